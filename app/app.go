@@ -95,6 +95,7 @@ import (
 	tokentypes "github.com/irismod/token/types"
 
 	"github.com/irisnet/irishub/modules/guardian"
+	guardianclient "github.com/irisnet/irishub/modules/guardian/client"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
 	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 	"github.com/irisnet/irishub/modules/mint"
@@ -104,6 +105,7 @@ import (
 	oracleKeeper "github.com/irisnet/irishub/modules/oracle/keeper"
 	oracletypes "github.com/irisnet/irishub/modules/oracle/types"
 	"github.com/irisnet/irishub/modules/random"
+	randomclient "github.com/irisnet/irishub/modules/random/client"
 	randomkeeper "github.com/irisnet/irishub/modules/random/keeper"
 	randomtypes "github.com/irisnet/irishub/modules/random/types"
 )
@@ -137,6 +139,10 @@ var (
 			paramsclient.ProposalHandler,
 			distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler,
+			guardianclient.AddGuardianProposalHandler,
+			guardianclient.DeleteGuardianProposalHandler,
+			guardianclient.UpdateGuardianRolesProposalHandler,
+			randomclient.UpdateVRFPublicKeyProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -170,6 +176,7 @@ var (
 		coinswaptypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
 		servicetypes.DepositAccName:    {authtypes.Burner},
 		servicetypes.RequestAccName:    nil,
+		randomtypes.ModuleName:         {authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -311,18 +318,7 @@ func NewIrisApp(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.bankKeeper, authtypes.FeeCollectorName,
 	)
 	app.upgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath)
-
-	// register the proposal types
-	govRouter := govtypes.NewRouter()
-	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
-
-	app.govKeeper = govkeeper.NewKeeper(
-		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.accountKeeper, app.bankKeeper,
-		&stakingKeeper, govRouter,
-	)
+	app.guardianKeeper = guardiankeeper.NewKeeper(appCodec, keys[guardiantypes.StoreKey], app.GetSubspace(guardiantypes.ModuleName))
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -358,7 +354,6 @@ func NewIrisApp(
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.evidenceKeeper = *evidenceKeeper
 
-	app.guardianKeeper = guardiankeeper.NewKeeper(appCodec, keys[guardiantypes.StoreKey])
 	app.tokenKeeper = tokenkeeper.NewKeeper(
 		appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName),
 		app.accountKeeper, app.bankKeeper, authtypes.FeeCollectorName,
@@ -383,7 +378,25 @@ func NewIrisApp(
 		app.guardianKeeper, app.serviceKeeper,
 	)
 
-	app.randomKeeper = randomkeeper.NewKeeper(appCodec, keys[randomtypes.StoreKey], app.bankKeeper, app.serviceKeeper)
+	app.randomKeeper = randomkeeper.NewKeeper(
+		appCodec, keys[randomtypes.StoreKey], app.GetSubspace(randomtypes.ModuleName),
+		app.bankKeeper, app.distrKeeper, app.serviceKeeper, authtypes.FeeCollectorName,
+	)
+
+	// register the proposal types
+	govRouter := govtypes.NewRouter()
+	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
+		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(guardiantypes.RouterKey, guardian.NewGuardianProposalHandler(app.guardianKeeper)).
+		AddRoute(randomtypes.RouterKey, random.NewProposalHandler(app.randomKeeper))
+
+	app.govKeeper = govkeeper.NewKeeper(
+		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.accountKeeper, app.bankKeeper,
+		&stakingKeeper, govRouter,
+	)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -409,8 +422,8 @@ func NewIrisApp(
 		htlc.NewAppModule(appCodec, app.htlcKeeper, app.accountKeeper, app.bankKeeper),
 		coinswap.NewAppModule(appCodec, app.coinswapKeeper, app.accountKeeper, app.bankKeeper),
 		service.NewAppModule(appCodec, app.serviceKeeper, app.accountKeeper, app.bankKeeper),
-		oracle.NewAppModule(appCodec, app.oracleKeeper),
-		random.NewAppModule(appCodec, app.randomKeeper, app.accountKeeper, app.bankKeeper),
+		oracle.NewAppModule(appCodec, app.oracleKeeper, app.accountKeeper, app.bankKeeper, app.guardianKeeper, app.serviceKeeper),
+		random.NewAppModule(appCodec, app.randomKeeper, app.accountKeeper, app.bankKeeper, app.serviceKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -424,7 +437,7 @@ func NewIrisApp(
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
-		servicetypes.ModuleName,
+		servicetypes.ModuleName, guardiantypes.ModuleName,
 	)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
@@ -471,8 +484,8 @@ func NewIrisApp(
 		htlc.NewAppModule(appCodec, app.htlcKeeper, app.accountKeeper, app.bankKeeper),
 		coinswap.NewAppModule(appCodec, app.coinswapKeeper, app.accountKeeper, app.bankKeeper),
 		service.NewAppModule(appCodec, app.serviceKeeper, app.accountKeeper, app.bankKeeper),
-		oracle.NewAppModule(appCodec, app.oracleKeeper),
-		random.NewAppModule(appCodec, app.randomKeeper, app.accountKeeper, app.bankKeeper),
+		oracle.NewAppModule(appCodec, app.oracleKeeper, app.accountKeeper, app.bankKeeper, app.guardianKeeper, app.serviceKeeper),
+		random.NewAppModule(appCodec, app.randomKeeper, app.accountKeeper, app.bankKeeper, app.serviceKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -494,6 +507,8 @@ func NewIrisApp(
 	)
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.registerUpgradeHandlers()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -666,6 +681,8 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(htlctypes.ModuleName)
 	paramsKeeper.Subspace(coinswaptypes.ModuleName)
 	paramsKeeper.Subspace(servicetypes.ModuleName)
+	paramsKeeper.Subspace(randomtypes.ModuleName)
+	paramsKeeper.Subspace(guardiantypes.ModuleName)
 
 	return paramsKeeper
 }
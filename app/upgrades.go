@@ -0,0 +1,25 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// UpgradeName is the name of the software upgrade plan which migrates the state
+// of the existing chain to the current version
+const UpgradeName = "v1.0"
+
+// registerUpgradeHandlers registers the state migrations run when the upgrade plan is reached
+func (app *IrisApp) registerUpgradeHandlers() {
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeName, app.upgradeHandler)
+}
+
+// upgradeHandler migrates the state of the existing chain when the upgrade plan is reached
+func (app *IrisApp) upgradeHandler(ctx sdk.Context, plan upgradetypes.Plan) {
+	app.guardianKeeper.MigrateParams(ctx)
+	app.guardianKeeper.MigrateProfilerRoles(ctx)
+	app.randomKeeper.MigrateParams(ctx)
+	app.randomKeeper.MigrateVRFRequests(ctx)
+	app.randomKeeper.MigrateRandomExpiry(ctx)
+	app.oracleKeeper.MigrateFeedPrecision(ctx)
+}
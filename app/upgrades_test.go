@@ -0,0 +1,31 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func TestUpgradeHandler(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), interBlockCacheOpt())
+
+	// the chain is not initialized, so none of the parameters introduced by the upgrade are stored
+	ctx := app.NewContext(true, tmproto.Header{Height: 1})
+	require.Panics(t, func() { app.guardianKeeper.GetParamSet(ctx) })
+
+	app.upgradeHandler(ctx, upgradetypes.Plan{Name: UpgradeName, Height: 1})
+
+	require.Equal(t, guardiantypes.DefaultParams(), app.guardianKeeper.GetParamSet(ctx))
+	require.NotPanics(t, func() { app.randomKeeper.GetParamSet(ctx) })
+}
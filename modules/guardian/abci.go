@@ -0,0 +1,82 @@
+package guardian
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// EndBlocker removes the profilers and the trustees which have expired. The guardian sets
+// are small, so all the guardians are checked in each block. The removals are recorded in
+// the audit trail as operated by the guardian module account
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	height, blockTime := ctx.BlockHeight(), ctx.BlockTime()
+	operator := authtypes.NewModuleAddress(types.ModuleName)
+
+	var expiredProfilers []types.Guardian
+	k.IterateProfilers(
+		ctx,
+		func(profiler types.Guardian) bool {
+			if profiler.IsExpired(height, blockTime) {
+				expiredProfilers = append(expiredProfilers, profiler)
+			}
+			return false
+		},
+	)
+
+	var expiredTrustees []types.Guardian
+	k.IterateTrustees(
+		ctx,
+		func(trustee types.Guardian) bool {
+			if trustee.IsExpired(height, blockTime) {
+				expiredTrustees = append(expiredTrustees, trustee)
+			}
+			return false
+		},
+	)
+
+	for _, profiler := range expiredProfilers {
+		k.DeleteProfiler(ctx, profiler.Address)
+		k.AppendAuditLog(ctx, types.ActionDeleteProfiler, profiler.Address, operator, profiler.Description)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireProfiler,
+				sdk.NewAttribute(types.AttributeKeyProfilerAddress, profiler.Address.String()),
+				sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(profiler.ExpiryHeight, 10)),
+				sdk.NewAttribute(types.AttributeKeyExpiryTime, formatExpiryTime(profiler.ExpiryTime)),
+			),
+		)
+
+		k.Logger(ctx).Info("profiler expired", "address", profiler.Address.String())
+	}
+
+	for _, trustee := range expiredTrustees {
+		k.DeleteTrustee(ctx, trustee.Address)
+		k.AppendAuditLog(ctx, types.ActionDeleteTrustee, trustee.Address, operator, trustee.Description)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireTrustee,
+				sdk.NewAttribute(types.AttributeKeyTrusteeAddress, trustee.Address.String()),
+				sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(trustee.ExpiryHeight, 10)),
+				sdk.NewAttribute(types.AttributeKeyExpiryTime, formatExpiryTime(trustee.ExpiryTime)),
+			),
+		)
+
+		k.Logger(ctx).Info("trustee expired", "address", trustee.Address.String())
+	}
+}
+
+// formatExpiryTime formats the expiry time for the events, empty if not set
+func formatExpiryTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
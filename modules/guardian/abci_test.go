@@ -0,0 +1,68 @@
+package guardian_test
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func (suite *TestSuite) TestEndBlocker() {
+	addr1 := sdk.AccAddress(crypto.AddressHash([]byte("addr1")))
+	addr2 := sdk.AccAddress(crypto.AddressHash([]byte("addr2")))
+	addr3 := sdk.AccAddress(crypto.AddressHash([]byte("addr3")))
+
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1600000000, 0).UTC())
+	expiryTime := ctx.BlockTime().Add(time.Hour)
+
+	suite.keeper.AddProfiler(ctx, types.NewGuardian("test", types.Ordinary, addr1, addr1).WithExpiry(11, nil))
+	suite.keeper.AddProfiler(ctx, types.NewGuardian("test", types.Genesis, addr2, addr2))
+	suite.keeper.AddTrustee(ctx, types.NewGuardian("test", types.Ordinary, addr3, addr3).WithExpiry(0, &expiryTime))
+
+	// nothing expires before the expiry height and time
+	guardian.EndBlocker(ctx, suite.keeper)
+	_, found := suite.keeper.GetProfiler(ctx, addr1)
+	suite.True(found)
+	_, found = suite.keeper.GetTrustee(ctx, addr3)
+	suite.True(found)
+
+	// the profiler expires at the expiry height
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	guardian.EndBlocker(ctx, suite.keeper)
+	_, found = suite.keeper.GetProfiler(ctx, addr1)
+	suite.False(found)
+	_, found = suite.keeper.GetProfiler(ctx, addr2)
+	suite.True(found)
+	_, found = suite.keeper.GetTrustee(ctx, addr3)
+	suite.True(found)
+	suite.Len(ctx.EventManager().Events(), 1)
+	suite.Equal(types.EventTypeExpireProfiler, ctx.EventManager().Events()[0].Type)
+
+	// the trustee expires at the expiry time
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(expiryTime).WithEventManager(sdk.NewEventManager())
+	guardian.EndBlocker(ctx, suite.keeper)
+	_, found = suite.keeper.GetTrustee(ctx, addr3)
+	suite.False(found)
+	suite.Len(ctx.EventManager().Events(), 1)
+	suite.Equal(types.EventTypeExpireTrustee, ctx.EventManager().Events()[0].Type)
+
+	// the removals are recorded in the audit trail
+	var logs []types.AuditLog
+	suite.keeper.IterateAuditLogs(
+		ctx,
+		func(log types.AuditLog) bool {
+			logs = append(logs, log)
+			return false
+		},
+	)
+	suite.Len(logs, 2)
+	suite.Equal(types.ActionDeleteProfiler, logs[0].ActionType)
+	suite.Equal(addr1, logs[0].Address)
+	suite.Equal(int64(11), logs[0].Height)
+	suite.Equal(types.ActionDeleteTrustee, logs[1].ActionType)
+	suite.Equal(addr3, logs[1].Address)
+}
@@ -3,21 +3,75 @@ package cli
 
 import (
 	flag "github.com/spf13/pflag"
+
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 )
 
 const (
-	FlagAddress     = "address"
-	FlagDescription = "description"
+	FlagAddress             = "address"
+	FlagDescription         = "description"
+	FlagGuardianType        = "guardian-type"
+	FlagGuardianDescription = "guardian-description"
+	FlagRoles               = "roles"
+	FlagExpiryHeight        = "expiry-height"
+	FlagExpiryTime          = "expiry-time"
+	FlagGenesis             = "genesis"
+	FlagPageKey             = "page-key"
+	FlagOffset              = "offset"
+	FlagLimit               = "limit"
+	FlagCountTotal          = "count-total"
 )
 
 // common flagsets to add to various functions
 var (
-	FsAddGuardian    = flag.NewFlagSet("", flag.ContinueOnError)
-	FsDeleteGuardian = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAddGuardian            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsDeleteGuardian         = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAddGuardianProposal    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsDeleteGuardianProposal = flag.NewFlagSet("", flag.ContinueOnError)
+	FsUpdateRolesProposal    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryPendingActions    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryAuditLogs         = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsAddGuardian.String(FlagAddress, "", "bech32 encoded account address")
 	FsAddGuardian.String(FlagDescription, "", "description of account")
+	FsAddGuardian.StringSlice(FlagRoles, []string{}, "comma separated roles assigned to the account, e.g. feed-admin")
+	FsAddGuardian.Int64(FlagExpiryHeight, 0, "height at which the account expires, never if zero")
+	FsAddGuardian.String(FlagExpiryTime, "", "time at which the account expires in RFC3339 format, e.g. 2021-01-01T00:00:00Z")
 	FsDeleteGuardian.String(FlagAddress, "", "bech32 encoded account address")
+
+	FsAddGuardianProposal.String(govcli.FlagTitle, "", "title of proposal")
+	FsAddGuardianProposal.String(govcli.FlagDescription, "", "description of proposal")
+	FsAddGuardianProposal.String(govcli.FlagDeposit, "", "deposit of proposal")
+	FsAddGuardianProposal.String(FlagGuardianType, "", "type of the guardian, Profiler or Trustee")
+	FsAddGuardianProposal.String(FlagAddress, "", "bech32 encoded account address")
+	FsAddGuardianProposal.String(FlagGuardianDescription, "", "description of account")
+	FsAddGuardianProposal.StringSlice(FlagRoles, []string{}, "comma separated roles assigned to the account, e.g. feed-admin")
+	FsAddGuardianProposal.Int64(FlagExpiryHeight, 0, "height at which the account expires, never if zero")
+	FsAddGuardianProposal.String(FlagExpiryTime, "", "time at which the account expires in RFC3339 format, e.g. 2021-01-01T00:00:00Z")
+	FsAddGuardianProposal.Bool(FlagGenesis, false, "add the account as a genesis account which can add and delete the ordinary accounts")
+
+	FsDeleteGuardianProposal.String(govcli.FlagTitle, "", "title of proposal")
+	FsDeleteGuardianProposal.String(govcli.FlagDescription, "", "description of proposal")
+	FsDeleteGuardianProposal.String(govcli.FlagDeposit, "", "deposit of proposal")
+	FsDeleteGuardianProposal.String(FlagGuardianType, "", "type of the guardian, Profiler or Trustee")
+	FsDeleteGuardianProposal.String(FlagAddress, "", "bech32 encoded account address")
+
+	FsUpdateRolesProposal.String(govcli.FlagTitle, "", "title of proposal")
+	FsUpdateRolesProposal.String(govcli.FlagDescription, "", "description of proposal")
+	FsUpdateRolesProposal.String(govcli.FlagDeposit, "", "deposit of proposal")
+	FsUpdateRolesProposal.String(FlagGuardianType, "", "type of the guardian, Profiler or Trustee")
+	FsUpdateRolesProposal.String(FlagAddress, "", "bech32 encoded account address")
+	FsUpdateRolesProposal.StringSlice(FlagRoles, []string{}, "comma separated roles replacing the current ones of the account, e.g. feed-admin")
+
+	FsQueryPendingActions.String(FlagPageKey, "", "The base64 encoded key of the page to query, returned as next_key by the previous query")
+	FsQueryPendingActions.Uint64(FlagOffset, 0, "The number of actions to skip, can not be used together with page-key")
+	FsQueryPendingActions.Uint64(FlagLimit, 0, "The maximum number of actions to return, default to 100")
+	FsQueryPendingActions.Bool(FlagCountTotal, false, "Count the total number of pending actions")
+
+	FsQueryAuditLogs.String(FlagPageKey, "", "The base64 encoded key of the page to query, returned as next_key by the previous query")
+	FsQueryAuditLogs.Uint64(FlagOffset, 0, "The number of logs to skip, can not be used together with page-key")
+	FsQueryAuditLogs.Uint64(FlagLimit, 0, "The maximum number of logs to return, default to 100")
+	FsQueryAuditLogs.Bool(FlagCountTotal, false, "Count the total number of audit logs")
 }
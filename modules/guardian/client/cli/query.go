@@ -2,12 +2,16 @@ package cli
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
@@ -25,6 +29,10 @@ func GetQueryCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetCmdQueryProfilers(),
 		GetCmdQueryTrustees(),
+		GetCmdQueryPendingAction(),
+		GetCmdQueryPendingActions(),
+		GetCmdQueryAuditLogs(),
+		GetCmdQueryParams(),
 	)
 	return txCmd
 }
@@ -82,3 +90,152 @@ func GetCmdQueryTrustees() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryPendingAction implements the query pending action command.
+func GetCmdQueryPendingAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-action [action-id]",
+		Short:   "Query a pending guardian action by the id",
+		Example: fmt.Sprintf("%s query guardian pending-action <action-id>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			actionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid action id: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingAction(context.Background(), &types.QueryPendingActionRequest{ID: actionID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Action)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPendingActions implements the query pending actions command.
+func GetCmdQueryPendingActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-actions",
+		Short:   "Query the guardian actions waiting for the approvals",
+		Example: fmt.Sprintf("%s query guardian pending-actions [--offset=<offset>] [--limit=<limit>]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingActionsRequest{
+				Pagination: &query.PageRequest{
+					Offset:     viper.GetUint64(FlagOffset),
+					Limit:      viper.GetUint64(FlagLimit),
+					CountTotal: viper.GetBool(FlagCountTotal),
+				},
+			}
+
+			if str := viper.GetString(FlagPageKey); len(str) > 0 {
+				key, err := base64.StdEncoding.DecodeString(str)
+				if err != nil {
+					return fmt.Errorf("invalid page key: %s", err)
+				}
+				req.Pagination.Key = key
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingActions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryPendingActions)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAuditLogs implements the query audit logs command.
+func GetCmdQueryAuditLogs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "audit-logs",
+		Short:   "Query the audit trail of the guardian add and delete operations",
+		Example: fmt.Sprintf("%s query guardian audit-logs [--offset=<offset>] [--limit=<limit>]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAuditLogsRequest{
+				Pagination: &query.PageRequest{
+					Offset:     viper.GetUint64(FlagOffset),
+					Limit:      viper.GetUint64(FlagLimit),
+					CountTotal: viper.GetBool(FlagCountTotal),
+				},
+			}
+
+			if str := viper.GetString(FlagPageKey); len(str) > 0 {
+				key, err := base64.StdEncoding.DecodeString(str)
+				if err != nil {
+					return fmt.Errorf("invalid page key: %s", err)
+				}
+				req.Pagination.Key = key
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AuditLogs(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryAuditLogs)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current guardian parameters",
+		Example: fmt.Sprintf("%s query guardian params", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
@@ -29,6 +33,8 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDeleteProfiler(),
 		GetCmdCreateTrustee(),
 		GetCmdDeleteTrustee(),
+		GetCmdApproveGuardianAction(),
+		GetCmdRejectGuardianAction(),
 	)
 	return txCmd
 }
@@ -39,7 +45,7 @@ func GetCmdCreateProfiler() *cobra.Command {
 		Use:   "add-profiler",
 		Short: "Add a new profiler",
 		Example: fmt.Sprintf(
-			"%s tx guardian add-profiler --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<added address> --description=<name>",
+			"%s tx guardian add-profiler --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<added address> --description=<name> --roles=<roles> --expiry-height=<height>",
 			version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -59,8 +65,13 @@ func GetCmdCreateProfiler() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			expiryHeight, expiryTime, err := parseExpiry()
+			if err != nil {
+				return err
+			}
 			description := viper.GetString(FlagDescription)
-			msg := types.NewMsgAddProfiler(description, pAddr, fromAddr)
+			msg := types.NewMsgAddProfiler(description, pAddr, fromAddr, viper.GetStringSlice(FlagRoles)...)
+			msg.AddGuardian.ExpiryHeight, msg.AddGuardian.ExpiryTime = expiryHeight, expiryTime
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -117,7 +128,7 @@ func GetCmdCreateTrustee() *cobra.Command {
 		Use:   "add-trustee",
 		Short: "Add a new trustee",
 		Example: fmt.Sprintf(
-			"%s tx guardian add-trustee --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<added address> --description=<name>",
+			"%s tx guardian add-trustee --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<added address> --description=<name> --roles=<roles> --expiry-height=<height>",
 			version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -136,8 +147,13 @@ func GetCmdCreateTrustee() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			expiryHeight, expiryTime, err := parseExpiry()
+			if err != nil {
+				return err
+			}
 			description := viper.GetString(FlagDescription)
-			msg := types.NewMsgAddTrustee(description, tAddr, fromAddr)
+			msg := types.NewMsgAddTrustee(description, tAddr, fromAddr, viper.GetStringSlice(FlagRoles)...)
+			msg.AddGuardian.ExpiryHeight, msg.AddGuardian.ExpiryTime = expiryHeight, expiryTime
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -186,3 +202,264 @@ func GetCmdDeleteTrustee() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdApproveGuardianAction implements the approve guardian action command.
+func GetCmdApproveGuardianAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-action [action-id]",
+		Short: "Approve a pending guardian action",
+		Example: fmt.Sprintf(
+			"%s tx guardian approve-action <action-id> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			actionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid action id: %s", err)
+			}
+
+			msg := types.NewMsgApproveGuardianAction(actionID, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRejectGuardianAction implements the reject guardian action command.
+func GetCmdRejectGuardianAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-action [action-id]",
+		Short: "Reject a pending guardian action",
+		Example: fmt.Sprintf(
+			"%s tx guardian reject-action <action-id> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			actionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid action id: %s", err)
+			}
+
+			msg := types.NewMsgRejectGuardianAction(actionID, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSubmitAddGuardianProposal implements the command to submit an add-guardian proposal
+func GetCmdSubmitAddGuardianProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-guardian",
+		Short: "Submit a proposal to add a profiler or a trustee",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal add-guardian --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --title=<title> "+
+				"--description=<description> --deposit=<deposit> --guardian-type=<Profiler|Trustee> --address=<added address> --guardian-description=<name> --roles=<roles> --expiry-time=<time> --genesis",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			guardianType, err := types.GuardianTypeFromString(viper.GetString(FlagGuardianType))
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+			expiryHeight, expiryTime, err := parseExpiry()
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAddGuardianProposal(
+				viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription),
+				guardianType, addr, viper.GetString(FlagGuardianDescription),
+				viper.GetStringSlice(FlagRoles)...,
+			)
+			content.ExpiryHeight, content.ExpiryTime = expiryHeight, expiryTime
+			content.Genesis = viper.GetBool(FlagGenesis)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAddGuardianProposal)
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+	_ = cmd.MarkFlagRequired(FlagGuardianType)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagGuardianDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSubmitDeleteGuardianProposal implements the command to submit a delete-guardian proposal
+func GetCmdSubmitDeleteGuardianProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-guardian",
+		Short: "Submit a proposal to delete a profiler or a trustee",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal delete-guardian --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --title=<title> "+
+				"--description=<description> --deposit=<deposit> --guardian-type=<Profiler|Trustee> --address=<deleted address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			guardianType, err := types.GuardianTypeFromString(viper.GetString(FlagGuardianType))
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewDeleteGuardianProposal(
+				viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription),
+				guardianType, addr,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsDeleteGuardianProposal)
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+	_ = cmd.MarkFlagRequired(FlagGuardianType)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSubmitUpdateGuardianRolesProposal implements the command to submit an update-guardian-roles proposal
+func GetCmdSubmitUpdateGuardianRolesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-guardian-roles",
+		Short: "Submit a proposal to replace the roles of a profiler or a trustee",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal update-guardian-roles --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --title=<title> "+
+				"--description=<description> --deposit=<deposit> --guardian-type=<Profiler|Trustee> --address=<guardian address> --roles=<roles>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			guardianType, err := types.GuardianTypeFromString(viper.GetString(FlagGuardianType))
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateGuardianRolesProposal(
+				viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription),
+				guardianType, addr, viper.GetStringSlice(FlagRoles)...,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsUpdateRolesProposal)
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+	_ = cmd.MarkFlagRequired(FlagGuardianType)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseExpiry returns the expiry height and time of the guardian from the flags
+func parseExpiry() (int64, *time.Time, error) {
+	expiryTimeStr := viper.GetString(FlagExpiryTime)
+	if len(expiryTimeStr) == 0 {
+		return viper.GetInt64(FlagExpiryHeight), nil, nil
+	}
+
+	expiryTime, err := time.Parse(time.RFC3339, expiryTimeStr)
+	if err != nil {
+		return 0, nil, err
+	}
+	return viper.GetInt64(FlagExpiryHeight), &expiryTime, nil
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/irisnet/irishub/modules/guardian/client/cli"
+	"github.com/irisnet/irishub/modules/guardian/client/rest"
+)
+
+// proposal handlers of the guardian module
+var (
+	AddGuardianProposalHandler         = govclient.NewProposalHandler(cli.GetCmdSubmitAddGuardianProposal, rest.AddGuardianProposalRESTHandler)
+	DeleteGuardianProposalHandler      = govclient.NewProposalHandler(cli.GetCmdSubmitDeleteGuardianProposal, rest.DeleteGuardianProposalRESTHandler)
+	UpdateGuardianRolesProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateGuardianRolesProposal, rest.UpdateGuardianRolesProposalRESTHandler)
+)
@@ -0,0 +1,144 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// AddGuardianProposalRESTHandler returns the REST handler to submit an add guardian proposal
+func AddGuardianProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_guardian",
+		Handler:  postAddGuardianProposalHandlerFn(cliCtx),
+	}
+}
+
+// DeleteGuardianProposalRESTHandler returns the REST handler to submit a delete guardian proposal
+func DeleteGuardianProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "delete_guardian",
+		Handler:  postDeleteGuardianProposalHandlerFn(cliCtx),
+	}
+}
+
+// UpdateGuardianRolesProposalRESTHandler returns the REST handler to submit an update guardian roles proposal
+func UpdateGuardianRolesProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_guardian_roles",
+		Handler:  postUpdateGuardianRolesProposalHandlerFn(cliCtx),
+	}
+}
+
+// HTTP request handler to submit an add guardian proposal
+func postAddGuardianProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddGuardianProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		guardianType, err := types.GuardianTypeFromString(req.GuardianType)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		content := types.NewAddGuardianProposal(req.Title, req.Description, guardianType, req.Address, req.GuardianDescription, req.Roles...)
+		content.ExpiryHeight, content.ExpiryTime = req.ExpiryHeight, req.ExpiryTime
+		content.Genesis = req.Genesis
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// HTTP request handler to submit a delete guardian proposal
+func postDeleteGuardianProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DeleteGuardianProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		guardianType, err := types.GuardianTypeFromString(req.GuardianType)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		content := types.NewDeleteGuardianProposal(req.Title, req.Description, guardianType, req.Address)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// HTTP request handler to submit an update guardian roles proposal
+func postUpdateGuardianRolesProposalHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateGuardianRolesProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		guardianType, err := types.GuardianTypeFromString(req.GuardianType)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		content := types.NewUpdateGuardianRolesProposal(req.Title, req.Description, guardianType, req.Address, req.Roles...)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func registerQueryRoutes(cliCtx client.Context, r *mux.Router) {
+	// query the audit trail of the guardian add and delete operations
+	r.HandleFunc("/guardian/audit-logs", queryAuditLogsHandlerFn(cliCtx)).Methods("GET")
+}
+
+// HTTP request handler to query the audit trail of the guardian add and delete operations.
+func queryAuditLogsHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var params types.QueryAuditLogsParams
+		var err error
+
+		if str := r.FormValue("page"); len(str) > 0 {
+			if params.Page, err = strconv.ParseUint(str, 10, 64); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid page: %s", err))
+				return
+			}
+		}
+		if str := r.FormValue("limit"); len(str) > 0 {
+			if params.Limit, err = strconv.ParseUint(str, 10, 64); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid limit: %s", err))
+				return
+			}
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.JSONMarshaler.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuditLogs)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
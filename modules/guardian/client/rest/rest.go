@@ -0,0 +1,55 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// RegisterHandlers defines routes that get registered by the main application
+func RegisterHandlers(cliCtx client.Context, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
+
+// AddGuardianProposalReq defines the properties of an add guardian proposal request's body
+type AddGuardianProposalReq struct {
+	BaseReq             rest.BaseReq   `json:"base_req" yaml:"base_req"` // base req
+	Title               string         `json:"title"`                    // title of the proposal
+	Description         string         `json:"description"`              // description of the proposal
+	GuardianType        string         `json:"guardian_type"`            // type of the guardian, Profiler or Trustee
+	Address             sdk.AccAddress `json:"address"`                  // added address
+	GuardianDescription string         `json:"guardian_description"`     // description of the guardian
+	Roles               []string       `json:"roles"`                    // roles assigned to the guardian
+	ExpiryHeight        int64          `json:"expiry_height"`            // height at which the guardian expires, never if zero
+	ExpiryTime          *time.Time     `json:"expiry_time"`              // time at which the guardian expires, never if nil
+	Genesis             bool           `json:"genesis"`                  // whether the guardian is a genesis account
+	Proposer            sdk.AccAddress `json:"proposer"`                 // proposer address
+	Deposit             sdk.Coins      `json:"deposit"`                  // initial deposit
+}
+
+// DeleteGuardianProposalReq defines the properties of a delete guardian proposal request's body
+type DeleteGuardianProposalReq struct {
+	BaseReq      rest.BaseReq   `json:"base_req" yaml:"base_req"` // base req
+	Title        string         `json:"title"`                    // title of the proposal
+	Description  string         `json:"description"`              // description of the proposal
+	GuardianType string         `json:"guardian_type"`            // type of the guardian, Profiler or Trustee
+	Address      sdk.AccAddress `json:"address"`                  // deleted address
+	Proposer     sdk.AccAddress `json:"proposer"`                 // proposer address
+	Deposit      sdk.Coins      `json:"deposit"`                  // initial deposit
+}
+
+// UpdateGuardianRolesProposalReq defines the properties of an update guardian roles proposal request's body
+type UpdateGuardianRolesProposalReq struct {
+	BaseReq      rest.BaseReq   `json:"base_req" yaml:"base_req"` // base req
+	Title        string         `json:"title"`                    // title of the proposal
+	Description  string         `json:"description"`              // description of the proposal
+	GuardianType string         `json:"guardian_type"`            // type of the guardian, Profiler or Trustee
+	Address      sdk.AccAddress `json:"address"`                  // guardian address
+	Roles        []string       `json:"roles"`                    // roles replacing the current ones of the guardian
+	Proposer     sdk.AccAddress `json:"proposer"`                 // proposer address
+	Deposit      sdk.Coins      `json:"deposit"`                  // initial deposit
+}
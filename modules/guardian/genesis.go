@@ -1,6 +1,8 @@
 package guardian
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/irisnet/irishub/modules/guardian/keeper"
@@ -9,6 +11,12 @@ import (
 
 // InitGenesis stores genesis data
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize guardian genesis state: %s", err.Error()))
+	}
+
+	keeper.SetParamSet(ctx, data.Params)
+
 	// Add profilers
 	for _, profiler := range data.Profilers {
 		keeper.AddProfiler(ctx, profiler)
@@ -17,6 +25,24 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 	for _, trustee := range data.Trustees {
 		keeper.AddTrustee(ctx, trustee)
 	}
+	// Add pending actions, the next action id follows the largest one
+	nextActionID := uint64(1)
+	for _, action := range data.PendingActions {
+		keeper.SetPendingAction(ctx, action)
+		if action.ID >= nextActionID {
+			nextActionID = action.ID + 1
+		}
+	}
+	keeper.SetNextActionID(ctx, nextActionID)
+	// Add audit logs, the next log id follows the largest one
+	nextAuditLogID := uint64(1)
+	for _, log := range data.AuditLogs {
+		keeper.SetAuditLog(ctx, log)
+		if log.ID >= nextAuditLogID {
+			nextAuditLogID = log.ID + 1
+		}
+	}
+	keeper.SetNextAuditLogID(ctx, nextAuditLogID)
 }
 
 // ExportGenesis outputs genesis data
@@ -38,5 +64,23 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(profilers, trustees)
+	var pendingActions []types.PendingAction
+	k.IteratePendingActions(
+		ctx,
+		func(action types.PendingAction) bool {
+			pendingActions = append(pendingActions, action)
+			return false
+		},
+	)
+
+	var auditLogs []types.AuditLog
+	k.IterateAuditLogs(
+		ctx,
+		func(log types.AuditLog) bool {
+			auditLogs = append(auditLogs, log)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(profilers, trustees, k.GetParamSet(ctx), pendingActions, auditLogs)
 }
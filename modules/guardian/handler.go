@@ -22,124 +22,134 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgDeleteProfiler(ctx, k, msg)
 		case *types.MsgDeleteTrustee:
 			return handleMsgDeleteTrustee(ctx, k, msg)
+		case *types.MsgApproveGuardianAction:
+			return handleMsgApproveGuardianAction(ctx, k, msg)
+		case *types.MsgRejectGuardianAction:
+			return handleMsgRejectGuardianAction(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized bank message type: %T", msg)
 		}
 	}
 }
 
-// handleMsgAddProfiler handles MsgAddProfiler
+// handleMsgAddProfiler handles MsgAddProfiler, the action is executed once approved by enough profilers
 func handleMsgAddProfiler(ctx sdk.Context, k keeper.Keeper, msg *types.MsgAddProfiler) (*sdk.Result, error) {
-	if profiler, found := k.GetProfiler(ctx, msg.AddGuardian.AddedBy); !found || profiler.GetAccountType() != types.Genesis {
+	if profiler, found := k.GetActiveGuardian(ctx, types.Profiler, msg.AddGuardian.AddedBy); !found || profiler.GetAccountType() != types.Genesis {
 		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.AddGuardian.AddedBy.String())
 	}
-	if _, found := k.GetProfiler(ctx, msg.AddGuardian.Address); found {
-		return nil, sdkerrors.Wrap(types.ErrProfilerExists, msg.AddGuardian.Address.String())
-	}
-	profiler := types.NewGuardian(msg.AddGuardian.Description, types.Ordinary, msg.AddGuardian.Address, msg.AddGuardian.AddedBy)
-	k.AddProfiler(ctx, profiler)
 
-	ctx.EventManager().EmitEvents(sdk.Events{
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.AddGuardian.AddedBy.String()),
 		),
-		sdk.NewEvent(
-			types.EventTypeAddProfiler,
-			sdk.NewAttribute(types.AttributeKeyProfilerAddress, msg.AddGuardian.Address.String()),
-			sdk.NewAttribute(types.AttributeKeyAddedBy, msg.AddGuardian.AddedBy.String()),
-		),
-	})
+	)
+
+	action := types.NewAddGuardianAction(types.ActionAddProfiler, msg.AddGuardian, ctx.BlockHeight())
+	if _, err := k.SubmitAction(ctx, action); err != nil {
+		return nil, err
+	}
 
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
-// handleMsgAddTrustee handles MsgAddTrustee
+// handleMsgAddTrustee handles MsgAddTrustee, the action is executed once approved by enough trustees
 func handleMsgAddTrustee(ctx sdk.Context, k keeper.Keeper, msg *types.MsgAddTrustee) (*sdk.Result, error) {
-	if trustee, found := k.GetTrustee(ctx, msg.AddGuardian.AddedBy); !found || trustee.GetAccountType() != types.Genesis {
+	if trustee, found := k.GetActiveGuardian(ctx, types.Trustee, msg.AddGuardian.AddedBy); !found || trustee.GetAccountType() != types.Genesis {
 		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.AddGuardian.AddedBy.String())
 	}
-	if _, found := k.GetTrustee(ctx, msg.AddGuardian.Address); found {
-		return nil, sdkerrors.Wrap(types.ErrTrusteeExists, msg.AddGuardian.Address.String())
-	}
-	trustee := types.NewGuardian(msg.AddGuardian.Description, types.Ordinary, msg.AddGuardian.Address, msg.AddGuardian.AddedBy)
-	k.AddTrustee(ctx, trustee)
 
-	ctx.EventManager().EmitEvents(sdk.Events{
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.AddGuardian.AddedBy.String()),
 		),
-		sdk.NewEvent(
-			types.EventTypeAddTrustee,
-			sdk.NewAttribute(types.AttributeKeyTrusteeAddress, msg.AddGuardian.Address.String()),
-			sdk.NewAttribute(types.AttributeKeyAddedBy, msg.AddGuardian.AddedBy.String()),
-		),
-	})
+	)
+
+	action := types.NewAddGuardianAction(types.ActionAddTrustee, msg.AddGuardian, ctx.BlockHeight())
+	if _, err := k.SubmitAction(ctx, action); err != nil {
+		return nil, err
+	}
 
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
-// handleMsgDeleteProfiler handles MsgDeleteProfiler
+// handleMsgDeleteProfiler handles MsgDeleteProfiler, the action is executed once approved by enough profilers
 func handleMsgDeleteProfiler(ctx sdk.Context, k keeper.Keeper, msg *types.MsgDeleteProfiler) (*sdk.Result, error) {
-	if profiler, found := k.GetProfiler(ctx, msg.DeleteGuardian.DeletedBy); !found || profiler.GetAccountType() != types.Genesis {
+	if profiler, found := k.GetActiveGuardian(ctx, types.Profiler, msg.DeleteGuardian.DeletedBy); !found || profiler.GetAccountType() != types.Genesis {
 		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.DeleteGuardian.DeletedBy.String())
 	}
-	profiler, found := k.GetProfiler(ctx, msg.DeleteGuardian.Address)
-	if !found {
-		return nil, sdkerrors.Wrap(types.ErrUnknownProfiler, msg.DeleteGuardian.Address.String())
-	}
-	if profiler.GetAccountType() == types.Genesis {
-		return nil, sdkerrors.Wrap(types.ErrDeleteGenesisProfiler, msg.DeleteGuardian.Address.String())
-	}
-
-	k.DeleteProfiler(ctx, msg.DeleteGuardian.Address)
 
-	ctx.EventManager().EmitEvents(sdk.Events{
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeleteGuardian.DeletedBy.String()),
 		),
-		sdk.NewEvent(
-			types.EventTypeDeleteProfiler,
-			sdk.NewAttribute(types.AttributeKeyProfilerAddress, msg.DeleteGuardian.Address.String()),
-			sdk.NewAttribute(types.AttributeKeyDeletedBy, msg.DeleteGuardian.DeletedBy.String()),
-		),
-	})
+	)
+
+	action := types.NewDeleteGuardianAction(types.ActionDeleteProfiler, msg.DeleteGuardian, ctx.BlockHeight())
+	if _, err := k.SubmitAction(ctx, action); err != nil {
+		return nil, err
+	}
 
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
-// handleMsgDeleteTrustee handles MsgDeleteTrustee
+// handleMsgDeleteTrustee handles MsgDeleteTrustee, the action is executed once approved by enough trustees
 func handleMsgDeleteTrustee(ctx sdk.Context, k keeper.Keeper, msg *types.MsgDeleteTrustee) (*sdk.Result, error) {
-	if trustee, found := k.GetTrustee(ctx, msg.DeleteGuardian.DeletedBy); !found || trustee.GetAccountType() != types.Genesis {
+	if trustee, found := k.GetActiveGuardian(ctx, types.Trustee, msg.DeleteGuardian.DeletedBy); !found || trustee.GetAccountType() != types.Genesis {
 		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.DeleteGuardian.DeletedBy.String())
 	}
-	trustee, found := k.GetTrustee(ctx, msg.DeleteGuardian.Address)
-	if !found {
-		return nil, sdkerrors.Wrap(types.ErrUnknownTrustee, msg.DeleteGuardian.Address.String())
-	}
-	if trustee.GetAccountType() == types.Genesis {
-		return nil, sdkerrors.Wrap(types.ErrDeleteGenesisTrustee, msg.DeleteGuardian.Address.String())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeleteGuardian.DeletedBy.String()),
+		),
+	)
+
+	action := types.NewDeleteGuardianAction(types.ActionDeleteTrustee, msg.DeleteGuardian, ctx.BlockHeight())
+	if _, err := k.SubmitAction(ctx, action); err != nil {
+		return nil, err
 	}
 
-	k.DeleteTrustee(ctx, msg.DeleteGuardian.Address)
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
+// handleMsgApproveGuardianAction handles MsgApproveGuardianAction
+func handleMsgApproveGuardianAction(ctx sdk.Context, k keeper.Keeper, msg *types.MsgApproveGuardianAction) (*sdk.Result, error) {
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeleteGuardian.DeletedBy.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Approver.String()),
 		),
+	)
+
+	if err := k.ApproveAction(ctx, msg.ActionID, msg.Approver); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgRejectGuardianAction handles MsgRejectGuardianAction
+func handleMsgRejectGuardianAction(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRejectGuardianAction) (*sdk.Result, error) {
+	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeDeleteTrustee,
-			sdk.NewAttribute(types.AttributeKeyTrusteeAddress, msg.DeleteGuardian.Address.String()),
-			sdk.NewAttribute(types.AttributeKeyDeletedBy, msg.DeleteGuardian.DeletedBy.String()),
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Rejecter.String()),
 		),
-	})
+	)
+
+	if err := k.RejectAction(ctx, msg.ActionID, msg.Rejecter); err != nil {
+		return nil, err
+	}
 
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
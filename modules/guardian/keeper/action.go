@@ -0,0 +1,376 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/pagination"
+)
+
+// SubmitAction stores the guardian action as a pending one approved by its operator,
+// the action is executed at once if a single approval reaches the threshold
+func (k Keeper) SubmitAction(ctx sdk.Context, action types.PendingAction) (uint64, error) {
+	if err := k.validateAction(ctx, action); err != nil {
+		return 0, err
+	}
+
+	action.ID = k.GetNextActionID(ctx)
+	k.SetNextActionID(ctx, action.ID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitGuardianAction,
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(action.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyActionType, action.ActionType.String()),
+		),
+	)
+
+	return action.ID, k.tallyAction(ctx, action)
+}
+
+// ApproveAction records the approval of the guardian on the pending action,
+// the action is executed once the approvals reach the threshold
+func (k Keeper) ApproveAction(ctx sdk.Context, id uint64, approver sdk.AccAddress) error {
+	action, err := k.getVotableAction(ctx, id, approver)
+	if err != nil {
+		return err
+	}
+	action.Approvals = append(action.Approvals, approver)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApproveGuardianAction,
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyApprover, approver.String()),
+		),
+	)
+
+	return k.tallyAction(ctx, action)
+}
+
+// RejectAction records the rejection of the guardian on the pending action,
+// the action is discarded once the rejections reach the threshold
+func (k Keeper) RejectAction(ctx sdk.Context, id uint64, rejecter sdk.AccAddress) error {
+	action, err := k.getVotableAction(ctx, id, rejecter)
+	if err != nil {
+		return err
+	}
+	action.Rejections = append(action.Rejections, rejecter)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRejectGuardianAction,
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRejecter, rejecter.String()),
+		),
+	)
+
+	guardianType := action.ActionType.GuardianType()
+	if k.countVotes(ctx, guardianType, action.Rejections) < k.approvalThreshold(ctx, guardianType) {
+		k.SetPendingAction(ctx, action)
+		return nil
+	}
+
+	k.discardAction(ctx, action, "rejected")
+	return nil
+}
+
+// discardAction deletes the pending action without applying it
+func (k Keeper) discardAction(ctx sdk.Context, action types.PendingAction, reason string) {
+	k.DeletePendingAction(ctx, action.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDiscardGuardianAction,
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(action.ID, 10)),
+		),
+	)
+
+	k.Logger(ctx).Info("guardian action discarded", "id", action.ID, "type", action.ActionType.String(), "reason", reason)
+}
+
+// approvalThreshold returns the number of votes required to settle an action on the guardians of
+// the given type, which is capped at the number of the guardians eligible to vote
+func (k Keeper) approvalThreshold(ctx sdk.Context, guardianType types.GuardianType) uint32 {
+	threshold := k.GetParamSet(ctx).ApprovalThreshold
+
+	var eligible uint32
+	countGuardian := func(guardian types.Guardian) bool {
+		if !guardian.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+			eligible++
+		}
+		return eligible >= threshold
+	}
+
+	switch guardianType {
+	case types.Profiler:
+		k.IterateProfilers(ctx, countGuardian)
+	case types.Trustee:
+		k.IterateTrustees(ctx, countGuardian)
+	}
+
+	if eligible > 0 && eligible < threshold {
+		return eligible
+	}
+	return threshold
+}
+
+// countVotes returns the number of the voters which are still unexpired guardians of the given type,
+// the votes of the guardians deleted or expired since they voted are not counted
+func (k Keeper) countVotes(ctx sdk.Context, guardianType types.GuardianType, voters []sdk.AccAddress) uint32 {
+	var votes uint32
+	for _, voter := range voters {
+		if _, found := k.GetActiveGuardian(ctx, guardianType, voter); found {
+			votes++
+		}
+	}
+	return votes
+}
+
+// getVotableAction retrieves the pending action which the voter is allowed to approve or reject,
+// the voter must be an unexpired guardian of the type the action operates on and vote only once
+func (k Keeper) getVotableAction(ctx sdk.Context, id uint64, voter sdk.AccAddress) (types.PendingAction, error) {
+	action, found := k.GetPendingAction(ctx, id)
+	if !found {
+		return action, sdkerrors.Wrapf(types.ErrUnknownAction, "%d", id)
+	}
+
+	if _, found := k.GetActiveGuardian(ctx, action.ActionType.GuardianType(), voter); !found {
+		return action, sdkerrors.Wrap(types.ErrUnknownOperator, voter.String())
+	}
+
+	if action.HasVoted(voter) {
+		return action, sdkerrors.Wrapf(types.ErrDuplicateVote, "%s on %d", voter, id)
+	}
+	return action, nil
+}
+
+// tallyAction executes the action if the approvals reach the threshold and stores it otherwise,
+// the action is discarded if it no longer applies to the current guardians
+func (k Keeper) tallyAction(ctx sdk.Context, action types.PendingAction) error {
+	guardianType := action.ActionType.GuardianType()
+	if k.countVotes(ctx, guardianType, action.Approvals) < k.approvalThreshold(ctx, guardianType) {
+		k.SetPendingAction(ctx, action)
+		return nil
+	}
+
+	if err := k.validateAction(ctx, action); err != nil {
+		k.discardAction(ctx, action, err.Error())
+		return nil
+	}
+
+	k.DeletePendingAction(ctx, action.ID)
+	k.executeAction(ctx, action)
+	return nil
+}
+
+// validateAction checks that the action can be applied to the current guardians
+func (k Keeper) validateAction(ctx sdk.Context, action types.PendingAction) error {
+	if err := action.Validate(); err != nil {
+		return err
+	}
+
+	address := action.Address()
+	switch action.ActionType {
+	case types.ActionAddProfiler:
+		if k.isProfiler(ctx, address) {
+			return sdkerrors.Wrap(types.ErrProfilerExists, address.String())
+		}
+	case types.ActionAddTrustee:
+		if k.isTrustee(ctx, address) {
+			return sdkerrors.Wrap(types.ErrTrusteeExists, address.String())
+		}
+	case types.ActionDeleteProfiler:
+		profiler, found := k.GetProfiler(ctx, address)
+		if !found {
+			return sdkerrors.Wrap(types.ErrUnknownProfiler, address.String())
+		}
+		if profiler.GetAccountType() == types.Genesis {
+			return sdkerrors.Wrap(types.ErrDeleteGenesisProfiler, address.String())
+		}
+	case types.ActionDeleteTrustee:
+		trustee, found := k.GetTrustee(ctx, address)
+		if !found {
+			return sdkerrors.Wrap(types.ErrUnknownTrustee, address.String())
+		}
+		if trustee.GetAccountType() == types.Genesis {
+			return sdkerrors.Wrap(types.ErrDeleteGenesisTrustee, address.String())
+		}
+	}
+
+	if action.ActionType.IsAdd() && newGuardian(*action.AddGuardian).IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+		return sdkerrors.Wrap(types.ErrInvalidExpiry, "the expiry must be later than the current block")
+	}
+	return nil
+}
+
+// executeAction applies the approved action, which has been validated, to the guardians
+func (k Keeper) executeAction(ctx sdk.Context, action types.PendingAction) {
+	var event sdk.Event
+	var description string
+	switch action.ActionType {
+	case types.ActionAddProfiler:
+		description = action.AddGuardian.Description
+		k.AddProfiler(ctx, newGuardian(*action.AddGuardian))
+		event = sdk.NewEvent(
+			types.EventTypeAddProfiler,
+			sdk.NewAttribute(types.AttributeKeyProfilerAddress, action.AddGuardian.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyAddedBy, action.AddGuardian.AddedBy.String()),
+		)
+	case types.ActionAddTrustee:
+		description = action.AddGuardian.Description
+		k.AddTrustee(ctx, newGuardian(*action.AddGuardian))
+		event = sdk.NewEvent(
+			types.EventTypeAddTrustee,
+			sdk.NewAttribute(types.AttributeKeyTrusteeAddress, action.AddGuardian.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyAddedBy, action.AddGuardian.AddedBy.String()),
+		)
+	case types.ActionDeleteProfiler:
+		profiler, _ := k.GetProfiler(ctx, action.DeleteGuardian.Address)
+		description = profiler.Description
+		k.DeleteProfiler(ctx, action.DeleteGuardian.Address)
+		event = sdk.NewEvent(
+			types.EventTypeDeleteProfiler,
+			sdk.NewAttribute(types.AttributeKeyProfilerAddress, action.DeleteGuardian.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyDeletedBy, action.DeleteGuardian.DeletedBy.String()),
+		)
+	case types.ActionDeleteTrustee:
+		trustee, _ := k.GetTrustee(ctx, action.DeleteGuardian.Address)
+		description = trustee.Description
+		k.DeleteTrustee(ctx, action.DeleteGuardian.Address)
+		event = sdk.NewEvent(
+			types.EventTypeDeleteTrustee,
+			sdk.NewAttribute(types.AttributeKeyTrusteeAddress, action.DeleteGuardian.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyDeletedBy, action.DeleteGuardian.DeletedBy.String()),
+		)
+	}
+
+	k.AppendAuditLog(ctx, action.ActionType, action.Address(), action.Operator(), description)
+
+	event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(action.ID, 10)))
+	ctx.EventManager().EmitEvent(event)
+
+	k.Logger(ctx).Info("guardian action executed", "id", action.ID, "type", action.ActionType.String())
+}
+
+// newGuardian builds the ordinary guardian added by the operation
+func newGuardian(g types.AddGuardian) types.Guardian {
+	return types.NewGuardian(
+		g.Description, types.Ordinary, g.Address, g.AddedBy, g.Roles...,
+	).WithExpiry(g.ExpiryHeight, g.ExpiryTime)
+}
+
+// GetActiveGuardian retrieves the profiler or the trustee of the address which has not expired
+func (k Keeper) GetActiveGuardian(ctx sdk.Context, guardianType types.GuardianType, addr sdk.AccAddress) (guardian types.Guardian, found bool) {
+	switch guardianType {
+	case types.Profiler:
+		guardian, found = k.GetProfiler(ctx, addr)
+	case types.Trustee:
+		guardian, found = k.GetTrustee(ctx, addr)
+	}
+	if !found || guardian.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+		return guardian, false
+	}
+	return guardian, true
+}
+
+func (k Keeper) isProfiler(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, found := k.GetProfiler(ctx, addr)
+	return found
+}
+
+func (k Keeper) isTrustee(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, found := k.GetTrustee(ctx, addr)
+	return found
+}
+
+// SetPendingAction stores the pending action
+func (k Keeper) SetPendingAction(ctx sdk.Context, action types.PendingAction) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&action)
+	store.Set(types.GetPendingActionKey(action.ID), bz)
+}
+
+// DeletePendingAction deletes the stored pending action
+func (k Keeper) DeletePendingAction(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingActionKey(id))
+}
+
+// GetPendingAction retrieves the pending action by the specified id
+func (k Keeper) GetPendingAction(ctx sdk.Context, id uint64) (action types.PendingAction, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetPendingActionKey(id)); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &action)
+		return action, true
+	}
+	return action, false
+}
+
+// IteratePendingActions iterates through all pending actions ordered by the id
+func (k Keeper) IteratePendingActions(
+	ctx sdk.Context,
+	op func(action types.PendingAction) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPendingActionsSubspaceKey())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var action types.PendingAction
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &action)
+
+		if stop := op(action); stop {
+			break
+		}
+	}
+}
+
+// GetPaginatedPendingActions returns the pending actions ordered by the id
+func (k Keeper) GetPaginatedPendingActions(
+	ctx sdk.Context,
+	pageReq *query.PageRequest,
+) ([]types.PendingAction, *query.PageResponse, error) {
+	var actions []types.PendingAction
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPendingActionsSubspaceKey())
+	pageRes, err := pagination.Paginate(
+		pageReq, types.DefaultPendingActionsLimit,
+		func(pageKey []byte) sdk.Iterator {
+			return store.Iterator(pageKey, nil)
+		},
+		func(key, value []byte, accumulate bool) bool {
+			if accumulate {
+				var action types.PendingAction
+				k.cdc.MustUnmarshalBinaryBare(value, &action)
+				actions = append(actions, action)
+			}
+			return true
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return actions, pageRes, nil
+}
+
+// GetNextActionID returns the id of the next pending action
+func (k Keeper) GetNextActionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextActionIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextActionID sets the id of the next pending action
+func (k Keeper) SetNextActionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextActionIDKey, sdk.Uint64ToBigEndian(id))
+}
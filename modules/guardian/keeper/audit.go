@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/pagination"
+)
+
+// AppendAuditLog appends the add or delete operation performed by the operator to the audit trail,
+// the logs are never deleted so that it can be traced who held the privileges and when
+func (k Keeper) AppendAuditLog(
+	ctx sdk.Context,
+	actionType types.ActionType,
+	address, operator sdk.AccAddress,
+	description string,
+) {
+	log := types.NewAuditLog(actionType, address, operator, description, ctx.BlockHeight())
+	log.ID = k.GetNextAuditLogID(ctx)
+	k.SetNextAuditLogID(ctx, log.ID+1)
+
+	k.SetAuditLog(ctx, log)
+}
+
+// SetAuditLog stores the audit log
+func (k Keeper) SetAuditLog(ctx sdk.Context, log types.AuditLog) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&log)
+	store.Set(types.GetAuditLogKey(log.ID), bz)
+}
+
+// IterateAuditLogs iterates through all audit logs ordered by the id
+func (k Keeper) IterateAuditLogs(
+	ctx sdk.Context,
+	op func(log types.AuditLog) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAuditLogsSubspaceKey())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var log types.AuditLog
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &log)
+
+		if stop := op(log); stop {
+			break
+		}
+	}
+}
+
+// GetPaginatedAuditLogs returns the audit logs ordered by the id
+func (k Keeper) GetPaginatedAuditLogs(
+	ctx sdk.Context,
+	pageReq *query.PageRequest,
+) ([]types.AuditLog, *query.PageResponse, error) {
+	var logs []types.AuditLog
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetAuditLogsSubspaceKey())
+	pageRes, err := pagination.Paginate(
+		pageReq, types.DefaultAuditLogsLimit,
+		func(pageKey []byte) sdk.Iterator {
+			return store.Iterator(pageKey, nil)
+		},
+		func(key, value []byte, accumulate bool) bool {
+			if accumulate {
+				var log types.AuditLog
+				k.cdc.MustUnmarshalBinaryBare(value, &log)
+				logs = append(logs, log)
+			}
+			return true
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return logs, pageRes, nil
+}
+
+// GetNextAuditLogID returns the id of the next audit log
+func (k Keeper) GetNextAuditLogID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextAuditLogKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextAuditLogID sets the id of the next audit log
+func (k Keeper) SetNextAuditLogID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextAuditLogKey, sdk.Uint64ToBigEndian(id))
+}
@@ -50,3 +50,59 @@ func (k Keeper) Trustees(c context.Context, req *types.QueryTrusteesRequest) (*t
 
 	return &types.QueryTrusteesResponse{Trustees: trustees}, nil
 }
+
+// PendingAction implements the Query/PendingAction gRPC method
+func (k Keeper) PendingAction(c context.Context, req *types.QueryPendingActionRequest) (*types.QueryPendingActionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	action, found := k.GetPendingAction(ctx, req.ID)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pending action %d not found", req.ID)
+	}
+
+	return &types.QueryPendingActionResponse{Action: action}, nil
+}
+
+// PendingActions implements the Query/PendingActions gRPC method
+func (k Keeper) PendingActions(c context.Context, req *types.QueryPendingActionsRequest) (*types.QueryPendingActionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	actions, pageRes, err := k.GetPaginatedPendingActions(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPendingActionsResponse{Actions: actions, Pagination: pageRes}, nil
+}
+
+// AuditLogs implements the Query/AuditLogs gRPC method
+func (k Keeper) AuditLogs(c context.Context, req *types.QueryAuditLogsRequest) (*types.QueryAuditLogsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	logs, pageRes, err := k.GetPaginatedAuditLogs(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAuditLogsResponse{Logs: logs, Pagination: pageRes}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParamSet(ctx)}, nil
+}
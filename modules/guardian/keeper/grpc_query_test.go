@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
@@ -48,3 +49,85 @@ func (suite *KeeperTestSuite) TestGRPCQueryTrustees() {
 	suite.Len(trusteesResp.Trustees, 1)
 	suite.Equal(guardian, trusteesResp.Trustees[0])
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryPendingActions() {
+	app, ctx := suite.app, suite.ctx
+	_, _, operator := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	app.GuardianKeeper.SetParamSet(ctx, types.NewParams(2))
+	app.GuardianKeeper.AddProfiler(ctx, types.NewGuardian("genesis", types.Genesis, operator, operator))
+	app.GuardianKeeper.AddTrustee(ctx, types.NewGuardian("genesis", types.Genesis, operator, operator))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	addGuardian := types.AddGuardian{Description: "test", Address: addr, AddedBy: operator}
+	profilerID, err := app.GuardianKeeper.SubmitAction(ctx, types.NewAddGuardianAction(types.ActionAddProfiler, addGuardian, ctx.BlockHeight()))
+	suite.Require().NoError(err)
+	_, err = app.GuardianKeeper.SubmitAction(ctx, types.NewAddGuardianAction(types.ActionAddTrustee, addGuardian, ctx.BlockHeight()))
+	suite.Require().NoError(err)
+
+	actionResp, err := queryClient.PendingAction(gocontext.Background(), &types.QueryPendingActionRequest{ID: profilerID})
+	suite.Require().NoError(err)
+	suite.Equal(types.ActionAddProfiler, actionResp.Action.ActionType)
+
+	_, err = queryClient.PendingAction(gocontext.Background(), &types.QueryPendingActionRequest{ID: profilerID + 2})
+	suite.Error(err)
+
+	actionsResp, err := queryClient.PendingActions(gocontext.Background(), &types.QueryPendingActionsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Len(actionsResp.Actions, 1)
+	suite.Equal(profilerID, actionsResp.Actions[0].ID)
+	suite.Equal(uint64(2), actionsResp.Pagination.Total)
+	suite.NotNil(actionsResp.Pagination.NextKey)
+
+	actionsResp, err = queryClient.PendingActions(gocontext.Background(), &types.QueryPendingActionsRequest{
+		Pagination: &query.PageRequest{Key: actionsResp.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Len(actionsResp.Actions, 1)
+	suite.Equal(types.ActionAddTrustee, actionsResp.Actions[0].ActionType)
+	suite.Nil(actionsResp.Pagination.NextKey)
+
+	paramsResp, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(uint32(2), paramsResp.Params.ApprovalThreshold)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryAuditLogs() {
+	app, ctx := suite.app, suite.ctx
+	_, _, operator := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GuardianKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	logsResp, err := queryClient.AuditLogs(gocontext.Background(), &types.QueryAuditLogsRequest{})
+	suite.Require().NoError(err)
+	suite.Len(logsResp.Logs, 0)
+
+	app.GuardianKeeper.AppendAuditLog(ctx, types.ActionAddTrustee, addr, operator, "test")
+	app.GuardianKeeper.AppendAuditLog(ctx, types.ActionDeleteTrustee, addr, operator, "test")
+
+	logsResp, err = queryClient.AuditLogs(gocontext.Background(), &types.QueryAuditLogsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Len(logsResp.Logs, 1)
+	suite.Equal(types.ActionAddTrustee, logsResp.Logs[0].ActionType)
+	suite.Equal(uint64(2), logsResp.Pagination.Total)
+	suite.NotNil(logsResp.Pagination.NextKey)
+
+	logsResp, err = queryClient.AuditLogs(gocontext.Background(), &types.QueryAuditLogsRequest{
+		Pagination: &query.PageRequest{Key: logsResp.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Len(logsResp.Logs, 1)
+	suite.Equal(types.ActionDeleteTrustee, logsResp.Logs[0].ActionType)
+	suite.Nil(logsResp.Pagination.NextKey)
+}
@@ -7,21 +7,24 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
 
 // Keeper of the guardian store
 type Keeper struct {
-	cdc      codec.Marshaler
-	storeKey sdk.StoreKey
+	cdc        codec.Marshaler
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
 }
 
 // NewKeeper returns a guardian keeper
-func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey) Keeper {
+func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
 	keeper := Keeper{
-		storeKey: key,
-		cdc:      cdc,
+		storeKey:   key,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
 	}
 	return keeper
 }
@@ -116,3 +119,33 @@ func (k Keeper) IterateTrustees(
 		}
 	}
 }
+
+// HasPermission returns true if the address is an unexpired profiler or trustee assigned the role named perm,
+// the genesis guardians hold all the permissions
+func (k Keeper) HasPermission(ctx sdk.Context, addr sdk.AccAddress, perm string) bool {
+	if profiler, found := k.GetProfiler(ctx, addr); found && k.isPermitted(ctx, profiler, perm) {
+		return true
+	}
+	if trustee, found := k.GetTrustee(ctx, addr); found && k.isPermitted(ctx, trustee, perm) {
+		return true
+	}
+	return false
+}
+
+// isPermitted returns true if the guardian has not expired and is assigned the role named perm,
+// the expired guardians are only removed at the end of the block
+func (k Keeper) isPermitted(ctx sdk.Context, guardian types.Guardian, perm string) bool {
+	return !guardian.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) && guardian.HasRole(perm)
+}
+
+// GetParamSet returns the guardian module parameters
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParamSet sets the guardian module parameters
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
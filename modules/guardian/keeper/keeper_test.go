@@ -13,6 +13,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/irisnet/irishub/modules/guardian/keeper"
 	"github.com/irisnet/irishub/modules/guardian/types"
@@ -24,11 +26,13 @@ var (
 		newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB50"),
 		newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB51"),
 		newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB52"),
+		newPubKey("0B485CFC0EECC619440448436F8FC9DF40566F2369E72400281454CB552AFB53"),
 	}
 	addrs = []sdk.AccAddress{
 		sdk.AccAddress(pks[0].Address()),
 		sdk.AccAddress(pks[1].Address()),
 		sdk.AccAddress(pks[2].Address()),
+		sdk.AccAddress(pks[3].Address()),
 	}
 )
 
@@ -121,6 +125,54 @@ func (suite *KeeperTestSuite) TestDeleteTrustee() {
 
 }
 
+func (suite *KeeperTestSuite) TestHasPermission() {
+	genesis := types.NewGuardian("test", types.Genesis, addrs[0], addrs[0])
+	profiler := types.NewGuardian("test", types.Ordinary, addrs[1], addrs[0], types.RoleFeedAdmin)
+	trustee := types.NewGuardian("test", types.Ordinary, addrs[2], addrs[0])
+
+	suite.keeper.AddProfiler(suite.ctx, genesis)
+	suite.keeper.AddProfiler(suite.ctx, profiler)
+	suite.keeper.AddTrustee(suite.ctx, trustee)
+
+	// the genesis guardians hold all the permissions
+	suite.True(suite.keeper.HasPermission(suite.ctx, addrs[0], types.RoleFeedAdmin))
+
+	suite.True(suite.keeper.HasPermission(suite.ctx, addrs[1], types.RoleFeedAdmin))
+	suite.False(suite.keeper.HasPermission(suite.ctx, addrs[2], types.RoleFeedAdmin))
+
+	// the expired guardians lose the permissions before they are removed
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	suite.keeper.AddProfiler(suite.ctx, profiler.WithExpiry(10, nil))
+	suite.False(suite.keeper.HasPermission(suite.ctx, addrs[1], types.RoleFeedAdmin))
+
+	suite.keeper.AddProfiler(suite.ctx, profiler)
+	suite.keeper.DeleteProfiler(suite.ctx, addrs[1])
+	suite.False(suite.keeper.HasPermission(suite.ctx, addrs[1], types.RoleFeedAdmin))
+}
+
+func (suite *KeeperTestSuite) TestMigrateProfilerRoles() {
+	genesis := types.NewGuardian("genesis", types.Genesis, addrs[0], addrs[0])
+	legacy := types.NewGuardian("legacy", types.Ordinary, addrs[1], addrs[0])
+	trustee := types.NewGuardian("trustee", types.Ordinary, addrs[2], addrs[0])
+
+	suite.keeper.AddProfiler(suite.ctx, genesis)
+	suite.keeper.AddProfiler(suite.ctx, legacy)
+	suite.keeper.AddTrustee(suite.ctx, trustee)
+	suite.False(suite.keeper.HasPermission(suite.ctx, addrs[1], types.RoleFeedAdmin))
+
+	suite.keeper.MigrateProfilerRoles(suite.ctx)
+
+	// only the ordinary profilers without any role are granted the feed-admin role
+	migrated, _ := suite.keeper.GetProfiler(suite.ctx, addrs[1])
+	suite.Equal([]string{types.RoleFeedAdmin}, migrated.Roles)
+	suite.True(suite.keeper.HasPermission(suite.ctx, addrs[1], types.RoleFeedAdmin))
+
+	unchanged, _ := suite.keeper.GetProfiler(suite.ctx, addrs[0])
+	suite.Empty(unchanged.Roles)
+	unchanged, _ = suite.keeper.GetTrustee(suite.ctx, addrs[2])
+	suite.Empty(unchanged.Roles)
+}
+
 func (suite *KeeperTestSuite) TestQueryProfilers() {
 	profiler := types.NewGuardian("test", types.Genesis, addrs[0], addrs[1])
 	suite.keeper.AddProfiler(suite.ctx, profiler)
@@ -151,6 +203,240 @@ func (suite *KeeperTestSuite) TestQueryTrustees() {
 	suite.Contains(trustees, trustee)
 }
 
+func (suite *KeeperTestSuite) TestGuardianProposals() {
+	profiler := types.NewGuardian("test", types.Genesis, addrs[0], addrs[1])
+	suite.keeper.AddProfiler(suite.ctx, profiler)
+
+	addProposal := types.NewAddGuardianProposal("title", "description", types.Profiler, addrs[2], "profiler")
+	err := keeper.HandleAddGuardianProposal(suite.ctx, suite.keeper, addProposal)
+	suite.NoError(err)
+
+	addedProfiler, found := suite.keeper.GetProfiler(suite.ctx, addrs[2])
+	suite.True(found)
+	suite.Equal(types.Ordinary, addedProfiler.AccountType)
+	suite.Equal(authtypes.NewModuleAddress(govtypes.ModuleName), addedProfiler.AddedBy)
+
+	err = keeper.HandleAddGuardianProposal(suite.ctx, suite.keeper, addProposal)
+	suite.Error(err)
+
+	addProposal = types.NewAddGuardianProposal("title", "description", types.Trustee, addrs[2], "trustee")
+	err = keeper.HandleAddGuardianProposal(suite.ctx, suite.keeper, addProposal)
+	suite.NoError(err)
+
+	_, found = suite.keeper.GetTrustee(suite.ctx, addrs[2])
+	suite.True(found)
+
+	// a genesis profiler can be added by governance as well
+	addProposal = types.NewAddGuardianProposal("title", "description", types.Profiler, addrs[3], "genesis profiler")
+	addProposal.Genesis = true
+	err = keeper.HandleAddGuardianProposal(suite.ctx, suite.keeper, addProposal)
+	suite.NoError(err)
+
+	addedProfiler, found = suite.keeper.GetProfiler(suite.ctx, addrs[3])
+	suite.True(found)
+	suite.Equal(types.Genesis, addedProfiler.AccountType)
+
+	// the genesis profiler can be deleted by governance
+	deleteProposal := types.NewDeleteGuardianProposal("title", "description", types.Profiler, addrs[0])
+	err = keeper.HandleDeleteGuardianProposal(suite.ctx, suite.keeper, deleteProposal)
+	suite.NoError(err)
+
+	_, found = suite.keeper.GetProfiler(suite.ctx, addrs[0])
+	suite.False(found)
+
+	err = keeper.HandleDeleteGuardianProposal(suite.ctx, suite.keeper, deleteProposal)
+	suite.Error(err)
+
+	deleteProposal = types.NewDeleteGuardianProposal("title", "description", types.Trustee, addrs[2])
+	err = keeper.HandleDeleteGuardianProposal(suite.ctx, suite.keeper, deleteProposal)
+	suite.NoError(err)
+
+	_, found = suite.keeper.GetTrustee(suite.ctx, addrs[2])
+	suite.False(found)
+
+	// the roles of a guardian are granted and revoked by governance
+	rolesProposal := types.NewUpdateGuardianRolesProposal("title", "description", types.Profiler, addrs[2], types.RoleFeedAdmin)
+	err = keeper.HandleUpdateGuardianRolesProposal(suite.ctx, suite.keeper, rolesProposal)
+	suite.NoError(err)
+	suite.True(suite.keeper.HasPermission(suite.ctx, addrs[2], types.RoleFeedAdmin))
+
+	rolesProposal = types.NewUpdateGuardianRolesProposal("title", "description", types.Profiler, addrs[2])
+	err = keeper.HandleUpdateGuardianRolesProposal(suite.ctx, suite.keeper, rolesProposal)
+	suite.NoError(err)
+	suite.False(suite.keeper.HasPermission(suite.ctx, addrs[2], types.RoleFeedAdmin))
+
+	rolesProposal = types.NewUpdateGuardianRolesProposal("title", "description", types.Trustee, addrs[2], types.RoleFeedAdmin)
+	err = keeper.HandleUpdateGuardianRolesProposal(suite.ctx, suite.keeper, rolesProposal)
+	suite.Error(err)
+
+	// the event of a trustee carries the trustee address
+	suite.keeper.AddTrustee(suite.ctx, types.NewGuardian("trustee", types.Ordinary, addrs[1], addrs[0]))
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	rolesProposal = types.NewUpdateGuardianRolesProposal("title", "description", types.Trustee, addrs[1], types.RoleFeedAdmin)
+	err = keeper.HandleUpdateGuardianRolesProposal(ctx, suite.keeper, rolesProposal)
+	suite.NoError(err)
+
+	events := ctx.EventManager().Events()
+	suite.Len(events, 1)
+	suite.Equal(types.EventTypeUpdateRoles, events[0].Type)
+	suite.Equal(types.AttributeKeyTrusteeAddress, string(events[0].Attributes[1].Key))
+	suite.Equal(addrs[1].String(), string(events[0].Attributes[1].Value))
+}
+
+func (suite *KeeperTestSuite) TestGuardianActions() {
+	suite.keeper.SetParamSet(suite.ctx, types.NewParams(2))
+	suite.keeper.AddProfiler(suite.ctx, types.NewGuardian("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddProfiler(suite.ctx, types.NewGuardian("ordinary", types.Ordinary, addrs[1], addrs[0]))
+
+	// the action waits for the second approval
+	addGuardian := types.AddGuardian{Description: "profiler", Address: addrs[2], AddedBy: addrs[0]}
+	id, err := suite.keeper.SubmitAction(suite.ctx, types.NewAddGuardianAction(types.ActionAddProfiler, addGuardian, suite.ctx.BlockHeight()))
+	suite.NoError(err)
+
+	action, found := suite.keeper.GetPendingAction(suite.ctx, id)
+	suite.True(found)
+	suite.Equal([]sdk.AccAddress{addrs[0]}, action.Approvals)
+	_, found = suite.keeper.GetProfiler(suite.ctx, addrs[2])
+	suite.False(found)
+
+	err = suite.keeper.ApproveAction(suite.ctx, id, addrs[0])
+	suite.Error(err)
+	err = suite.keeper.ApproveAction(suite.ctx, id, addrs[3])
+	suite.Error(err)
+	err = suite.keeper.ApproveAction(suite.ctx, id+1, addrs[1])
+	suite.Error(err)
+
+	// the threshold is reached
+	err = suite.keeper.ApproveAction(suite.ctx, id, addrs[1])
+	suite.NoError(err)
+
+	_, found = suite.keeper.GetPendingAction(suite.ctx, id)
+	suite.False(found)
+	profiler, found := suite.keeper.GetProfiler(suite.ctx, addrs[2])
+	suite.True(found)
+	suite.Equal(types.Ordinary, profiler.AccountType)
+
+	// the action is discarded by the rejections
+	deleteGuardian := types.DeleteGuardian{Address: addrs[2], DeletedBy: addrs[0]}
+	id, err = suite.keeper.SubmitAction(suite.ctx, types.NewDeleteGuardianAction(types.ActionDeleteProfiler, deleteGuardian, suite.ctx.BlockHeight()))
+	suite.NoError(err)
+
+	err = suite.keeper.RejectAction(suite.ctx, id, addrs[1])
+	suite.NoError(err)
+	err = suite.keeper.RejectAction(suite.ctx, id, addrs[1])
+	suite.Error(err)
+	_, found = suite.keeper.GetPendingAction(suite.ctx, id)
+	suite.True(found)
+
+	err = suite.keeper.RejectAction(suite.ctx, id, addrs[2])
+	suite.NoError(err)
+	_, found = suite.keeper.GetPendingAction(suite.ctx, id)
+	suite.False(found)
+	_, found = suite.keeper.GetProfiler(suite.ctx, addrs[2])
+	suite.True(found)
+
+	// the actions which can not be applied are not stored
+	_, err = suite.keeper.SubmitAction(suite.ctx, types.NewAddGuardianAction(types.ActionAddProfiler, addGuardian, suite.ctx.BlockHeight()))
+	suite.Error(err)
+	deleteGuardian = types.DeleteGuardian{Address: addrs[0], DeletedBy: addrs[0]}
+	_, err = suite.keeper.SubmitAction(suite.ctx, types.NewDeleteGuardianAction(types.ActionDeleteProfiler, deleteGuardian, suite.ctx.BlockHeight()))
+	suite.Error(err)
+
+	// the action which no longer applies when the threshold is reached is discarded
+	deleteGuardian = types.DeleteGuardian{Address: addrs[2], DeletedBy: addrs[0]}
+	id, err = suite.keeper.SubmitAction(suite.ctx, types.NewDeleteGuardianAction(types.ActionDeleteProfiler, deleteGuardian, suite.ctx.BlockHeight()))
+	suite.NoError(err)
+	suite.keeper.DeleteProfiler(suite.ctx, addrs[2])
+
+	err = suite.keeper.ApproveAction(suite.ctx, id, addrs[1])
+	suite.NoError(err)
+	_, found = suite.keeper.GetPendingAction(suite.ctx, id)
+	suite.False(found)
+
+	// the threshold is capped at the number of the eligible guardians
+	suite.keeper.SetParamSet(suite.ctx, types.NewParams(5))
+	addGuardian = types.AddGuardian{Description: "profiler", Address: addrs[3], AddedBy: addrs[0]}
+	id, err = suite.keeper.SubmitAction(suite.ctx, types.NewAddGuardianAction(types.ActionAddProfiler, addGuardian, suite.ctx.BlockHeight()))
+	suite.NoError(err)
+
+	err = suite.keeper.ApproveAction(suite.ctx, id, addrs[1])
+	suite.NoError(err)
+	_, found = suite.keeper.GetPendingAction(suite.ctx, id)
+	suite.False(found)
+	_, found = suite.keeper.GetProfiler(suite.ctx, addrs[3])
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestGuardianActionVoters() {
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	suite.keeper.SetParamSet(suite.ctx, types.NewParams(2))
+	suite.keeper.AddProfiler(suite.ctx, types.NewGuardian("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddProfiler(suite.ctx, types.NewGuardian("ordinary", types.Ordinary, addrs[1], addrs[0]))
+	suite.keeper.AddProfiler(suite.ctx, types.NewGuardian("ordinary", types.Ordinary, addrs[2], addrs[0]))
+
+	addGuardian := types.AddGuardian{Description: "profiler", Address: addrs[3], AddedBy: addrs[0]}
+	id, err := suite.keeper.SubmitAction(suite.ctx, types.NewAddGuardianAction(types.ActionAddProfiler, addGuardian, suite.ctx.BlockHeight()))
+	suite.NoError(err)
+
+	// the vote of the deleted operator is not counted
+	suite.keeper.DeleteProfiler(suite.ctx, addrs[0])
+	err = suite.keeper.ApproveAction(suite.ctx, id, addrs[1])
+	suite.NoError(err)
+	_, found := suite.keeper.GetPendingAction(suite.ctx, id)
+	suite.True(found)
+	_, found = suite.keeper.GetProfiler(suite.ctx, addrs[3])
+	suite.False(found)
+
+	// the expired guardians can not vote
+	suite.keeper.AddProfiler(suite.ctx, types.NewGuardian("ordinary", types.Ordinary, addrs[2], addrs[0]).WithExpiry(10, nil))
+	err = suite.keeper.ApproveAction(suite.ctx, id, addrs[2])
+	suite.Error(err)
+
+	suite.keeper.AddProfiler(suite.ctx, types.NewGuardian("ordinary", types.Ordinary, addrs[2], addrs[0]))
+	err = suite.keeper.ApproveAction(suite.ctx, id, addrs[2])
+	suite.NoError(err)
+	_, found = suite.keeper.GetPendingAction(suite.ctx, id)
+	suite.False(found)
+	_, found = suite.keeper.GetProfiler(suite.ctx, addrs[3])
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestAuditLogs() {
+	suite.keeper.AddProfiler(suite.ctx, types.NewGuardian("genesis", types.Genesis, addrs[0], addrs[0]))
+
+	addGuardian := types.AddGuardian{Description: "profiler", Address: addrs[1], AddedBy: addrs[0]}
+	_, err := suite.keeper.SubmitAction(suite.ctx, types.NewAddGuardianAction(types.ActionAddProfiler, addGuardian, suite.ctx.BlockHeight()))
+	suite.NoError(err)
+
+	deleteProposal := types.NewDeleteGuardianProposal("title", "description", types.Profiler, addrs[1])
+	err = keeper.HandleDeleteGuardianProposal(suite.ctx, suite.keeper, deleteProposal)
+	suite.NoError(err)
+
+	// the failed operations are not recorded
+	err = keeper.HandleDeleteGuardianProposal(suite.ctx, suite.keeper, deleteProposal)
+	suite.Error(err)
+
+	var logs []types.AuditLog
+	suite.keeper.IterateAuditLogs(
+		suite.ctx,
+		func(log types.AuditLog) bool {
+			logs = append(logs, log)
+			return false
+		},
+	)
+
+	suite.Len(logs, 2)
+	suite.Equal(types.ActionAddProfiler, logs[0].ActionType)
+	suite.Equal(addrs[1], logs[0].Address)
+	suite.Equal(addrs[0], logs[0].Operator)
+	suite.Equal("profiler", logs[0].Description)
+	suite.Equal(suite.ctx.BlockHeight(), logs[0].Height)
+	suite.Equal(types.ActionDeleteProfiler, logs[1].ActionType)
+	suite.Equal(authtypes.NewModuleAddress(govtypes.ModuleName), logs[1].Operator)
+	suite.Equal("profiler", logs[1].Description)
+	suite.Equal(logs[0].ID+1, logs[1].ID)
+}
+
 func newPubKey(pk string) (res crypto.PubKey) {
 	pkBytes, err := hex.DecodeString(pk)
 	if err != nil {
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// MigrateProfilerRoles grants the feed-admin role to the ordinary profilers without any role,
+// who were allowed to manage the oracle feeds before the guardian roles were introduced
+func (k Keeper) MigrateProfilerRoles(ctx sdk.Context) {
+	var profilers []types.Guardian
+	k.IterateProfilers(
+		ctx,
+		func(profiler types.Guardian) bool {
+			if profiler.AccountType == types.Ordinary && len(profiler.Roles) == 0 {
+				profilers = append(profilers, profiler)
+			}
+			return false
+		},
+	)
+
+	for _, profiler := range profilers {
+		profiler.Roles = []string{types.RoleFeedAdmin}
+		k.AddProfiler(ctx, profiler)
+	}
+}
+
+// MigrateParams sets the parameters introduced since the parameters were stored to the default values,
+// such as the approval threshold of the pending actions
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
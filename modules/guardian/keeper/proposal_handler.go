@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// HandleAddGuardianProposal adds the profiler or the trustee of the passed proposal, the guardian
+// is recorded as added by the gov module account, and is a genesis one if the proposal says so,
+// which allows to restore the genesis guardians after they are rotated by proposals
+func HandleAddGuardianProposal(ctx sdk.Context, k Keeper, p *types.AddGuardianProposal) error {
+	addedBy := authtypes.NewModuleAddress(govtypes.ModuleName)
+	guardian := types.NewGuardian(
+		p.GuardianDescription, p.AccountType(), p.Address, addedBy, p.Roles...,
+	).WithExpiry(p.ExpiryHeight, p.ExpiryTime)
+	if guardian.IsExpired(ctx.BlockHeight(), ctx.BlockTime()) {
+		return sdkerrors.Wrap(types.ErrInvalidExpiry, "the expiry must be later than the current block")
+	}
+
+	var event sdk.Event
+	switch p.GuardianType {
+	case types.Profiler:
+		if _, found := k.GetProfiler(ctx, p.Address); found {
+			return sdkerrors.Wrap(types.ErrProfilerExists, p.Address.String())
+		}
+		k.AddProfiler(ctx, guardian)
+		k.AppendAuditLog(ctx, types.ActionAddProfiler, p.Address, addedBy, p.GuardianDescription)
+
+		event = sdk.NewEvent(
+			types.EventTypeAddProfiler,
+			sdk.NewAttribute(types.AttributeKeyProfilerAddress, p.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyAddedBy, addedBy.String()),
+		)
+	case types.Trustee:
+		if _, found := k.GetTrustee(ctx, p.Address); found {
+			return sdkerrors.Wrap(types.ErrTrusteeExists, p.Address.String())
+		}
+		k.AddTrustee(ctx, guardian)
+		k.AppendAuditLog(ctx, types.ActionAddTrustee, p.Address, addedBy, p.GuardianDescription)
+
+		event = sdk.NewEvent(
+			types.EventTypeAddTrustee,
+			sdk.NewAttribute(types.AttributeKeyTrusteeAddress, p.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyAddedBy, addedBy.String()),
+		)
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid guardian type: %d", p.GuardianType)
+	}
+
+	ctx.EventManager().EmitEvent(event)
+
+	k.Logger(ctx).Info("add guardian by proposal", "type", p.GuardianType.String(), "account_type", guardian.AccountType.String(), "address", p.Address.String())
+	return nil
+}
+
+// HandleDeleteGuardianProposal deletes the profiler or the trustee of the passed proposal,
+// genesis guardians can be deleted as well so that the community is able to rotate them
+func HandleDeleteGuardianProposal(ctx sdk.Context, k Keeper, p *types.DeleteGuardianProposal) error {
+	deletedBy := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	var event sdk.Event
+	switch p.GuardianType {
+	case types.Profiler:
+		profiler, found := k.GetProfiler(ctx, p.Address)
+		if !found {
+			return sdkerrors.Wrap(types.ErrUnknownProfiler, p.Address.String())
+		}
+		k.DeleteProfiler(ctx, p.Address)
+		k.AppendAuditLog(ctx, types.ActionDeleteProfiler, p.Address, deletedBy, profiler.Description)
+
+		event = sdk.NewEvent(
+			types.EventTypeDeleteProfiler,
+			sdk.NewAttribute(types.AttributeKeyProfilerAddress, p.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyDeletedBy, deletedBy.String()),
+		)
+	case types.Trustee:
+		trustee, found := k.GetTrustee(ctx, p.Address)
+		if !found {
+			return sdkerrors.Wrap(types.ErrUnknownTrustee, p.Address.String())
+		}
+		k.DeleteTrustee(ctx, p.Address)
+		k.AppendAuditLog(ctx, types.ActionDeleteTrustee, p.Address, deletedBy, trustee.Description)
+
+		event = sdk.NewEvent(
+			types.EventTypeDeleteTrustee,
+			sdk.NewAttribute(types.AttributeKeyTrusteeAddress, p.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyDeletedBy, deletedBy.String()),
+		)
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid guardian type: %d", p.GuardianType)
+	}
+
+	ctx.EventManager().EmitEvent(event)
+
+	k.Logger(ctx).Info("delete guardian by proposal", "type", p.GuardianType.String(), "address", p.Address.String())
+	return nil
+}
+
+// HandleUpdateGuardianRolesProposal replaces the roles of the profiler or the trustee of the passed proposal,
+// which grants and revokes the permissions of an ordinary guardian
+func HandleUpdateGuardianRolesProposal(ctx sdk.Context, k Keeper, p *types.UpdateGuardianRolesProposal) error {
+	var guardian types.Guardian
+	var found bool
+	switch p.GuardianType {
+	case types.Profiler:
+		if guardian, found = k.GetProfiler(ctx, p.Address); !found {
+			return sdkerrors.Wrap(types.ErrUnknownProfiler, p.Address.String())
+		}
+	case types.Trustee:
+		if guardian, found = k.GetTrustee(ctx, p.Address); !found {
+			return sdkerrors.Wrap(types.ErrUnknownTrustee, p.Address.String())
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid guardian type: %d", p.GuardianType)
+	}
+
+	guardian.Roles = p.Roles
+	addressKey := types.AttributeKeyProfilerAddress
+	if p.GuardianType == types.Profiler {
+		k.AddProfiler(ctx, guardian)
+	} else {
+		k.AddTrustee(ctx, guardian)
+		addressKey = types.AttributeKeyTrusteeAddress
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRoles,
+			sdk.NewAttribute(types.AttributeKeyGuardianType, p.GuardianType.String()),
+			sdk.NewAttribute(addressKey, p.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyRoles, strings.Join(p.Roles, ",")),
+		),
+	)
+
+	k.Logger(ctx).Info("update guardian roles by proposal", "type", p.GuardianType.String(), "address", p.Address.String())
+	return nil
+}
@@ -6,18 +6,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
 
 // NewQuerier creates a querier for guardian REST endpoints
 func NewQuerier(k Keeper, legacyQuerierCdc codec.JSONMarshaler) sdk.Querier {
-	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		switch path[0] {
 		case types.QueryProfilers:
 			return queryProfilers(ctx, k, legacyQuerierCdc)
 		case types.QueryTrustees:
 			return queryTrustees(ctx, k, legacyQuerierCdc)
+		case types.QueryPendingAction:
+			return queryPendingAction(ctx, req, k, legacyQuerierCdc)
+		case types.QueryPendingActions:
+			return queryPendingActions(ctx, req, k, legacyQuerierCdc)
+		case types.QueryAuditLogs:
+			return queryAuditLogs(ctx, req, k, legacyQuerierCdc)
+		case types.QueryParams:
+			return queryParams(ctx, k, legacyQuerierCdc)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -57,3 +66,77 @@ func queryTrustees(ctx sdk.Context, k Keeper, legacyQuerierCdc codec.JSONMarshal
 	}
 	return bz, nil
 }
+
+func queryPendingAction(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryPendingActionParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	action, found := k.GetPendingAction(ctx, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownAction, "%d", params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, action)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPendingActions(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryPendingActionsParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	pageReq := &query.PageRequest{Limit: params.Limit}
+	if params.Page > 1 {
+		pageReq.Offset = (params.Page - 1) * params.Limit
+	}
+
+	actions, _, err := k.GetPaginatedPendingActions(ctx, pageReq)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, actions)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryAuditLogs(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryAuditLogsParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	pageReq := &query.PageRequest{Limit: params.Limit}
+	if params.Page > 1 {
+		pageReq.Offset = (params.Page - 1) * params.Limit
+	}
+
+	logs, _, err := k.GetPaginatedAuditLogs(ctx, pageReq)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, logs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	params := k.GetParamSet(ctx)
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
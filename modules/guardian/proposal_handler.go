@@ -0,0 +1,26 @@
+package guardian
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// NewGuardianProposalHandler returns a handler for the guardian governance proposals
+func NewGuardianProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddGuardianProposal:
+			return keeper.HandleAddGuardianProposal(ctx, k, c)
+		case *types.DeleteGuardianProposal:
+			return keeper.HandleDeleteGuardianProposal(ctx, k, c)
+		case *types.UpdateGuardianRolesProposal:
+			return keeper.HandleUpdateGuardianRolesProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized guardian proposal content type: %T", c)
+		}
+	}
+}
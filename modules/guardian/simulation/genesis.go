@@ -0,0 +1,52 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// Simulation parameter constants
+const (
+	GenesisGuardians = "genesis_guardians"
+
+	GuardianDescription = "guardian simulation account"
+)
+
+// RandomGenesisGuardians returns the number of the random accounts which are the genesis guardians
+func RandomGenesisGuardians(r *rand.Rand) int {
+	return simtypes.RandIntBetween(r, 1, 4)
+}
+
+// RandomizedGenState generates a random GenesisState for guardian, some random accounts are the
+// genesis profilers with the feed admin role and the genesis trustees, so that the simulated
+// operations of the other modules can add the guardians they need by the guardian msgs
+func RandomizedGenState(simState *module.SimulationState) {
+	var genesisGuardians int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GenesisGuardians, &genesisGuardians, simState.Rand,
+		func(r *rand.Rand) { genesisGuardians = RandomGenesisGuardians(r) },
+	)
+	if genesisGuardians > len(simState.Accounts) {
+		genesisGuardians = len(simState.Accounts)
+	}
+
+	var profilers, trustees []types.Guardian
+	for _, i := range simState.Rand.Perm(len(simState.Accounts))[:genesisGuardians] {
+		address := simState.Accounts[i].Address
+		profilers = append(profilers, types.NewGuardian(GuardianDescription, types.Genesis, address, address, types.RoleFeedAdmin))
+		trustees = append(trustees, types.NewGuardian(GuardianDescription, types.Genesis, address, address))
+	}
+
+	guardianGenesis := types.NewGenesisState(profilers, trustees, types.DefaultParams(), nil, nil)
+
+	fmt.Printf("Selected randomly generated guardian genesis:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, guardianGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(guardianGenesis)
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewAddGuardianAction constructs a pending action to add the guardian, approved by the operator
+func NewAddGuardianAction(actionType ActionType, addGuardian AddGuardian, height int64) PendingAction {
+	return PendingAction{
+		ActionType:  actionType,
+		AddGuardian: &addGuardian,
+		Approvals:   []sdk.AccAddress{addGuardian.AddedBy},
+		Height:      height,
+	}
+}
+
+// NewDeleteGuardianAction constructs a pending action to delete the guardian, approved by the operator
+func NewDeleteGuardianAction(actionType ActionType, deleteGuardian DeleteGuardian, height int64) PendingAction {
+	return PendingAction{
+		ActionType:     actionType,
+		DeleteGuardian: &deleteGuardian,
+		Approvals:      []sdk.AccAddress{deleteGuardian.DeletedBy},
+		Height:         height,
+	}
+}
+
+// GuardianType returns the type of the guardians the action operates on
+func (t ActionType) GuardianType() GuardianType {
+	if t == ActionAddTrustee || t == ActionDeleteTrustee {
+		return Trustee
+	}
+	return Profiler
+}
+
+// IsAdd returns true if the action adds a guardian
+func (t ActionType) IsAdd() bool {
+	return t == ActionAddProfiler || t == ActionAddTrustee
+}
+
+// ValidActionType returns true if the action type is valid and false otherwise
+func ValidActionType(t ActionType) bool {
+	_, ok := ActionType_name[int32(t)]
+	return ok
+}
+
+// Operator returns the address which submitted the action
+func (a PendingAction) Operator() sdk.AccAddress {
+	if a.AddGuardian != nil {
+		return a.AddGuardian.AddedBy
+	}
+	if a.DeleteGuardian != nil {
+		return a.DeleteGuardian.DeletedBy
+	}
+	return nil
+}
+
+// Address returns the address of the guardian the action operates on
+func (a PendingAction) Address() sdk.AccAddress {
+	if a.AddGuardian != nil {
+		return a.AddGuardian.Address
+	}
+	if a.DeleteGuardian != nil {
+		return a.DeleteGuardian.Address
+	}
+	return nil
+}
+
+// HasVoted returns true if the address has approved or rejected the action
+func (a PendingAction) HasVoted(addr sdk.AccAddress) bool {
+	for _, voters := range [][]sdk.AccAddress{a.Approvals, a.Rejections} {
+		for _, voter := range voters {
+			if voter.Equals(addr) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Validate checks that the action carries the operation matching its type
+func (a PendingAction) Validate() error {
+	if !ValidActionType(a.ActionType) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid action type: %d", a.ActionType)
+	}
+	if a.ActionType.IsAdd() {
+		if a.AddGuardian == nil || a.DeleteGuardian != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "action %d must only add a guardian", a.ID)
+		}
+		return a.AddGuardian.ValidateBasic()
+	}
+	if a.DeleteGuardian == nil || a.AddGuardian != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "action %d must only delete a guardian", a.ID)
+	}
+	return a.DeleteGuardian.ValidateBasic()
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewAuditLog constructs an audit log of the add or delete operation
+func NewAuditLog(
+	actionType ActionType,
+	address, operator sdk.AccAddress,
+	description string,
+	height int64,
+) AuditLog {
+	return AuditLog{
+		ActionType:  actionType,
+		Address:     address,
+		Operator:    operator,
+		Description: description,
+		Height:      height,
+	}
+}
+
+// Validate checks the operation and the accounts of the audit log
+func (l AuditLog) Validate() error {
+	if !ValidActionType(l.ActionType) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid action type: %d", l.ActionType)
+	}
+	if len(l.Address) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "audit log %d: guardian address missing", l.ID)
+	}
+	if len(l.Operator) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "audit log %d: operator address missing", l.ID)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterCodec registers the necessary module/guardian interfaces and concrete types
@@ -15,6 +16,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddTrustee{}, "irishub/guardian/MsgAddTrustee", nil)
 	cdc.RegisterConcrete(&MsgDeleteProfiler{}, "irishub/guardian/MsgDeleteProfiler", nil)
 	cdc.RegisterConcrete(&MsgDeleteTrustee{}, "irishub/guardian/MsgDeleteTrustee", nil)
+	cdc.RegisterConcrete(&MsgApproveGuardianAction{}, "irishub/guardian/MsgApproveGuardianAction", nil)
+	cdc.RegisterConcrete(&MsgRejectGuardianAction{}, "irishub/guardian/MsgRejectGuardianAction", nil)
+	cdc.RegisterConcrete(&AddGuardianProposal{}, "irishub/guardian/AddGuardianProposal", nil)
+	cdc.RegisterConcrete(&DeleteGuardianProposal{}, "irishub/guardian/DeleteGuardianProposal", nil)
+	cdc.RegisterConcrete(&UpdateGuardianRolesProposal{}, "irishub/guardian/UpdateGuardianRolesProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -23,6 +29,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAddTrustee{},
 		&MsgDeleteProfiler{},
 		&MsgDeleteTrustee{},
+		&MsgApproveGuardianAction{},
+		&MsgRejectGuardianAction{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AddGuardianProposal{},
+		&DeleteGuardianProposal{},
+		&UpdateGuardianRolesProposal{},
 	)
 
 	registry.RegisterInterface(
@@ -13,4 +13,9 @@ var (
 	ErrTrusteeExists         = sdkerrors.Register(ModuleName, 6, "trustee already exists")
 	ErrDeleteGenesisProfiler = sdkerrors.Register(ModuleName, 7, "can't delete genesis profiler")
 	ErrDeleteGenesisTrustee  = sdkerrors.Register(ModuleName, 8, "can't delete genesis trustee")
+	ErrInvalidRole           = sdkerrors.Register(ModuleName, 9, "invalid role")
+	ErrInvalidExpiry         = sdkerrors.Register(ModuleName, 10, "invalid expiry")
+	ErrUnknownAction         = sdkerrors.Register(ModuleName, 11, "unknown guardian action")
+	ErrDuplicateVote         = sdkerrors.Register(ModuleName, 12, "guardian has already voted on the action")
+	ErrInvalidParams         = sdkerrors.Register(ModuleName, 13, "invalid params")
 )
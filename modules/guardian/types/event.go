@@ -7,11 +7,27 @@ const (
 	EventTypeAddTrustee     = "add_trustee"
 	EventTypeDeleteProfiler = "delete_profiler"
 	EventTypeDeleteTrustee  = "delete_trustee"
+	EventTypeExpireProfiler = "expire_profiler"
+	EventTypeExpireTrustee  = "expire_trustee"
+	EventTypeUpdateRoles    = "update_guardian_roles"
+
+	EventTypeSubmitGuardianAction  = "submit_guardian_action"
+	EventTypeApproveGuardianAction = "approve_guardian_action"
+	EventTypeRejectGuardianAction  = "reject_guardian_action"
+	EventTypeDiscardGuardianAction = "discard_guardian_action"
 
 	AttributeKeyProfilerAddress = "address"
 	AttributeKeyTrusteeAddress  = "address"
 	AttributeKeyAddedBy         = "added_by"
 	AttributeKeyDeletedBy       = "deleted_by"
+	AttributeKeyExpiryHeight    = "expiry_height"
+	AttributeKeyExpiryTime      = "expiry_time"
+	AttributeKeyActionID        = "action_id"
+	AttributeKeyActionType      = "action_type"
+	AttributeKeyApprover        = "approver"
+	AttributeKeyRejecter        = "rejecter"
+	AttributeKeyGuardianType    = "guardian_type"
+	AttributeKeyRoles           = "roles"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,14 +1,72 @@
 package types
 
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // NewGenesisState constructs a GenesisState
-func NewGenesisState(profilers, trustees []Guardian) *GenesisState {
+func NewGenesisState(
+	profilers, trustees []Guardian,
+	params Params,
+	pendingActions []PendingAction,
+	auditLogs []AuditLog,
+) *GenesisState {
 	return &GenesisState{
-		Profilers: profilers,
-		Trustees:  trustees,
+		Profilers:      profilers,
+		Trustees:       trustees,
+		Params:         params,
+		PendingActions: pendingActions,
+		AuditLogs:      auditLogs,
+	}
+}
+
+// ValidateGenesis validates the params, the pending actions, the audit logs, and the roles and
+// the expiry of the guardians in the genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[uint64]bool, len(data.PendingActions))
+	for _, action := range data.PendingActions {
+		if ids[action.ID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate pending action %d", action.ID)
+		}
+		ids[action.ID] = true
+
+		if err := action.Validate(); err != nil {
+			return err
+		}
 	}
+
+	ids = make(map[uint64]bool, len(data.AuditLogs))
+	for _, log := range data.AuditLogs {
+		if ids[log.ID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate audit log %d", log.ID)
+		}
+		ids[log.ID] = true
+
+		if err := log.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, guardians := range [][]Guardian{data.Profilers, data.Trustees} {
+		for _, guardian := range guardians {
+			if err := ValidateRoles(guardian.Roles); err != nil {
+				return err
+			}
+			if err := ValidateExpiry(guardian.ExpiryHeight, guardian.ExpiryTime); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
@@ -25,8 +25,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the guardian module's genesis state.
 type GenesisState struct {
-	Profilers []Guardian `protobuf:"bytes,1,rep,name=profilers,proto3" json:"profilers"`
-	Trustees  []Guardian `protobuf:"bytes,2,rep,name=trustees,proto3" json:"trustees"`
+	Profilers      []Guardian      `protobuf:"bytes,1,rep,name=profilers,proto3" json:"profilers"`
+	Trustees       []Guardian      `protobuf:"bytes,2,rep,name=trustees,proto3" json:"trustees"`
+	Params         Params          `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	PendingActions []PendingAction `protobuf:"bytes,4,rep,name=pending_actions,json=pendingActions,proto3" json:"pending_actions" yaml:"pending_actions"`
+	AuditLogs      []AuditLog      `protobuf:"bytes,5,rep,name=audit_logs,json=auditLogs,proto3" json:"audit_logs" yaml:"audit_logs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +79,27 @@ func (m *GenesisState) GetTrustees() []Guardian {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPendingActions() []PendingAction {
+	if m != nil {
+		return m.PendingActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditLogs() []AuditLog {
+	if m != nil {
+		return m.AuditLogs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "irishub.guardian.GenesisState")
 }
@@ -83,21 +107,28 @@ func init() {
 func init() { proto.RegisterFile("guardian/genesis.proto", fileDescriptor_5203106ad1456439) }
 
 var fileDescriptor_5203106ad1456439 = []byte{
-	// 217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0xc8, 0x2c, 0xca, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x83, 0xc9, 0x4b, 0x89,
-	0x23, 0x54, 0x42, 0x19, 0x10, 0xa5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0xea, 0x61, 0xe4, 0xe2, 0x71, 0x87, 0x18, 0x19, 0x5c, 0x92, 0x58, 0x92, 0x2a,
-	0x64, 0xc7, 0xc5, 0x59, 0x50, 0x94, 0x9f, 0x96, 0x99, 0x93, 0x5a, 0x54, 0x2c, 0xc1, 0xa8, 0xc0,
-	0xac, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0x6e, 0x8b, 0x9e, 0x3b, 0x94, 0xe1, 0xc4, 0x72, 0xe2, 0x9e,
-	0x3c, 0x43, 0x10, 0x42, 0x8b, 0x90, 0x0d, 0x17, 0x47, 0x49, 0x51, 0x69, 0x71, 0x49, 0x6a, 0x6a,
-	0xb1, 0x04, 0x13, 0x91, 0xda, 0xe1, 0x3a, 0x9c, 0xbc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0xca, 0x30, 0x3d, 0xb3, 0x04, 0x64, 0x46, 0x72, 0x7e, 0xae, 0x3e, 0xc8, 0xbc,
-	0xbc, 0xd4, 0x12, 0x7d, 0xa8, 0xb9, 0xfa, 0xb9, 0xf9, 0x29, 0xa5, 0x39, 0xa9, 0xc5, 0x70, 0x1f,
-	0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xbd, 0x68, 0x0c, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0x71, 0x74, 0x59, 0xf5, 0x3d, 0x01, 0x00, 0x00,
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
+	0x18, 0xc6, 0x5b, 0x41, 0x22, 0x87, 0xf1, 0x4f, 0x63, 0xb0, 0x32, 0x1c, 0xa4, 0x13, 0x53, 0x1b,
+	0x31, 0x71, 0x30, 0xc6, 0x84, 0x2e, 0x0c, 0x3a, 0x18, 0x74, 0x72, 0x21, 0x07, 0x3d, 0x8f, 0x4b,
+	0xda, 0x5e, 0x73, 0xef, 0x75, 0x60, 0xf7, 0x03, 0xf8, 0xb1, 0x18, 0x19, 0x9d, 0x88, 0x81, 0x6f,
+	0xe0, 0x27, 0x30, 0x6d, 0x4f, 0x6a, 0x50, 0x13, 0xb7, 0x27, 0xbd, 0xdf, 0xf3, 0x7b, 0xd2, 0xbc,
+	0xa8, 0xc9, 0x52, 0x22, 0x03, 0x4e, 0x62, 0x8f, 0xd1, 0x98, 0x02, 0x07, 0x37, 0x91, 0x42, 0x09,
+	0xeb, 0x88, 0x4b, 0x0e, 0xd3, 0x74, 0xec, 0x7e, 0xbd, 0xb7, 0x4e, 0x4b, 0x52, 0x87, 0x02, 0x6d,
+	0x9d, 0x30, 0xc1, 0x44, 0x1e, 0xbd, 0x2c, 0x15, 0x5f, 0x9d, 0x97, 0x0a, 0xda, 0x1f, 0x14, 0xca,
+	0x07, 0x45, 0x14, 0xb5, 0x6e, 0x50, 0x3d, 0x91, 0xe2, 0x99, 0x87, 0x54, 0x82, 0x6d, 0x76, 0x2a,
+	0xdd, 0x46, 0xaf, 0xe5, 0x6e, 0xaf, 0xb8, 0x03, 0x1d, 0xfc, 0xea, 0x7c, 0xd9, 0x36, 0x86, 0x65,
+	0xc5, 0xba, 0x46, 0x7b, 0x4a, 0xa6, 0xa0, 0x28, 0x05, 0x7b, 0xe7, 0x9f, 0xf5, 0x4d, 0xc3, 0xba,
+	0x44, 0xb5, 0x84, 0x48, 0x12, 0x81, 0x5d, 0xe9, 0x98, 0xdd, 0x46, 0xcf, 0xfe, 0xd9, 0xbd, 0xcf,
+	0xdf, 0x75, 0x53, 0xd3, 0xd6, 0x14, 0x1d, 0x26, 0x34, 0x0e, 0x78, 0xcc, 0x46, 0x64, 0xa2, 0xb8,
+	0x88, 0xc1, 0xae, 0xe6, 0xe3, 0xed, 0x5f, 0x04, 0x05, 0xd8, 0xcf, 0x39, 0x1f, 0x67, 0x9e, 0x8f,
+	0x65, 0xbb, 0x39, 0x23, 0x51, 0x78, 0xe5, 0x6c, 0x59, 0x9c, 0xe1, 0x41, 0xf2, 0x1d, 0x07, 0xeb,
+	0x11, 0x21, 0x92, 0x06, 0x5c, 0x8d, 0x42, 0xc1, 0xc0, 0xde, 0xfd, 0xeb, 0x0f, 0xfb, 0x19, 0x73,
+	0x27, 0x98, 0x7f, 0xa6, 0xfd, 0xc7, 0x85, 0xbf, 0xec, 0x3a, 0xc3, 0x3a, 0xd1, 0x10, 0xf8, 0xb7,
+	0xf3, 0x15, 0x36, 0x17, 0x2b, 0x6c, 0xbe, 0xaf, 0xb0, 0xf9, 0xba, 0xc6, 0xc6, 0x62, 0x8d, 0x8d,
+	0xb7, 0x35, 0x36, 0x9e, 0xce, 0x19, 0x57, 0x99, 0x79, 0x22, 0x22, 0x2f, 0x5b, 0x89, 0xa9, 0xf2,
+	0xf4, 0x9a, 0x17, 0x89, 0x20, 0x0d, 0x29, 0x6c, 0x2e, 0xed, 0xa9, 0x59, 0x42, 0x61, 0x5c, 0xcb,
+	0x4f, 0x7b, 0xf1, 0x39, 0x00, 0x35, 0xda, 0x38, 0xaf, 0x35, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -120,6 +151,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AuditLogs) > 0 {
+		for iNdEx := len(m.AuditLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingActions) > 0 {
+		for iNdEx := len(m.PendingActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Trustees) > 0 {
 		for iNdEx := len(m.Trustees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -180,6 +249,20 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingActions) > 0 {
+		for _, e := range m.PendingActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuditLogs) > 0 {
+		for _, e := range m.AuditLogs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -286,6 +369,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingActions = append(m.PendingActions, PendingAction{})
+			if err := m.PendingActions[len(m.PendingActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditLogs = append(m.AuditLogs, AuditLog{})
+			if err := m.AuditLogs[len(m.AuditLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
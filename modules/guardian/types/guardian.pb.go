@@ -8,16 +8,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -53,6 +57,70 @@ func (AccountType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_07c8fad859e95e75, []int{0}
 }
 
+// GuardianType defines the type of the guardian
+type GuardianType int32
+
+const (
+	// PROFILER defines a profiler
+	Profiler GuardianType = 0
+	// TRUSTEE defines a trustee
+	Trustee GuardianType = 1
+)
+
+var GuardianType_name = map[int32]string{
+	0: "PROFILER",
+	1: "TRUSTEE",
+}
+
+var GuardianType_value = map[string]int32{
+	"PROFILER": 0,
+	"TRUSTEE":  1,
+}
+
+func (x GuardianType) String() string {
+	return proto.EnumName(GuardianType_name, int32(x))
+}
+
+func (GuardianType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{1}
+}
+
+// ActionType defines the type of the guardian action
+type ActionType int32
+
+const (
+	// ADD_PROFILER defines an action to add a profiler
+	ActionAddProfiler ActionType = 0
+	// ADD_TRUSTEE defines an action to add a trustee
+	ActionAddTrustee ActionType = 1
+	// DELETE_PROFILER defines an action to delete a profiler
+	ActionDeleteProfiler ActionType = 2
+	// DELETE_TRUSTEE defines an action to delete a trustee
+	ActionDeleteTrustee ActionType = 3
+)
+
+var ActionType_name = map[int32]string{
+	0: "ADD_PROFILER",
+	1: "ADD_TRUSTEE",
+	2: "DELETE_PROFILER",
+	3: "DELETE_TRUSTEE",
+}
+
+var ActionType_value = map[string]int32{
+	"ADD_PROFILER":    0,
+	"ADD_TRUSTEE":     1,
+	"DELETE_PROFILER": 2,
+	"DELETE_TRUSTEE":  3,
+}
+
+func (x ActionType) String() string {
+	return proto.EnumName(ActionType_name, int32(x))
+}
+
+func (ActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{2}
+}
+
 // MsgAddProfiler defines an sdk.Msg type that supports adding profiler
 type MsgAddProfiler struct {
 	AddGuardian AddGuardian `protobuf:"bytes,1,opt,name=add_guardian,json=addGuardian,proto3" json:"add_guardian" yaml:"add_guardian"`
@@ -233,18 +301,129 @@ func (m *MsgDeleteTrustee) GetDeleteGuardian() DeleteGuardian {
 	return DeleteGuardian{}
 }
 
+// MsgApproveGuardianAction defines an sdk.Msg type that supports approving a pending guardian action
+type MsgApproveGuardianAction struct {
+	ActionID uint64                                        `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty" yaml:"action_id"`
+	Approver github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=approver,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"approver,omitempty"`
+}
+
+func (m *MsgApproveGuardianAction) Reset()         { *m = MsgApproveGuardianAction{} }
+func (m *MsgApproveGuardianAction) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveGuardianAction) ProtoMessage()    {}
+func (*MsgApproveGuardianAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{4}
+}
+func (m *MsgApproveGuardianAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveGuardianAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveGuardianAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveGuardianAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveGuardianAction.Merge(m, src)
+}
+func (m *MsgApproveGuardianAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveGuardianAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveGuardianAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveGuardianAction proto.InternalMessageInfo
+
+func (m *MsgApproveGuardianAction) GetActionID() uint64 {
+	if m != nil {
+		return m.ActionID
+	}
+	return 0
+}
+
+func (m *MsgApproveGuardianAction) GetApprover() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Approver
+	}
+	return nil
+}
+
+// MsgRejectGuardianAction defines an sdk.Msg type that supports rejecting a pending guardian action
+type MsgRejectGuardianAction struct {
+	ActionID uint64                                        `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty" yaml:"action_id"`
+	Rejecter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=rejecter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"rejecter,omitempty"`
+}
+
+func (m *MsgRejectGuardianAction) Reset()         { *m = MsgRejectGuardianAction{} }
+func (m *MsgRejectGuardianAction) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectGuardianAction) ProtoMessage()    {}
+func (*MsgRejectGuardianAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{5}
+}
+func (m *MsgRejectGuardianAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectGuardianAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectGuardianAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRejectGuardianAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectGuardianAction.Merge(m, src)
+}
+func (m *MsgRejectGuardianAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectGuardianAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectGuardianAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRejectGuardianAction proto.InternalMessageInfo
+
+func (m *MsgRejectGuardianAction) GetActionID() uint64 {
+	if m != nil {
+		return m.ActionID
+	}
+	return 0
+}
+
+func (m *MsgRejectGuardianAction) GetRejecter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Rejecter
+	}
+	return nil
+}
+
 // AddGuardian defines the properties of add guardian message
 type AddGuardian struct {
 	Description string                                        `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
 	Address     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
 	AddedBy     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"added_by,omitempty" yaml:"added_by"`
+	Roles       []string                                      `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	// the guardian expires at the height if not zero
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// the guardian expires at the time if set
+	ExpiryTime *time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" yaml:"expiry_time"`
 }
 
 func (m *AddGuardian) Reset()         { *m = AddGuardian{} }
 func (m *AddGuardian) String() string { return proto.CompactTextString(m) }
 func (*AddGuardian) ProtoMessage()    {}
 func (*AddGuardian) Descriptor() ([]byte, []int) {
-	return fileDescriptor_07c8fad859e95e75, []int{4}
+	return fileDescriptor_07c8fad859e95e75, []int{6}
 }
 func (m *AddGuardian) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,6 +473,27 @@ func (m *AddGuardian) GetAddedBy() github_com_cosmos_cosmos_sdk_types.AccAddress
 	return nil
 }
 
+func (m *AddGuardian) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *AddGuardian) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *AddGuardian) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
 // DeleteGuardian defines the properties of delete guardian message
 type DeleteGuardian struct {
 	Address   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
@@ -304,7 +504,7 @@ func (m *DeleteGuardian) Reset()         { *m = DeleteGuardian{} }
 func (m *DeleteGuardian) String() string { return proto.CompactTextString(m) }
 func (*DeleteGuardian) ProtoMessage()    {}
 func (*DeleteGuardian) Descriptor() ([]byte, []int) {
-	return fileDescriptor_07c8fad859e95e75, []int{5}
+	return fileDescriptor_07c8fad859e95e75, []int{7}
 }
 func (m *DeleteGuardian) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,13 +553,18 @@ type Guardian struct {
 	AccountType AccountType                                   `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=irishub.guardian.AccountType" json:"account_type,omitempty" yaml:"account_type"`
 	Address     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
 	AddedBy     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=added_by,json=addedBy,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"added_by,omitempty" yaml:"added_by"`
+	Roles       []string                                      `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	// the guardian expires at the height if not zero
+	ExpiryHeight int64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// the guardian expires at the time if set
+	ExpiryTime *time.Time `protobuf:"bytes,7,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" yaml:"expiry_time"`
 }
 
 func (m *Guardian) Reset()         { *m = Guardian{} }
 func (m *Guardian) String() string { return proto.CompactTextString(m) }
 func (*Guardian) ProtoMessage()    {}
 func (*Guardian) Descriptor() ([]byte, []int) {
-	return fileDescriptor_07c8fad859e95e75, []int{6}
+	return fileDescriptor_07c8fad859e95e75, []int{8}
 }
 func (m *Guardian) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,443 +621,3205 @@ func (m *Guardian) GetAddedBy() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("irishub.guardian.AccountType", AccountType_name, AccountType_value)
-	proto.RegisterType((*MsgAddProfiler)(nil), "irishub.guardian.MsgAddProfiler")
-	proto.RegisterType((*MsgDeleteProfiler)(nil), "irishub.guardian.MsgDeleteProfiler")
-	proto.RegisterType((*MsgAddTrustee)(nil), "irishub.guardian.MsgAddTrustee")
-	proto.RegisterType((*MsgDeleteTrustee)(nil), "irishub.guardian.MsgDeleteTrustee")
-	proto.RegisterType((*AddGuardian)(nil), "irishub.guardian.AddGuardian")
-	proto.RegisterType((*DeleteGuardian)(nil), "irishub.guardian.DeleteGuardian")
-	proto.RegisterType((*Guardian)(nil), "irishub.guardian.Guardian")
+func (m *Guardian) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("guardian/guardian.proto", fileDescriptor_07c8fad859e95e75) }
-
-var fileDescriptor_07c8fad859e95e75 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x31, 0x6f, 0xd3, 0x4e,
-	0x14, 0xb7, 0xdb, 0xea, 0x9f, 0xf4, 0x9c, 0x7f, 0x9a, 0x1a, 0x44, 0x83, 0x11, 0x8e, 0xe5, 0xa9,
-	0x42, 0x6a, 0xa2, 0xc2, 0xc6, 0x66, 0x2b, 0x25, 0x8a, 0xaa, 0xb6, 0xc8, 0xed, 0x52, 0x24, 0x64,
-	0x39, 0xbe, 0xc3, 0x9c, 0x48, 0x7c, 0xd1, 0x9d, 0x3d, 0x78, 0x80, 0x19, 0x75, 0xe2, 0x0b, 0x74,
-	0xe2, 0x33, 0xf0, 0x0d, 0x18, 0x3a, 0x76, 0x64, 0xc1, 0x42, 0xc9, 0x37, 0xc8, 0xc8, 0x84, 0xec,
-	0xb3, 0xdd, 0x6b, 0x60, 0xa1, 0x6a, 0x99, 0x7c, 0xbe, 0xf7, 0xde, 0xef, 0xf7, 0x7e, 0xbf, 0xf7,
-	0x74, 0x60, 0x2b, 0x88, 0x3d, 0x0a, 0xb1, 0x17, 0xf6, 0xca, 0x43, 0x77, 0x4a, 0x49, 0x44, 0xd4,
-	0x16, 0xa6, 0x98, 0xbd, 0x8d, 0x47, 0xdd, 0xf2, 0x5e, 0xbb, 0x1f, 0x90, 0x80, 0xe4, 0xc1, 0x5e,
-	0x76, 0xe2, 0x79, 0xda, 0x43, 0x9f, 0xb0, 0x09, 0x61, 0x2e, 0x0f, 0xf0, 0x1f, 0x1e, 0x32, 0x09,
-	0x68, 0x1e, 0xb0, 0xc0, 0x82, 0xf0, 0x25, 0x25, 0x6f, 0xf0, 0x18, 0x51, 0xf5, 0x35, 0x68, 0x78,
-	0x10, 0xba, 0x25, 0x64, 0x5b, 0x36, 0xe4, 0x6d, 0xe5, 0xe9, 0xe3, 0xee, 0x32, 0x57, 0xd7, 0x82,
-	0x70, 0x50, 0x9c, 0xed, 0x47, 0x17, 0x69, 0x47, 0x5a, 0xa4, 0x9d, 0x7b, 0x89, 0x37, 0x19, 0x3f,
-	0x37, 0x45, 0x00, 0xd3, 0x51, 0xbc, 0xab, 0x4c, 0xf3, 0x03, 0xd8, 0x3c, 0x60, 0x41, 0x1f, 0x8d,
-	0x51, 0x84, 0x2a, 0x4e, 0x0c, 0x36, 0x60, 0x7e, 0xb3, 0x4c, 0x6b, 0xfc, 0x4e, 0xcb, 0x4b, 0x2b,
-	0x66, 0xbd, 0x60, 0x7e, 0xc0, 0x99, 0x97, 0x60, 0x4c, 0xa7, 0x09, 0xaf, 0xe5, 0x9b, 0x21, 0xf8,
-	0x9f, 0x0b, 0x3e, 0xa1, 0x31, 0x8b, 0x10, 0xba, 0x6b, 0xbd, 0xef, 0x41, 0xab, 0xd2, 0x5b, 0x52,
-	0xfe, 0x43, 0xb9, 0xdf, 0x65, 0xa0, 0x08, 0x8d, 0xab, 0x06, 0x50, 0x20, 0x62, 0x3e, 0xc5, 0xd3,
-	0x08, 0x13, 0x4e, 0xbb, 0xee, 0x88, 0x57, 0xea, 0x3e, 0xa8, 0x79, 0x10, 0x52, 0xc4, 0x58, 0x7b,
-	0xc5, 0x90, 0xb7, 0x1b, 0xf6, 0xee, 0xcf, 0xb4, 0xb3, 0x13, 0xe0, 0x28, 0x6b, 0xcb, 0x27, 0x93,
-	0x62, 0x7f, 0x8a, 0xcf, 0x0e, 0x83, 0xef, 0x7a, 0x51, 0x32, 0x45, 0xac, 0x6b, 0xf9, 0xbe, 0xc5,
-	0x0b, 0x9d, 0x12, 0x41, 0x75, 0x41, 0xdd, 0x83, 0x10, 0x41, 0x77, 0x94, 0xb4, 0x57, 0x73, 0xb4,
-	0xfe, 0x22, 0xed, 0x6c, 0x54, 0xae, 0xe5, 0x11, 0xf3, 0x66, 0x04, 0x08, 0xda, 0x89, 0xf9, 0x55,
-	0x06, 0xcd, 0xeb, 0x16, 0xdd, 0xae, 0x00, 0x04, 0x00, 0x77, 0x54, 0x90, 0xf0, 0x62, 0x91, 0x76,
-	0x36, 0x45, 0xff, 0x6f, 0x28, 0x62, 0xbd, 0xa8, 0xb6, 0x13, 0xf3, 0xcb, 0x0a, 0xa8, 0xff, 0xc5,
-	0x8c, 0x4e, 0x41, 0xc3, 0xf3, 0x7d, 0x12, 0x87, 0x91, 0x9b, 0xa1, 0xe6, 0x3a, 0x9b, 0x7f, 0xdc,
-	0x59, 0x9e, 0x75, 0x92, 0x4c, 0x91, 0xbd, 0x25, 0xec, 0xab, 0x50, 0x9c, 0xed, 0xeb, 0x55, 0x96,
-	0xe8, 0xde, 0xea, 0xad, 0x8e, 0x7f, 0xed, 0x0e, 0xc6, 0xff, 0x64, 0x08, 0x14, 0x41, 0xa2, 0xda,
-	0x06, 0xb5, 0xc1, 0xde, 0xe1, 0xde, 0xf1, 0xf0, 0xb8, 0x25, 0x69, 0xca, 0xd9, 0xb9, 0x51, 0x1b,
-	0xa0, 0x10, 0x31, 0xcc, 0x54, 0x0d, 0xd4, 0x8f, 0x9c, 0xfe, 0xf0, 0xd0, 0x72, 0x4e, 0x5b, 0xb2,
-	0xd6, 0x38, 0x3b, 0x37, 0xea, 0x47, 0x14, 0xe2, 0xd0, 0xa3, 0x89, 0xb6, 0xf6, 0xf1, 0xb3, 0x2e,
-	0xd9, 0xfb, 0x17, 0x33, 0x5d, 0xbe, 0x9c, 0xe9, 0xf2, 0x8f, 0x99, 0x2e, 0x7f, 0x9a, 0xeb, 0xd2,
-	0xe5, 0x5c, 0x97, 0xbe, 0xcd, 0x75, 0xe9, 0xd5, 0xae, 0xd0, 0x5c, 0xe6, 0x70, 0x88, 0xa2, 0x5e,
-	0xe1, 0x74, 0x6f, 0x42, 0x60, 0x3c, 0x46, 0xac, 0x7a, 0x99, 0x79, 0xaf, 0xa3, 0xff, 0xf2, 0xd7,
-	0xf5, 0xd9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x90, 0xd8, 0x8e, 0xb9, 0xbb, 0x05, 0x00, 0x00,
+func (m *Guardian) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
 }
 
-func (m *MsgAddProfiler) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Guardian) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgAddProfiler) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AddGuardianProposal defines a governance proposal to add a profiler or a trustee
+type AddGuardianProposal struct {
+	Title               string                                        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description         string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	GuardianType        GuardianType                                  `protobuf:"varint,3,opt,name=guardian_type,json=guardianType,proto3,enum=irishub.guardian.GuardianType" json:"guardian_type,omitempty" yaml:"guardian_type"`
+	Address             github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	GuardianDescription string                                        `protobuf:"bytes,5,opt,name=guardian_description,json=guardianDescription,proto3" json:"guardian_description,omitempty" yaml:"guardian_description"`
+	Roles               []string                                      `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+	// the guardian expires at the height if not zero
+	ExpiryHeight int64 `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	// the guardian expires at the time if set
+	ExpiryTime *time.Time `protobuf:"bytes,8,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" yaml:"expiry_time"`
+	// the guardian is a genesis account if true, otherwise an ordinary one
+	Genesis bool `protobuf:"varint,9,opt,name=genesis,proto3" json:"genesis,omitempty"`
 }
 
-func (m *MsgAddProfiler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AddGuardian.MarshalToSizedBuffer(dAtA[:i])
+func (m *AddGuardianProposal) Reset()      { *m = AddGuardianProposal{} }
+func (*AddGuardianProposal) ProtoMessage() {}
+func (*AddGuardianProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{9}
+}
+func (m *AddGuardianProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddGuardianProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddGuardianProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGuardian(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *AddGuardianProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddGuardianProposal.Merge(m, src)
+}
+func (m *AddGuardianProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddGuardianProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddGuardianProposal.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteProfiler) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AddGuardianProposal proto.InternalMessageInfo
+
+func (m *AddGuardianProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteProfiler) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AddGuardianProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *MsgDeleteProfiler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DeleteGuardian.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGuardian(dAtA, i, uint64(size))
+func (m *AddGuardianProposal) GetGuardianType() GuardianType {
+	if m != nil {
+		return m.GuardianType
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return Profiler
 }
 
-func (m *MsgAddTrustee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AddGuardianProposal) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgAddTrustee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AddGuardianProposal) GetGuardianDescription() string {
+	if m != nil {
+		return m.GuardianDescription
+	}
+	return ""
 }
 
-func (m *MsgAddTrustee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AddGuardian.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGuardian(dAtA, i, uint64(size))
+func (m *AddGuardianProposal) GetRoles() []string {
+	if m != nil {
+		return m.Roles
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgDeleteTrustee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AddGuardianProposal) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeleteTrustee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AddGuardianProposal) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
 }
 
-func (m *MsgDeleteTrustee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DeleteGuardian.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGuardian(dAtA, i, uint64(size))
+func (m *AddGuardianProposal) GetGenesis() bool {
+	if m != nil {
+		return m.Genesis
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *AddGuardian) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// DeleteGuardianProposal defines a governance proposal to delete a profiler or a trustee
+type DeleteGuardianProposal struct {
+	Title        string                                        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	GuardianType GuardianType                                  `protobuf:"varint,3,opt,name=guardian_type,json=guardianType,proto3,enum=irishub.guardian.GuardianType" json:"guardian_type,omitempty" yaml:"guardian_type"`
+	Address      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
 }
 
-func (m *AddGuardian) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeleteGuardianProposal) Reset()      { *m = DeleteGuardianProposal{} }
+func (*DeleteGuardianProposal) ProtoMessage() {}
+func (*DeleteGuardianProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{10}
 }
-
-func (m *AddGuardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddedBy) > 0 {
-		i -= len(m.AddedBy)
-		copy(dAtA[i:], m.AddedBy)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.AddedBy)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0xa
+func (m *DeleteGuardianProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteGuardianProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteGuardianProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DeleteGuardianProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGuardianProposal.Merge(m, src)
+}
+func (m *DeleteGuardianProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteGuardianProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGuardianProposal.DiscardUnknown(m)
 }
 
-func (m *DeleteGuardian) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteGuardianProposal proto.InternalMessageInfo
+
+func (m *DeleteGuardianProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DeleteGuardian) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DeleteGuardianProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *DeleteGuardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeletedBy) > 0 {
-		i -= len(m.DeletedBy)
-		copy(dAtA[i:], m.DeletedBy)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.DeletedBy)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func (m *DeleteGuardianProposal) GetGuardianType() GuardianType {
+	if m != nil {
+		return m.GuardianType
 	}
-	return len(dAtA) - i, nil
+	return Profiler
 }
 
-func (m *Guardian) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeleteGuardianProposal) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Guardian) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpdateGuardianRolesProposal defines a governance proposal to replace the roles of a profiler or a trustee
+type UpdateGuardianRolesProposal struct {
+	Title        string                                        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string                                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	GuardianType GuardianType                                  `protobuf:"varint,3,opt,name=guardian_type,json=guardianType,proto3,enum=irishub.guardian.GuardianType" json:"guardian_type,omitempty" yaml:"guardian_type"`
+	Address      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	Roles        []string                                      `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
 }
 
-func (m *Guardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddedBy) > 0 {
-		i -= len(m.AddedBy)
-		copy(dAtA[i:], m.AddedBy)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.AddedBy)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.AccountType != 0 {
-		i = encodeVarintGuardian(dAtA, i, uint64(m.AccountType))
-		i--
-		dAtA[i] = 0x10
+func (m *UpdateGuardianRolesProposal) Reset()      { *m = UpdateGuardianRolesProposal{} }
+func (*UpdateGuardianRolesProposal) ProtoMessage() {}
+func (*UpdateGuardianRolesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{11}
+}
+func (m *UpdateGuardianRolesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateGuardianRolesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateGuardianRolesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *UpdateGuardianRolesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateGuardianRolesProposal.Merge(m, src)
+}
+func (m *UpdateGuardianRolesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateGuardianRolesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateGuardianRolesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateGuardianRolesProposal proto.InternalMessageInfo
+
+func (m *UpdateGuardianRolesProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintGuardian(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGuardian(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UpdateGuardianRolesProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgAddProfiler) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UpdateGuardianRolesProposal) GetGuardianType() GuardianType {
+	if m != nil {
+		return m.GuardianType
 	}
-	var l int
-	_ = l
-	l = m.AddGuardian.Size()
-	n += 1 + l + sovGuardian(uint64(l))
-	return n
+	return Profiler
 }
 
-func (m *MsgDeleteProfiler) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateGuardianRolesProposal) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	l = m.DeleteGuardian.Size()
-	n += 1 + l + sovGuardian(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MsgAddTrustee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateGuardianRolesProposal) GetRoles() []string {
+	if m != nil {
+		return m.Roles
 	}
-	var l int
-	_ = l
-	l = m.AddGuardian.Size()
-	n += 1 + l + sovGuardian(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MsgDeleteTrustee) Size() (n int) {
-	if m == nil {
-		return 0
+// PendingAction defines a guardian action waiting for the approvals of the guardians
+type PendingAction struct {
+	ID         uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActionType ActionType `protobuf:"varint,2,opt,name=action_type,json=actionType,proto3,enum=irishub.guardian.ActionType" json:"action_type,omitempty" yaml:"action_type"`
+	// set for the actions adding a guardian
+	AddGuardian *AddGuardian `protobuf:"bytes,3,opt,name=add_guardian,json=addGuardian,proto3" json:"add_guardian,omitempty" yaml:"add_guardian"`
+	// set for the actions deleting a guardian
+	DeleteGuardian *DeleteGuardian                                 `protobuf:"bytes,4,opt,name=delete_guardian,json=deleteGuardian,proto3" json:"delete_guardian,omitempty" yaml:"delete_guardian"`
+	Approvals      []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,rep,name=approvals,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"approvals,omitempty"`
+	Rejections     []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,rep,name=rejections,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"rejections,omitempty"`
+	// height at which the action was submitted
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PendingAction) Reset()         { *m = PendingAction{} }
+func (m *PendingAction) String() string { return proto.CompactTextString(m) }
+func (*PendingAction) ProtoMessage()    {}
+func (*PendingAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{12}
+}
+func (m *PendingAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.DeleteGuardian.Size()
-	n += 1 + l + sovGuardian(uint64(l))
-	return n
+}
+func (m *PendingAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAction.Merge(m, src)
+}
+func (m *PendingAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAction.DiscardUnknown(m)
 }
 
-func (m *AddGuardian) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_PendingAction proto.InternalMessageInfo
+
+func (m *PendingAction) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	var l int
-	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
+	return 0
+}
+
+func (m *PendingAction) GetActionType() ActionType {
+	if m != nil {
+		return m.ActionType
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
+	return ActionAddProfiler
+}
+
+func (m *PendingAction) GetAddGuardian() *AddGuardian {
+	if m != nil {
+		return m.AddGuardian
 	}
-	l = len(m.AddedBy)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
+	return nil
+}
+
+func (m *PendingAction) GetDeleteGuardian() *DeleteGuardian {
+	if m != nil {
+		return m.DeleteGuardian
 	}
-	return n
+	return nil
 }
 
-func (m *DeleteGuardian) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingAction) GetApprovals() []github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Approvals
 	}
-	var l int
+	return nil
+}
+
+func (m *PendingAction) GetRejections() []github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Rejections
+	}
+	return nil
+}
+
+func (m *PendingAction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// AuditLog defines an entry of the append-only audit trail of the guardian add and delete operations
+type AuditLog struct {
+	ID         uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActionType ActionType `protobuf:"varint,2,opt,name=action_type,json=actionType,proto3,enum=irishub.guardian.ActionType" json:"action_type,omitempty" yaml:"action_type"`
+	// address of the added or deleted guardian
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	// account which performed the operation
+	Operator github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=operator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"operator,omitempty"`
+	// description of the added or deleted guardian
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// height at which the operation was performed
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *AuditLog) Reset()         { *m = AuditLog{} }
+func (m *AuditLog) String() string { return proto.CompactTextString(m) }
+func (*AuditLog) ProtoMessage()    {}
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{13}
+}
+func (m *AuditLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLog.Merge(m, src)
+}
+func (m *AuditLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLog proto.InternalMessageInfo
+
+func (m *AuditLog) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *AuditLog) GetActionType() ActionType {
+	if m != nil {
+		return m.ActionType
+	}
+	return ActionAddProfiler
+}
+
+func (m *AuditLog) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AuditLog) GetOperator() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *AuditLog) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AuditLog) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Params defines the parameters of the guardian module
+type Params struct {
+	// number of the guardian approvals required to execute a guardian action
+	ApprovalThreshold uint32 `protobuf:"varint,1,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty" yaml:"approval_threshold"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{14}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetApprovalThreshold() uint32 {
+	if m != nil {
+		return m.ApprovalThreshold
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("irishub.guardian.AccountType", AccountType_name, AccountType_value)
+	proto.RegisterEnum("irishub.guardian.GuardianType", GuardianType_name, GuardianType_value)
+	proto.RegisterEnum("irishub.guardian.ActionType", ActionType_name, ActionType_value)
+	proto.RegisterType((*MsgAddProfiler)(nil), "irishub.guardian.MsgAddProfiler")
+	proto.RegisterType((*MsgDeleteProfiler)(nil), "irishub.guardian.MsgDeleteProfiler")
+	proto.RegisterType((*MsgAddTrustee)(nil), "irishub.guardian.MsgAddTrustee")
+	proto.RegisterType((*MsgDeleteTrustee)(nil), "irishub.guardian.MsgDeleteTrustee")
+	proto.RegisterType((*MsgApproveGuardianAction)(nil), "irishub.guardian.MsgApproveGuardianAction")
+	proto.RegisterType((*MsgRejectGuardianAction)(nil), "irishub.guardian.MsgRejectGuardianAction")
+	proto.RegisterType((*AddGuardian)(nil), "irishub.guardian.AddGuardian")
+	proto.RegisterType((*DeleteGuardian)(nil), "irishub.guardian.DeleteGuardian")
+	proto.RegisterType((*Guardian)(nil), "irishub.guardian.Guardian")
+	proto.RegisterType((*AddGuardianProposal)(nil), "irishub.guardian.AddGuardianProposal")
+	proto.RegisterType((*DeleteGuardianProposal)(nil), "irishub.guardian.DeleteGuardianProposal")
+	proto.RegisterType((*UpdateGuardianRolesProposal)(nil), "irishub.guardian.UpdateGuardianRolesProposal")
+	proto.RegisterType((*PendingAction)(nil), "irishub.guardian.PendingAction")
+	proto.RegisterType((*AuditLog)(nil), "irishub.guardian.AuditLog")
+	proto.RegisterType((*Params)(nil), "irishub.guardian.Params")
+}
+
+func init() { proto.RegisterFile("guardian/guardian.proto", fileDescriptor_07c8fad859e95e75) }
+
+var fileDescriptor_07c8fad859e95e75 = []byte{
+	// 1226 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcf, 0x6f, 0xe2, 0x46,
+	0x14, 0xc6, 0x40, 0xf8, 0xf1, 0x20, 0x09, 0x71, 0x68, 0xe2, 0x25, 0x5d, 0x6c, 0x59, 0xaa, 0x1a,
+	0x6d, 0x15, 0xd0, 0xb6, 0xb7, 0x95, 0xf6, 0x00, 0x82, 0x4d, 0xe9, 0x26, 0x9b, 0x74, 0x42, 0x54,
+	0x6d, 0xd5, 0x15, 0x72, 0x98, 0x59, 0xc7, 0x2d, 0x30, 0xc8, 0x36, 0x55, 0x39, 0xb4, 0x87, 0x9e,
+	0x2a, 0x7a, 0xd9, 0x63, 0xa5, 0x0a, 0xa9, 0x52, 0x0f, 0xed, 0x5f, 0xd1, 0x53, 0x0f, 0x7b, 0xeb,
+	0x1e, 0x7b, 0xa2, 0x55, 0xf2, 0x1f, 0x70, 0xac, 0x7a, 0xa8, 0xec, 0xb1, 0xcd, 0x00, 0xd1, 0x2a,
+	0x25, 0xec, 0x5e, 0xf6, 0x84, 0x67, 0xe6, 0x9b, 0xef, 0xf9, 0xbd, 0xf7, 0xcd, 0x9b, 0x87, 0x61,
+	0x5b, 0xef, 0x69, 0x26, 0x36, 0xb4, 0x4e, 0xd1, 0x7f, 0x28, 0x74, 0x4d, 0x6a, 0x53, 0x31, 0x63,
+	0x98, 0x86, 0x75, 0xde, 0x3b, 0x2b, 0xf8, 0xf3, 0xb9, 0xac, 0x4e, 0x75, 0xea, 0x2e, 0x16, 0x9d,
+	0x27, 0x86, 0xcb, 0xdd, 0x6a, 0x52, 0xab, 0x4d, 0xad, 0x06, 0x5b, 0x60, 0x03, 0x6f, 0x49, 0xd6,
+	0x29, 0xd5, 0x5b, 0xa4, 0xe8, 0x8e, 0xce, 0x7a, 0x4f, 0x8b, 0xb6, 0xd1, 0x26, 0x96, 0xad, 0xb5,
+	0xbb, 0x0c, 0xa0, 0x52, 0x58, 0x3b, 0xb4, 0xf4, 0x12, 0xc6, 0xc7, 0x26, 0x7d, 0x6a, 0xb4, 0x88,
+	0x29, 0x3e, 0x81, 0xb4, 0x86, 0x71, 0xc3, 0xb7, 0x29, 0x09, 0x8a, 0xb0, 0x9b, 0x7a, 0xff, 0x76,
+	0x61, 0xf6, 0x65, 0x0a, 0x25, 0x8c, 0xf7, 0xbd, 0xe7, 0xf2, 0xce, 0xf3, 0x91, 0x1c, 0x1a, 0x8f,
+	0xe4, 0xcd, 0xbe, 0xd6, 0x6e, 0xdd, 0x53, 0x79, 0x02, 0x15, 0xa5, 0xb4, 0x09, 0x52, 0xfd, 0x06,
+	0x36, 0x0e, 0x2d, 0xbd, 0x42, 0x5a, 0xc4, 0x26, 0x81, 0x4d, 0x03, 0xd6, 0xb1, 0x3b, 0x33, 0x6b,
+	0x56, 0x99, 0x37, 0xcb, 0xb6, 0x06, 0x96, 0xf3, 0x9e, 0xe5, 0x2d, 0x66, 0x79, 0x86, 0x46, 0x45,
+	0x6b, 0x78, 0x0a, 0xaf, 0x76, 0x60, 0x95, 0x39, 0x5c, 0x37, 0x7b, 0x96, 0x4d, 0xc8, 0xab, 0xf6,
+	0xf7, 0x6b, 0xc8, 0x04, 0xfe, 0xfa, 0x26, 0x5f, 0xa3, 0xbb, 0xbf, 0x0a, 0x20, 0x39, 0xfe, 0x76,
+	0xbb, 0x26, 0xfd, 0x32, 0x98, 0x2e, 0x35, 0x6d, 0x83, 0x76, 0xc4, 0xfb, 0x90, 0xd4, 0xdc, 0xa7,
+	0x86, 0x81, 0xdd, 0x37, 0x88, 0x96, 0x95, 0x8b, 0x91, 0x9c, 0x60, 0xcb, 0xb5, 0xca, 0x78, 0x24,
+	0x67, 0x3c, 0x07, 0x7d, 0x98, 0x8a, 0x12, 0xec, 0xb9, 0x86, 0xc5, 0x43, 0x48, 0x68, 0x8c, 0xd7,
+	0x94, 0xc2, 0x8a, 0xb0, 0x9b, 0x2e, 0xdf, 0xfd, 0x67, 0x24, 0xef, 0xe9, 0x86, 0xed, 0x78, 0xd0,
+	0xa4, 0x6d, 0x4f, 0x8b, 0xde, 0xcf, 0x9e, 0x85, 0xbf, 0x28, 0xda, 0xfd, 0x2e, 0xb1, 0x0a, 0xa5,
+	0x66, 0xb3, 0x84, 0xb1, 0x49, 0x2c, 0x0b, 0x05, 0x14, 0xea, 0x2f, 0x02, 0x6c, 0x1f, 0x5a, 0x3a,
+	0x22, 0x9f, 0x93, 0xa6, 0xbd, 0xf4, 0x37, 0x35, 0x5d, 0xda, 0x1b, 0xbd, 0xa9, 0x4f, 0xa1, 0x7e,
+	0x1f, 0x81, 0x14, 0xa7, 0x06, 0x51, 0x81, 0x14, 0x26, 0x56, 0xd3, 0x34, 0xba, 0x8e, 0x3d, 0xf7,
+	0xfd, 0x92, 0x88, 0x9f, 0x12, 0x1f, 0x42, 0x5c, 0x63, 0x34, 0x8b, 0xdb, 0xf7, 0x19, 0xc4, 0x06,
+	0x24, 0x34, 0x8c, 0x09, 0x6e, 0x9c, 0xf5, 0xa5, 0x88, 0xcb, 0xe6, 0xf8, 0xbf, 0x1e, 0x48, 0xd1,
+	0x5d, 0x51, 0x17, 0x33, 0x40, 0x70, 0xb9, 0x2f, 0x66, 0x61, 0xc5, 0xa4, 0x2d, 0x62, 0x49, 0x51,
+	0x25, 0xb2, 0x9b, 0x44, 0x6c, 0x20, 0xde, 0x87, 0x55, 0xf2, 0x55, 0xd7, 0x30, 0xfb, 0x8d, 0x73,
+	0x62, 0xe8, 0xe7, 0xb6, 0xb4, 0xa2, 0x08, 0xbb, 0x91, 0xb2, 0x34, 0x1e, 0xc9, 0x59, 0x66, 0x7b,
+	0x6a, 0x59, 0x45, 0x69, 0x36, 0xfe, 0xd0, 0x1d, 0x8a, 0x9f, 0x40, 0xca, 0x5b, 0x77, 0x6a, 0x90,
+	0x14, 0x73, 0x05, 0x9f, 0x2b, 0xb0, 0x02, 0x55, 0xf0, 0x0b, 0x54, 0xa1, 0xee, 0x17, 0xa8, 0x72,
+	0x6e, 0x3c, 0x92, 0xc5, 0x29, 0x62, 0x67, 0xa3, 0xfa, 0xec, 0x2f, 0x59, 0x40, 0xc0, 0x66, 0x1c,
+	0xb0, 0xfa, 0xbb, 0x00, 0x6b, 0xd3, 0xa7, 0x64, 0xb9, 0xe1, 0x26, 0x00, 0xec, 0x50, 0x71, 0x01,
+	0x7f, 0x30, 0x1e, 0xc9, 0x1b, 0xfc, 0x11, 0x5c, 0x30, 0xe4, 0x49, 0x6f, 0x77, 0xb9, 0xaf, 0x5e,
+	0x46, 0x20, 0xf1, 0x3f, 0x14, 0xf5, 0x18, 0xd2, 0x5a, 0xb3, 0x49, 0x7b, 0x1d, 0xbb, 0xe1, 0xb0,
+	0xba, 0x7e, 0xae, 0x5d, 0x59, 0xb6, 0x18, 0xaa, 0xde, 0xef, 0x92, 0xf2, 0x36, 0x57, 0xb2, 0xb8,
+	0xcd, 0x4e, 0xc9, 0x9a, 0xa0, 0xf8, 0xe8, 0x45, 0x96, 0x2a, 0xd6, 0xe8, 0x2b, 0x15, 0xeb, 0xca,
+	0x4b, 0xc5, 0x1a, 0xbb, 0x89, 0x58, 0xe3, 0x4b, 0x13, 0xeb, 0x8f, 0x51, 0xd8, 0xe4, 0x4a, 0xc7,
+	0xb1, 0x49, 0xbb, 0xd4, 0xd2, 0x5a, 0x8e, 0x17, 0xb6, 0x61, 0xb7, 0x88, 0x97, 0x6a, 0x36, 0x98,
+	0x95, 0x41, 0x78, 0x5e, 0x06, 0x4f, 0x60, 0xd5, 0xcf, 0x34, 0xd3, 0x41, 0xc4, 0xd5, 0x41, 0x7e,
+	0x5e, 0x07, 0xbe, 0x49, 0x57, 0x08, 0x5c, 0x1c, 0xa6, 0xb6, 0xab, 0x28, 0xad, 0x73, 0x38, 0x5e,
+	0x0a, 0xd1, 0x1b, 0x4b, 0x01, 0x41, 0x36, 0x30, 0xc6, 0xbb, 0xe5, 0xd4, 0x91, 0x64, 0x59, 0x1e,
+	0x8f, 0xe4, 0x9d, 0x99, 0x57, 0xe2, 0x50, 0x2a, 0xda, 0xf4, 0xa7, 0x2b, 0x9c, 0xff, 0x41, 0xf6,
+	0x63, 0x2f, 0xcd, 0x7e, 0xfc, 0x26, 0xd9, 0x4f, 0x2c, 0x2b, 0xfb, 0xa2, 0x04, 0x71, 0x9d, 0x74,
+	0x88, 0x65, 0x58, 0x52, 0x52, 0x11, 0x76, 0x13, 0xc8, 0x1f, 0xde, 0x8b, 0xfe, 0xf0, 0x93, 0x1c,
+	0x52, 0xbf, 0x0d, 0xc3, 0xd6, 0x74, 0x29, 0x7b, 0x93, 0x04, 0xe2, 0x05, 0x61, 0x18, 0x86, 0x9d,
+	0xd3, 0x2e, 0xd6, 0x26, 0x41, 0x40, 0x4e, 0x52, 0xdf, 0xa8, 0xa3, 0x72, 0x65, 0x51, 0xf3, 0xe2,
+	0xf3, 0x6f, 0x04, 0x56, 0x8f, 0x49, 0x07, 0x1b, 0x1d, 0xdd, 0xeb, 0x8e, 0xb6, 0x20, 0x1c, 0xb4,
+	0x45, 0xb1, 0x8b, 0x91, 0x1c, 0xae, 0x55, 0x50, 0xd8, 0xc0, 0xe2, 0x29, 0xa4, 0xbc, 0x76, 0x88,
+	0xbb, 0x22, 0xde, 0xbe, 0xea, 0x8a, 0x70, 0x40, 0xae, 0xb7, 0x5b, 0x13, 0x25, 0x73, 0x5b, 0x55,
+	0x04, 0x5a, 0x80, 0x71, 0xaf, 0x1e, 0xbe, 0x63, 0x8e, 0x5c, 0xa7, 0x63, 0xde, 0xbe, 0x4e, 0xb7,
+	0x2c, 0x92, 0xf9, 0xce, 0x38, 0x7a, 0xcd, 0xce, 0x38, 0x77, 0xfd, 0xae, 0x58, 0x3c, 0x82, 0x24,
+	0x6b, 0x3b, 0xb5, 0x16, 0x0b, 0xf1, 0x42, 0xd9, 0x9a, 0x70, 0x88, 0x1f, 0x03, 0xb0, 0xee, 0xd0,
+	0xa0, 0x1d, 0x56, 0x8b, 0x16, 0x62, 0xe4, 0x48, 0xc4, 0x2d, 0x88, 0xf1, 0xc5, 0x0b, 0x79, 0x23,
+	0xf5, 0x8f, 0x30, 0x24, 0x4a, 0x3d, 0x6c, 0xd8, 0x07, 0x54, 0x7f, 0xdd, 0x99, 0x5f, 0x6a, 0x67,
+	0x70, 0x08, 0x09, 0xda, 0x25, 0xa6, 0x66, 0x53, 0x73, 0xf1, 0x13, 0x13, 0x50, 0xcc, 0x16, 0x80,
+	0x95, 0xf9, 0x02, 0x30, 0x89, 0x68, 0x6c, 0x2a, 0xa2, 0x9f, 0x41, 0xec, 0x58, 0x33, 0xb5, 0xb6,
+	0x25, 0x1e, 0x80, 0xe8, 0xe7, 0xb4, 0x61, 0x9f, 0x9b, 0xc4, 0x3a, 0xa7, 0x2d, 0x16, 0xde, 0xd5,
+	0xf2, 0xed, 0xf1, 0x48, 0xbe, 0xe5, 0xc5, 0x67, 0x0e, 0xa3, 0xa2, 0x0d, 0x7f, 0xb2, 0xee, 0xcf,
+	0xb1, 0xe3, 0x7a, 0xa7, 0x06, 0x29, 0xae, 0x05, 0x73, 0xae, 0x80, 0xfd, 0xea, 0xa3, 0xea, 0x49,
+	0xed, 0x24, 0x13, 0xca, 0xa5, 0x06, 0x43, 0x25, 0xbe, 0xcf, 0xae, 0x00, 0x31, 0x07, 0x89, 0x23,
+	0x54, 0xa9, 0x3d, 0x2a, 0xa1, 0xc7, 0x19, 0x21, 0x97, 0x1e, 0x0c, 0x95, 0xc4, 0x91, 0x89, 0x8d,
+	0x8e, 0x66, 0xf6, 0x73, 0xd1, 0xef, 0x7e, 0xce, 0x87, 0xee, 0x7c, 0x04, 0x69, 0xbe, 0x34, 0x39,
+	0x3b, 0x8e, 0xd1, 0xd1, 0x83, 0xda, 0x41, 0x15, 0x65, 0x42, 0x6c, 0x47, 0xf0, 0x97, 0x5a, 0x82,
+	0x78, 0x1d, 0x9d, 0x9e, 0xd4, 0xab, 0xd5, 0x8c, 0xc0, 0xec, 0x78, 0xff, 0x3e, 0x3d, 0xae, 0xdf,
+	0x04, 0x80, 0x49, 0xf6, 0xc5, 0x77, 0x21, 0x5d, 0xaa, 0x54, 0x1a, 0x1c, 0xdd, 0x5b, 0x83, 0xa1,
+	0xb2, 0xc1, 0x10, 0xfc, 0xe7, 0x81, 0x77, 0x20, 0xe5, 0x00, 0x27, 0xdc, 0xd9, 0xc1, 0x50, 0xc9,
+	0x04, 0x38, 0xff, 0x2f, 0xee, 0x1e, 0xac, 0x57, 0xaa, 0x07, 0xd5, 0x7a, 0x75, 0x42, 0x19, 0xce,
+	0x49, 0x83, 0xa1, 0x92, 0x65, 0xd0, 0x99, 0x0f, 0x00, 0xef, 0xc1, 0x9a, 0x07, 0xf7, 0x89, 0x23,
+	0xb9, 0xed, 0xc1, 0x50, 0xd9, 0xe4, 0xd1, 0x53, 0x0e, 0x94, 0x1f, 0x3e, 0xbf, 0xc8, 0x0b, 0x2f,
+	0x2e, 0xf2, 0xc2, 0xdf, 0x17, 0x79, 0xe1, 0xd9, 0x65, 0x3e, 0xf4, 0xe2, 0x32, 0x1f, 0xfa, 0xf3,
+	0x32, 0x1f, 0xfa, 0xf4, 0x2e, 0x27, 0x21, 0x47, 0xf1, 0x1d, 0x62, 0x17, 0x3d, 0xe5, 0x17, 0xdb,
+	0x14, 0xf7, 0x5a, 0xc4, 0x0a, 0x3e, 0xb5, 0x30, 0x45, 0x9d, 0xc5, 0xdc, 0x4b, 0xfd, 0x83, 0xff,
+	0x06, 0x00, 0xfe, 0x10, 0x52, 0xd0, 0x8c, 0x11, 0x00, 0x00,
+}
+
+func (m *MsgAddProfiler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddProfiler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddProfiler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
+	{
+		size, err := m.AddGuardian.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGuardian(dAtA, i, uint64(size))
 	}
-	l = len(m.DeletedBy)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteProfiler) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteProfiler) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteProfiler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DeleteGuardian.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGuardian(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddTrustee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddTrustee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddTrustee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AddGuardian.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGuardian(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTrustee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteTrustee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteTrustee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DeleteGuardian.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGuardian(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveGuardianAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveGuardianAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveGuardianAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ActionID != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ActionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectGuardianAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectGuardianAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectGuardianAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rejecter) > 0 {
+		i -= len(m.Rejecter)
+		copy(dAtA[i:], m.Rejecter)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Rejecter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ActionID != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ActionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddGuardian) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddGuardian) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddGuardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintGuardian(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintGuardian(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteGuardian) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteGuardian) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteGuardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeletedBy) > 0 {
+		i -= len(m.DeletedBy)
+		copy(dAtA[i:], m.DeletedBy)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.DeletedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Guardian) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Guardian) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Guardian) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGuardian(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintGuardian(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AccountType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddGuardianProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddGuardianProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddGuardianProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Genesis {
+		i--
+		if m.Genesis {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpiryTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGuardian(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintGuardian(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GuardianDescription) > 0 {
+		i -= len(m.GuardianDescription)
+		copy(dAtA[i:], m.GuardianDescription)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.GuardianDescription)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GuardianType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.GuardianType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteGuardianProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteGuardianProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteGuardianProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GuardianType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.GuardianType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateGuardianRolesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateGuardianRolesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateGuardianRolesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintGuardian(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GuardianType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.GuardianType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Rejections) > 0 {
+		for iNdEx := len(m.Rejections) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Rejections[iNdEx])
+			copy(dAtA[i:], m.Rejections[iNdEx])
+			i = encodeVarintGuardian(dAtA, i, uint64(len(m.Rejections[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintGuardian(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DeleteGuardian != nil {
+		{
+			size, err := m.DeleteGuardian.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGuardian(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AddGuardian != nil {
+		{
+			size, err := m.AddGuardian.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGuardian(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActionType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ActionType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActionType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ActionType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApprovalThreshold != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ApprovalThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGuardian(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGuardian(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddProfiler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AddGuardian.Size()
+	n += 1 + l + sovGuardian(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteProfiler) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeleteGuardian.Size()
+	n += 1 + l + sovGuardian(uint64(l))
+	return n
+}
+
+func (m *MsgAddTrustee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AddGuardian.Size()
+	n += 1 + l + sovGuardian(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteTrustee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeleteGuardian.Size()
+	n += 1 + l + sovGuardian(uint64(l))
+	return n
+}
+
+func (m *MsgApproveGuardianAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActionID != 0 {
+		n += 1 + sovGuardian(uint64(m.ActionID))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectGuardianAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActionID != 0 {
+		n += 1 + sovGuardian(uint64(m.ActionID))
+	}
+	l = len(m.Rejecter)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *AddGuardian) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGuardian(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteGuardian) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.DeletedBy)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *Guardian) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovGuardian(uint64(m.AccountType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGuardian(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *AddGuardianProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.GuardianType != 0 {
+		n += 1 + sovGuardian(uint64(m.GuardianType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.GuardianDescription)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGuardian(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.Genesis {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeleteGuardianProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.GuardianType != 0 {
+		n += 1 + sovGuardian(uint64(m.GuardianType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateGuardianRolesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.GuardianType != 0 {
+		n += 1 + sovGuardian(uint64(m.GuardianType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovGuardian(uint64(m.ID))
+	}
+	if m.ActionType != 0 {
+		n += 1 + sovGuardian(uint64(m.ActionType))
+	}
+	if m.AddGuardian != nil {
+		l = m.AddGuardian.Size()
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.DeleteGuardian != nil {
+		l = m.DeleteGuardian.Size()
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, b := range m.Approvals {
+			l = len(b)
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	if len(m.Rejections) > 0 {
+		for _, b := range m.Rejections {
+			l = len(b)
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovGuardian(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *AuditLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovGuardian(uint64(m.ID))
+	}
+	if m.ActionType != 0 {
+		n += 1 + sovGuardian(uint64(m.ActionType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGuardian(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApprovalThreshold != 0 {
+		n += 1 + sovGuardian(uint64(m.ApprovalThreshold))
+	}
+	return n
+}
+
+func sovGuardian(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGuardian(x uint64) (n int) {
+	return sovGuardian(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddProfiler) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddProfiler: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddProfiler: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddGuardian", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddGuardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteProfiler) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteProfiler: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteProfiler: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGuardian", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeleteGuardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddTrustee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddTrustee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddTrustee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddGuardian", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddGuardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteTrustee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteTrustee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteTrustee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGuardian", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeleteGuardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveGuardianAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveGuardianAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveGuardianAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionID", wireType)
+			}
+			m.ActionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = append(m.Approver[:0], dAtA[iNdEx:postIndex]...)
+			if m.Approver == nil {
+				m.Approver = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectGuardianAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectGuardianAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectGuardianAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionID", wireType)
+			}
+			m.ActionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejecter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rejecter = append(m.Rejecter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Rejecter == nil {
+				m.Rejecter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddGuardian) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddGuardian: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddGuardian: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = append(m.AddedBy[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddedBy == nil {
+				m.AddedBy = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteGuardian) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteGuardian: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteGuardian: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedBy = append(m.DeletedBy[:0], dAtA[iNdEx:postIndex]...)
+			if m.DeletedBy == nil {
+				m.DeletedBy = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Guardian) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Guardian: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Guardian: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= AccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = append(m.AddedBy[:0], dAtA[iNdEx:postIndex]...)
+			if m.AddedBy == nil {
+				m.AddedBy = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddGuardianProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddGuardianProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddGuardianProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianType", wireType)
+			}
+			m.GuardianType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianType |= GuardianType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Genesis = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Guardian) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
-	}
-	if m.AccountType != 0 {
-		n += 1 + sovGuardian(uint64(m.AccountType))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
-	}
-	l = len(m.AddedBy)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGuardian(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGuardian(x uint64) (n int) {
-	return sovGuardian(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddProfiler) Unmarshal(dAtA []byte) error {
+func (m *DeleteGuardianProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -871,21 +3838,104 @@ func (m *MsgAddProfiler) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddProfiler: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddProfiler: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteGuardianProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteGuardianProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianType", wireType)
+			}
+			m.GuardianType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianType |= GuardianType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddGuardian", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGuardian
@@ -895,23 +3945,24 @@ func (m *MsgAddProfiler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGuardian
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGuardian
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AddGuardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -938,7 +3989,7 @@ func (m *MsgAddProfiler) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteProfiler) Unmarshal(dAtA []byte) error {
+func (m *UpdateGuardianRolesProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -961,17 +4012,17 @@ func (m *MsgDeleteProfiler) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteProfiler: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateGuardianRolesProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteProfiler: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateGuardianRolesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGuardian", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGuardian
@@ -981,83 +4032,114 @@ func (m *MsgDeleteProfiler) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGuardian
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGuardian
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DeleteGuardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGuardian(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGuardian
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGuardian
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddTrustee) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGuardian
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianType", wireType)
 			}
-			if iNdEx >= l {
+			m.GuardianType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GuardianType |= GuardianType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddTrustee: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddTrustee: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddGuardian", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGuardian
@@ -1067,24 +4149,23 @@ func (m *MsgAddTrustee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGuardian
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGuardian
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AddGuardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1110,7 +4191,7 @@ func (m *MsgAddTrustee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteTrustee) Unmarshal(dAtA []byte) error {
+func (m *PendingAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1133,15 +4214,53 @@ func (m *MsgDeleteTrustee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteTrustee: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteTrustee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			m.ActionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionType |= ActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGuardian", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddGuardian", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1165,71 +4284,21 @@ func (m *MsgDeleteTrustee) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGuardian
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DeleteGuardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGuardian(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGuardian
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGuardian
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddGuardian) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGuardian
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.AddGuardian == nil {
+				m.AddGuardian = &AddGuardian{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddGuardian: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddGuardian: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.AddGuardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGuardian", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGuardian
@@ -1239,27 +4308,31 @@ func (m *AddGuardian) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGuardian
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGuardian
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.DeleteGuardian == nil {
+				m.DeleteGuardian = &DeleteGuardian{}
+			}
+			if err := m.DeleteGuardian.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1286,14 +4359,12 @@ func (m *AddGuardian) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
+			m.Approvals = append(m.Approvals, make([]byte, postIndex-iNdEx))
+			copy(m.Approvals[len(m.Approvals)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1320,11 +4391,28 @@ func (m *AddGuardian) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedBy = append(m.AddedBy[:0], dAtA[iNdEx:postIndex]...)
-			if m.AddedBy == nil {
-				m.AddedBy = []byte{}
-			}
+			m.Rejections = append(m.Rejections, make([]byte, postIndex-iNdEx))
+			copy(m.Rejections[len(m.Rejections)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGuardian(dAtA[iNdEx:])
@@ -1349,7 +4437,7 @@ func (m *AddGuardian) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteGuardian) Unmarshal(dAtA []byte) error {
+func (m *AuditLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1372,13 +4460,51 @@ func (m *DeleteGuardian) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteGuardian: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteGuardian: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+			}
+			m.ActionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionType |= ActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -1412,9 +4538,9 @@ func (m *DeleteGuardian) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1441,65 +4567,12 @@ func (m *DeleteGuardian) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedBy = append(m.DeletedBy[:0], dAtA[iNdEx:postIndex]...)
-			if m.DeletedBy == nil {
-				m.DeletedBy = []byte{}
+			m.Operator = append(m.Operator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Operator == nil {
+				m.Operator = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGuardian(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGuardian
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGuardian
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Guardian) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGuardian
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Guardian: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Guardian: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
@@ -1531,11 +4604,11 @@ func (m *Guardian) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.AccountType = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGuardian
@@ -1545,50 +4618,69 @@ func (m *Guardian) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountType |= AccountType(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGuardian
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGuardian
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGuardian
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalThreshold", wireType)
+			}
+			m.ApprovalThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGuardian
@@ -1598,26 +4690,11 @@ func (m *Guardian) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ApprovalThreshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGuardian
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGuardian
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AddedBy = append(m.AddedBy[:0], dAtA[iNdEx:postIndex]...)
-			if m.AddedBy == nil {
-				m.AddedBy = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGuardian(dAtA[iNdEx:])
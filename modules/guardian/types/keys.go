@@ -19,13 +19,27 @@ const (
 	QuerierRoute = StoreKey
 
 	// Query endpoints supported by the guardian querier
-	QueryProfilers = "profilers"
-	QueryTrustees  = "trustees"
+	QueryProfilers      = "profilers"
+	QueryTrustees       = "trustees"
+	QueryPendingAction  = "pending_action"
+	QueryPendingActions = "pending_actions"
+	QueryAuditLogs      = "audit_logs"
+	QueryParams         = "params"
+
+	// DefaultPendingActionsLimit is the default number of the pending actions returned in a page
+	DefaultPendingActionsLimit = 100
+	// DefaultAuditLogsLimit is the default number of the audit logs returned in a page
+	DefaultAuditLogsLimit = 100
 )
 
 var (
 	ProfilerKey = []byte{0x00} // profiler key
 	TrusteeKey  = []byte{0x01} // trustee key
+
+	PendingActionKey = []byte{0x02} // key prefix for the pending actions
+	NextActionIDKey  = []byte{0x03} // key for the id of the next pending action
+	AuditLogKey      = []byte{0x04} // key prefix for the audit logs
+	NextAuditLogKey  = []byte{0x05} // key for the id of the next audit log
 )
 
 // GetProfilerKey returns profiler key bytes
@@ -47,3 +61,23 @@ func GetProfilersSubspaceKey() []byte {
 func GetTrusteesSubspaceKey() []byte {
 	return TrusteeKey
 }
+
+// GetPendingActionKey returns the key of the pending action with the specified id
+func GetPendingActionKey(id uint64) []byte {
+	return append(PendingActionKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPendingActionsSubspaceKey returns the key for getting all pending actions from the store
+func GetPendingActionsSubspaceKey() []byte {
+	return PendingActionKey
+}
+
+// GetAuditLogKey returns the key of the audit log with the specified id
+func GetAuditLogKey(id uint64) []byte {
+	return append(AuditLogKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetAuditLogsSubspaceKey returns the key for getting all audit logs from the store
+func GetAuditLogsSubspaceKey() []byte {
+	return AuditLogKey
+}
@@ -10,6 +10,9 @@ const (
 	TypeMsgDeleteProfiler = "delete_profiler" // type for MsgDeleteProfiler
 	TypeMsgAddTrustee     = "add_trustee"     // type for MsgAddTrustee
 	TypeMsgDeleteTrustee  = "delete_trustee"  // type for MsgDeleteTrustee
+
+	TypeMsgApproveGuardianAction = "approve_guardian_action" // type for MsgApproveGuardianAction
+	TypeMsgRejectGuardianAction  = "reject_guardian_action"  // type for MsgRejectGuardianAction
 )
 
 var (
@@ -17,15 +20,18 @@ var (
 	_ sdk.Msg = &MsgAddTrustee{}
 	_ sdk.Msg = &MsgDeleteProfiler{}
 	_ sdk.Msg = &MsgDeleteTrustee{}
+	_ sdk.Msg = &MsgApproveGuardianAction{}
+	_ sdk.Msg = &MsgRejectGuardianAction{}
 )
 
 // NewMsgAddProfiler constructs a MsgAddProfiler
-func NewMsgAddProfiler(description string, address, addedBy sdk.AccAddress) *MsgAddProfiler {
+func NewMsgAddProfiler(description string, address, addedBy sdk.AccAddress, roles ...string) *MsgAddProfiler {
 	return &MsgAddProfiler{
 		AddGuardian: AddGuardian{
 			Description: description,
 			Address:     address,
 			AddedBy:     addedBy,
+			Roles:       roles,
 		},
 	}
 }
@@ -95,12 +101,13 @@ func (msg MsgDeleteProfiler) GetSigners() []sdk.AccAddress {
 //______________________________________________________________________
 
 // NewMsgAddTrustee constructs a MsgAddTrustee
-func NewMsgAddTrustee(description string, address, addedAddress sdk.AccAddress) *MsgAddTrustee {
+func NewMsgAddTrustee(description string, address, addedAddress sdk.AccAddress, roles ...string) *MsgAddTrustee {
 	return &MsgAddTrustee{
 		AddGuardian: AddGuardian{
 			Description: description,
 			Address:     address,
 			AddedBy:     addedAddress,
+			Roles:       roles,
 		},
 	}
 }
@@ -169,6 +176,82 @@ func (msg MsgDeleteTrustee) GetSigners() []sdk.AccAddress {
 
 //______________________________________________________________________
 
+// NewMsgApproveGuardianAction constructs a MsgApproveGuardianAction
+func NewMsgApproveGuardianAction(actionID uint64, approver sdk.AccAddress) *MsgApproveGuardianAction {
+	return &MsgApproveGuardianAction{
+		ActionID: actionID,
+		Approver: approver,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgApproveGuardianAction) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgApproveGuardianAction) Type() string { return TypeMsgApproveGuardianAction }
+
+// GetSignBytes implements Msg.
+func (msg MsgApproveGuardianAction) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgApproveGuardianAction) ValidateBasic() error {
+	if len(msg.Approver) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "approver address missing")
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgApproveGuardianAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Approver}
+}
+
+//______________________________________________________________________
+
+// NewMsgRejectGuardianAction constructs a MsgRejectGuardianAction
+func NewMsgRejectGuardianAction(actionID uint64, rejecter sdk.AccAddress) *MsgRejectGuardianAction {
+	return &MsgRejectGuardianAction{
+		ActionID: actionID,
+		Rejecter: rejecter,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRejectGuardianAction) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRejectGuardianAction) Type() string { return TypeMsgRejectGuardianAction }
+
+// GetSignBytes implements Msg.
+func (msg MsgRejectGuardianAction) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgRejectGuardianAction) ValidateBasic() error {
+	if len(msg.Rejecter) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "rejecter address missing")
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgRejectGuardianAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Rejecter}
+}
+
+//______________________________________________________________________
+
 // ValidateBasic validate the AddGuardian
 func (g AddGuardian) ValidateBasic() error {
 	if len(g.Description) == 0 {
@@ -183,7 +266,10 @@ func (g AddGuardian) ValidateBasic() error {
 	if err := g.EnsureLength(); err != nil {
 		return err
 	}
-	return nil
+	if err := ValidateExpiry(g.ExpiryHeight, g.ExpiryTime); err != nil {
+		return err
+	}
+	return ValidateRoles(g.Roles)
 }
 
 // ValidateBasic validate the DeleteGuardian
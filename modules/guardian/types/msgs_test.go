@@ -36,7 +36,7 @@ func init() {
 // ----------------------------------------------
 
 func TestNewMsgAddProfiler(t *testing.T) {
-	addGuardian := AddGuardian{description, testAddr, sender}
+	addGuardian := AddGuardian{Description: description, Address: testAddr, AddedBy: sender}
 	msg := NewMsgAddProfiler(description, testAddr, sender)
 	require.Equal(t, addGuardian, msg.AddGuardian)
 }
@@ -76,6 +76,11 @@ func TestMsgAddProfilerValidation(t *testing.T) {
 		{"invalid Description", false, NewMsgAddProfiler(nilDescription, testAddr, sender)},
 		{"invalid Address", false, NewMsgAddProfiler(description, nilAddr, sender)},
 		{"invalid AddedBy", false, NewMsgAddProfiler(description, testAddr, nilAddr)},
+		{"pass with roles", true, NewMsgAddProfiler(description, testAddr, sender, RoleFeedAdmin)},
+		{"removed Roles", false, NewMsgAddProfiler(description, testAddr, sender, "service-admin")},
+		{"invalid Roles", false, NewMsgAddProfiler(description, testAddr, sender, "admin")},
+		{"duplicate Roles", false, NewMsgAddProfiler(description, testAddr, sender, RoleFeedAdmin, RoleFeedAdmin)},
+		{"invalid ExpiryHeight", false, &MsgAddProfiler{AddGuardian{Description: description, Address: testAddr, AddedBy: sender, ExpiryHeight: -1}}},
 	}
 
 	for _, tc := range tests {
@@ -153,7 +158,7 @@ func TestMsgDeleteProfilerValidation(t *testing.T) {
 // ----------------------------------------------
 
 func TestNewMsgAddTrustee(t *testing.T) {
-	addGuardian := AddGuardian{description, testAddr, sender}
+	addGuardian := AddGuardian{Description: description, Address: testAddr, AddedBy: sender}
 	msg := NewMsgAddTrustee(description, testAddr, sender)
 	require.Equal(t, addGuardian, msg.AddGuardian)
 }
@@ -264,3 +269,33 @@ func TestMsgDeleteTrusteeValidation(t *testing.T) {
 		})
 	}
 }
+
+// ----------------------------------------------
+// test MsgApproveGuardianAction and MsgRejectGuardianAction
+// ----------------------------------------------
+
+func TestMsgApproveGuardianAction(t *testing.T) {
+	msg := NewMsgApproveGuardianAction(1, sender)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgApproveGuardianAction, msg.Type())
+	require.Equal(t, []sdk.AccAddress{sender}, msg.GetSigners())
+
+	expected := `{"type":"irishub/guardian/MsgApproveGuardianAction","value":{"action_id":"1","approver":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+
+	require.NoError(t, msg.ValidateBasic())
+	require.Error(t, NewMsgApproveGuardianAction(1, nilAddr).ValidateBasic())
+}
+
+func TestMsgRejectGuardianAction(t *testing.T) {
+	msg := NewMsgRejectGuardianAction(1, sender)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgRejectGuardianAction, msg.Type())
+	require.Equal(t, []sdk.AccAddress{sender}, msg.GetSigners())
+
+	expected := `{"type":"irishub/guardian/MsgRejectGuardianAction","value":{"action_id":"1","rejecter":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+
+	require.NoError(t, msg.ValidateBasic())
+	require.Error(t, NewMsgRejectGuardianAction(1, nilAddr).ValidateBasic())
+}
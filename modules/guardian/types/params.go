@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store key
+var (
+	KeyApprovalThreshold = []byte("ApprovalThreshold")
+)
+
+// ParamKeyTable for guardian module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs the guardian module parameters
+func NewParams(approvalThreshold uint32) Params {
+	return Params{
+		ApprovalThreshold: approvalThreshold,
+	}
+}
+
+// DefaultParams returns default guardian module parameters,
+// a single approval executes the guardian actions at once
+func DefaultParams() Params {
+	return NewParams(1)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyApprovalThreshold, &p.ApprovalThreshold, validateApprovalThreshold),
+	}
+}
+
+// GetParamSpace implements params.ParamStruct
+func (p *Params) GetParamSpace() string {
+	return DefaultParamSpace
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	return validateApprovalThreshold(p.ApprovalThreshold)
+}
+
+func validateApprovalThreshold(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("approval threshold must be positive: %d", v)
+	}
+
+	return nil
+}
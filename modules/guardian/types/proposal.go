@@ -0,0 +1,215 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeAddGuardian    = "AddGuardian"         // type for AddGuardianProposal
+	ProposalTypeDeleteGuardian = "DeleteGuardian"      // type for DeleteGuardianProposal
+	ProposalTypeUpdateRoles    = "UpdateGuardianRoles" // type for UpdateGuardianRolesProposal
+)
+
+var (
+	_ govtypes.Content = &AddGuardianProposal{}
+	_ govtypes.Content = &DeleteGuardianProposal{}
+	_ govtypes.Content = &UpdateGuardianRolesProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddGuardian)
+	govtypes.RegisterProposalTypeCodec(&AddGuardianProposal{}, "irishub/guardian/AddGuardianProposal")
+	govtypes.RegisterProposalType(ProposalTypeDeleteGuardian)
+	govtypes.RegisterProposalTypeCodec(&DeleteGuardianProposal{}, "irishub/guardian/DeleteGuardianProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateRoles)
+	govtypes.RegisterProposalTypeCodec(&UpdateGuardianRolesProposal{}, "irishub/guardian/UpdateGuardianRolesProposal")
+}
+
+// NewAddGuardianProposal constructs an AddGuardianProposal
+func NewAddGuardianProposal(
+	title, description string,
+	guardianType GuardianType,
+	address sdk.AccAddress,
+	guardianDescription string,
+	roles ...string,
+) *AddGuardianProposal {
+	return &AddGuardianProposal{
+		Title:               title,
+		Description:         description,
+		GuardianType:        guardianType,
+		Address:             address,
+		GuardianDescription: guardianDescription,
+		Roles:               roles,
+	}
+}
+
+// AccountType returns the account type of the guardian added by the proposal
+func (p AddGuardianProposal) AccountType() AccountType {
+	if p.Genesis {
+		return Genesis
+	}
+	return Ordinary
+}
+
+// ProposalRoute implements Content.
+func (p *AddGuardianProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements Content.
+func (p *AddGuardianProposal) ProposalType() string { return ProposalTypeAddGuardian }
+
+// ValidateBasic implements Content.
+func (p *AddGuardianProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if !ValidGuardianType(p.GuardianType) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid guardian type: %d", p.GuardianType)
+	}
+	if len(p.Address) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "added address missing")
+	}
+	if len(p.GuardianDescription) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "guardian description missing")
+	}
+	if err := (AddGuardian{Description: p.GuardianDescription}).EnsureLength(); err != nil {
+		return err
+	}
+	if err := ValidateExpiry(p.ExpiryHeight, p.ExpiryTime); err != nil {
+		return err
+	}
+	return ValidateRoles(p.Roles)
+}
+
+// String implements Content.
+func (p AddGuardianProposal) String() string {
+	return fmt.Sprintf(`Add Guardian Proposal:
+  Title:                %s
+  Description:          %s
+  Guardian Type:        %s
+  Address:              %s
+  Guardian Description: %s
+  Roles:                %s
+  Genesis:              %t
+`, p.Title, p.Description, p.GuardianType, p.Address, p.GuardianDescription, strings.Join(p.Roles, ","), p.Genesis)
+}
+
+//______________________________________________________________________
+
+// NewDeleteGuardianProposal constructs a DeleteGuardianProposal
+func NewDeleteGuardianProposal(
+	title, description string,
+	guardianType GuardianType,
+	address sdk.AccAddress,
+) *DeleteGuardianProposal {
+	return &DeleteGuardianProposal{
+		Title:        title,
+		Description:  description,
+		GuardianType: guardianType,
+		Address:      address,
+	}
+}
+
+// ProposalRoute implements Content.
+func (p *DeleteGuardianProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements Content.
+func (p *DeleteGuardianProposal) ProposalType() string { return ProposalTypeDeleteGuardian }
+
+// ValidateBasic implements Content.
+func (p *DeleteGuardianProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if !ValidGuardianType(p.GuardianType) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid guardian type: %d", p.GuardianType)
+	}
+	if len(p.Address) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "deleted address missing")
+	}
+	return nil
+}
+
+// String implements Content.
+func (p DeleteGuardianProposal) String() string {
+	return fmt.Sprintf(`Delete Guardian Proposal:
+  Title:         %s
+  Description:   %s
+  Guardian Type: %s
+  Address:       %s
+`, p.Title, p.Description, p.GuardianType, p.Address)
+}
+
+//______________________________________________________________________
+
+// NewUpdateGuardianRolesProposal constructs an UpdateGuardianRolesProposal
+func NewUpdateGuardianRolesProposal(
+	title, description string,
+	guardianType GuardianType,
+	address sdk.AccAddress,
+	roles ...string,
+) *UpdateGuardianRolesProposal {
+	return &UpdateGuardianRolesProposal{
+		Title:        title,
+		Description:  description,
+		GuardianType: guardianType,
+		Address:      address,
+		Roles:        roles,
+	}
+}
+
+// ProposalRoute implements Content.
+func (p *UpdateGuardianRolesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements Content.
+func (p *UpdateGuardianRolesProposal) ProposalType() string { return ProposalTypeUpdateRoles }
+
+// ValidateBasic implements Content.
+func (p *UpdateGuardianRolesProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if !ValidGuardianType(p.GuardianType) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid guardian type: %d", p.GuardianType)
+	}
+	if len(p.Address) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "guardian address missing")
+	}
+	return ValidateRoles(p.Roles)
+}
+
+// String implements Content.
+func (p UpdateGuardianRolesProposal) String() string {
+	return fmt.Sprintf(`Update Guardian Roles Proposal:
+  Title:         %s
+  Description:   %s
+  Guardian Type: %s
+  Address:       %s
+  Roles:         %s
+`, p.Title, p.Description, p.GuardianType, p.Address, strings.Join(p.Roles, ","))
+}
+
+//______________________________________________________________________
+
+// GuardianTypeFromString converts string to GuardianType, case insensitive
+func GuardianTypeFromString(str string) (GuardianType, error) {
+	switch strings.ToLower(str) {
+	case "profiler":
+		return Profiler, nil
+	case "trustee":
+		return Trustee, nil
+	default:
+		return GuardianType(0xff), errors.Errorf("'%s' is not a valid guardian type", str)
+	}
+}
+
+// ValidGuardianType returns true if the GuardianType option is valid and false otherwise.
+func ValidGuardianType(option GuardianType) bool {
+	return option == Profiler || option == Trustee
+}
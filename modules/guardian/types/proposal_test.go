@@ -0,0 +1,88 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddGuardianProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *AddGuardianProposal
+		expPass  bool
+	}{
+		{"valid profiler", NewAddGuardianProposal("title", "description", Profiler, testAddr, description), true},
+		{"valid trustee", NewAddGuardianProposal("title", "description", Trustee, testAddr, description), true},
+		{"empty title", NewAddGuardianProposal("", "description", Profiler, testAddr, description), false},
+		{"invalid guardian type", NewAddGuardianProposal("title", "description", GuardianType(2), testAddr, description), false},
+		{"empty address", NewAddGuardianProposal("title", "description", Profiler, nilAddr, description), false},
+		{"empty guardian description", NewAddGuardianProposal("title", "description", Profiler, testAddr, nilDescription), false},
+	}
+
+	for _, tc := range testCases {
+		if tc.expPass {
+			require.NoError(t, tc.proposal.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.proposal.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestDeleteGuardianProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *DeleteGuardianProposal
+		expPass  bool
+	}{
+		{"valid profiler", NewDeleteGuardianProposal("title", "description", Profiler, testAddr), true},
+		{"valid trustee", NewDeleteGuardianProposal("title", "description", Trustee, testAddr), true},
+		{"empty description", NewDeleteGuardianProposal("title", "", Profiler, testAddr), false},
+		{"invalid guardian type", NewDeleteGuardianProposal("title", "description", GuardianType(2), testAddr), false},
+		{"empty address", NewDeleteGuardianProposal("title", "description", Trustee, nilAddr), false},
+	}
+
+	for _, tc := range testCases {
+		if tc.expPass {
+			require.NoError(t, tc.proposal.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.proposal.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestUpdateGuardianRolesProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *UpdateGuardianRolesProposal
+		expPass  bool
+	}{
+		{"valid roles", NewUpdateGuardianRolesProposal("title", "description", Profiler, testAddr, RoleFeedAdmin), true},
+		{"revoke all roles", NewUpdateGuardianRolesProposal("title", "description", Trustee, testAddr), true},
+		{"empty description", NewUpdateGuardianRolesProposal("title", "", Profiler, testAddr), false},
+		{"invalid guardian type", NewUpdateGuardianRolesProposal("title", "description", GuardianType(2), testAddr), false},
+		{"empty address", NewUpdateGuardianRolesProposal("title", "description", Trustee, nilAddr), false},
+		{"invalid role", NewUpdateGuardianRolesProposal("title", "description", Profiler, testAddr, "admin"), false},
+	}
+
+	for _, tc := range testCases {
+		if tc.expPass {
+			require.NoError(t, tc.proposal.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.proposal.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestGuardianTypeFromString(t *testing.T) {
+	guardianType, err := GuardianTypeFromString("Profiler")
+	require.NoError(t, err)
+	require.Equal(t, Profiler, guardianType)
+
+	guardianType, err = GuardianTypeFromString("trustee")
+	require.NoError(t, err)
+	require.Equal(t, Trustee, guardianType)
+
+	_, err = GuardianTypeFromString("genesis")
+	require.Error(t, err)
+}
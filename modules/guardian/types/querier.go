@@ -0,0 +1,18 @@
+package types
+
+// QueryPendingActionParams is the query parameters for 'custom/guardian/pending_action'
+type QueryPendingActionParams struct {
+	ID uint64 `json:"id" yaml:"id"` // id of the pending action
+}
+
+// QueryPendingActionsParams is the query parameters for 'custom/guardian/pending_actions'
+type QueryPendingActionsParams struct {
+	Page  uint64 `json:"page" yaml:"page"`   // page number, starting from 1
+	Limit uint64 `json:"limit" yaml:"limit"` // number of actions in a page
+}
+
+// QueryAuditLogsParams is the query parameters for 'custom/guardian/audit_logs'
+type QueryAuditLogsParams struct {
+	Page  uint64 `json:"page" yaml:"page"`   // page number, starting from 1
+	Limit uint64 `json:"limit" yaml:"limit"` // number of logs in a page
+}
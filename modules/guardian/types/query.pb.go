@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -193,37 +194,430 @@ func (m *QueryTrusteesResponse) GetTrustees() []Guardian {
 	return nil
 }
 
+// QueryPendingActionRequest is request type for the Query/PendingAction RPC method
+type QueryPendingActionRequest struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryPendingActionRequest) Reset()         { *m = QueryPendingActionRequest{} }
+func (m *QueryPendingActionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingActionRequest) ProtoMessage()    {}
+func (*QueryPendingActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{4}
+}
+func (m *QueryPendingActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingActionRequest.Merge(m, src)
+}
+func (m *QueryPendingActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingActionRequest proto.InternalMessageInfo
+
+func (m *QueryPendingActionRequest) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+// QueryPendingActionResponse is response type for the Query/PendingAction RPC method
+type QueryPendingActionResponse struct {
+	Action PendingAction `protobuf:"bytes,1,opt,name=action,proto3" json:"action"`
+}
+
+func (m *QueryPendingActionResponse) Reset()         { *m = QueryPendingActionResponse{} }
+func (m *QueryPendingActionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingActionResponse) ProtoMessage()    {}
+func (*QueryPendingActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{5}
+}
+func (m *QueryPendingActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingActionResponse.Merge(m, src)
+}
+func (m *QueryPendingActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingActionResponse proto.InternalMessageInfo
+
+func (m *QueryPendingActionResponse) GetAction() PendingAction {
+	if m != nil {
+		return m.Action
+	}
+	return PendingAction{}
+}
+
+// QueryPendingActionsRequest is request type for the Query/PendingActions RPC method
+type QueryPendingActionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingActionsRequest) Reset()         { *m = QueryPendingActionsRequest{} }
+func (m *QueryPendingActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingActionsRequest) ProtoMessage()    {}
+func (*QueryPendingActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{6}
+}
+func (m *QueryPendingActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingActionsRequest.Merge(m, src)
+}
+func (m *QueryPendingActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingActionsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingActionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingActionsResponse is response type for the Query/PendingActions RPC method
+type QueryPendingActionsResponse struct {
+	Actions    []PendingAction     `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingActionsResponse) Reset()         { *m = QueryPendingActionsResponse{} }
+func (m *QueryPendingActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingActionsResponse) ProtoMessage()    {}
+func (*QueryPendingActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{7}
+}
+func (m *QueryPendingActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingActionsResponse.Merge(m, src)
+}
+func (m *QueryPendingActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingActionsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingActionsResponse) GetActions() []PendingAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *QueryPendingActionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuditLogsRequest is request type for the Query/AuditLogs RPC method
+type QueryAuditLogsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuditLogsRequest) Reset()         { *m = QueryAuditLogsRequest{} }
+func (m *QueryAuditLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogsRequest) ProtoMessage()    {}
+func (*QueryAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{8}
+}
+func (m *QueryAuditLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuditLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogsRequest.Merge(m, src)
+}
+func (m *QueryAuditLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditLogsRequest proto.InternalMessageInfo
+
+func (m *QueryAuditLogsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuditLogsResponse is response type for the Query/AuditLogs RPC method
+type QueryAuditLogsResponse struct {
+	Logs       []AuditLog          `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuditLogsResponse) Reset()         { *m = QueryAuditLogsResponse{} }
+func (m *QueryAuditLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogsResponse) ProtoMessage()    {}
+func (*QueryAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{9}
+}
+func (m *QueryAuditLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuditLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogsResponse.Merge(m, src)
+}
+func (m *QueryAuditLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditLogsResponse proto.InternalMessageInfo
+
+func (m *QueryAuditLogsResponse) GetLogs() []AuditLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *QueryAuditLogsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{10}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{11}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryProfilersRequest)(nil), "irishub.guardian.QueryProfilersRequest")
 	proto.RegisterType((*QueryProfilersResponse)(nil), "irishub.guardian.QueryProfilersResponse")
 	proto.RegisterType((*QueryTrusteesRequest)(nil), "irishub.guardian.QueryTrusteesRequest")
 	proto.RegisterType((*QueryTrusteesResponse)(nil), "irishub.guardian.QueryTrusteesResponse")
+	proto.RegisterType((*QueryPendingActionRequest)(nil), "irishub.guardian.QueryPendingActionRequest")
+	proto.RegisterType((*QueryPendingActionResponse)(nil), "irishub.guardian.QueryPendingActionResponse")
+	proto.RegisterType((*QueryPendingActionsRequest)(nil), "irishub.guardian.QueryPendingActionsRequest")
+	proto.RegisterType((*QueryPendingActionsResponse)(nil), "irishub.guardian.QueryPendingActionsResponse")
+	proto.RegisterType((*QueryAuditLogsRequest)(nil), "irishub.guardian.QueryAuditLogsRequest")
+	proto.RegisterType((*QueryAuditLogsResponse)(nil), "irishub.guardian.QueryAuditLogsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "irishub.guardian.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "irishub.guardian.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("guardian/query.proto", fileDescriptor_20cf24f8e5be2110) }
 
 var fileDescriptor_20cf24f8e5be2110 = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x49, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0xc8, 0x2c, 0xca, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x83, 0xc9, 0x4a, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x25, 0xf5, 0x41, 0x2c, 0x88, 0x3a, 0x29, 0x71, 0xb8, 0x6e, 0x18, 0x03, 0x2a,
-	0x21, 0x99, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x1c, 0x0f, 0xd1, 0x01, 0xe1, 0x40, 0xa4, 0x94, 0xc4,
-	0xb9, 0x44, 0x03, 0x41, 0x56, 0x05, 0x14, 0xe5, 0xa7, 0x65, 0xe6, 0xa4, 0x16, 0x15, 0x07, 0xa5,
-	0x16, 0x96, 0xa6, 0x16, 0x97, 0x28, 0x45, 0x70, 0x89, 0xa1, 0x4b, 0x14, 0x17, 0xe4, 0xe7, 0x15,
-	0xa7, 0x0a, 0xd9, 0x71, 0x71, 0x16, 0xc0, 0x04, 0x25, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0xa4,
-	0xf4, 0xd0, 0x9d, 0xa8, 0xe7, 0x0e, 0x65, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x84, 0xd0,
-	0xa2, 0x24, 0xc6, 0x25, 0x02, 0x36, 0x39, 0xa4, 0xa8, 0xb4, 0xb8, 0x24, 0x35, 0x15, 0x6e, 0x63,
-	0x28, 0xd4, 0x29, 0x08, 0x71, 0xa8, 0x85, 0x36, 0x5c, 0x1c, 0x25, 0x50, 0x31, 0xa2, 0xed, 0x83,
-	0xeb, 0x30, 0x3a, 0xc1, 0xc8, 0xc5, 0x0a, 0x36, 0x57, 0x28, 0x81, 0x8b, 0x13, 0xee, 0x1b, 0x21,
-	0x75, 0x4c, 0x23, 0xb0, 0x06, 0x84, 0x94, 0x06, 0x61, 0x85, 0x10, 0x77, 0x2a, 0x31, 0x08, 0xc5,
-	0x72, 0x71, 0xc0, 0x5c, 0x2f, 0xa4, 0x86, 0x43, 0x1f, 0x9a, 0xb7, 0xa5, 0xd4, 0x09, 0xaa, 0x83,
-	0x19, 0xef, 0xe4, 0x7d, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x86, 0xe9,
-	0x99, 0x25, 0x20, 0x23, 0x92, 0xf3, 0x73, 0xf5, 0x41, 0xc6, 0xe5, 0xa5, 0x96, 0xe8, 0x43, 0x8d,
-	0xd5, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x86, 0x27, 0x0a, 0xfd, 0x92, 0xca, 0x82, 0xd4,
-	0xe2, 0x24, 0x36, 0x70, 0x02, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x06, 0xe0, 0xf0,
-	0x74, 0x02, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x5f, 0x6b, 0x13, 0x4f,
+	0x14, 0xcd, 0xe6, 0x97, 0x5f, 0x6c, 0x6e, 0x51, 0x64, 0x4c, 0xd3, 0x64, 0xc5, 0x4d, 0x59, 0xd4,
+	0x06, 0xd4, 0x2c, 0xa6, 0x22, 0x28, 0xfe, 0xa1, 0x41, 0x10, 0x51, 0xa1, 0x06, 0xa5, 0xa2, 0x88,
+	0xdd, 0x66, 0xc7, 0x75, 0x20, 0xd9, 0xd9, 0xee, 0xec, 0x3e, 0xf4, 0x2b, 0xf8, 0xe4, 0xb3, 0x9f,
+	0xa8, 0x8f, 0x7d, 0xf4, 0xa9, 0x48, 0xf2, 0x45, 0x24, 0x3b, 0x77, 0xa6, 0xdd, 0xed, 0xc6, 0x04,
+	0xf4, 0x6d, 0xf6, 0xde, 0x73, 0xce, 0x3d, 0x77, 0xe6, 0x5e, 0x16, 0xea, 0x7e, 0xe2, 0x46, 0x1e,
+	0x73, 0x03, 0xe7, 0x20, 0xa1, 0xd1, 0x61, 0x37, 0x8c, 0x78, 0xcc, 0xc9, 0x65, 0x16, 0x31, 0xf1,
+	0x35, 0xd9, 0xef, 0xaa, 0xac, 0x59, 0xf7, 0xb9, 0xcf, 0xd3, 0xa4, 0x33, 0x3b, 0x49, 0x9c, 0xb9,
+	0xae, 0xd9, 0xea, 0x80, 0x89, 0xd6, 0x90, 0x8b, 0x31, 0x17, 0x9f, 0x25, 0x43, 0x7e, 0x60, 0xea,
+	0x9a, 0xfc, 0x92, 0xf5, 0x9c, 0xd0, 0xf5, 0x59, 0xe0, 0xc6, 0x8c, 0x23, 0xd3, 0x5e, 0x87, 0xb5,
+	0x37, 0xb3, 0xcc, 0x4e, 0xc4, 0xbf, 0xb0, 0x11, 0x8d, 0xc4, 0x80, 0x1e, 0x24, 0x54, 0xc4, 0xf6,
+	0x7b, 0x68, 0xe4, 0x13, 0x22, 0xe4, 0x81, 0xa0, 0xe4, 0x09, 0xd4, 0x42, 0x15, 0x6c, 0x1a, 0x1b,
+	0xff, 0x75, 0x56, 0x7b, 0x66, 0x37, 0xdf, 0x41, 0xf7, 0x39, 0x1e, 0xfa, 0x95, 0xa3, 0x93, 0x76,
+	0x69, 0x70, 0x4a, 0xb1, 0x1b, 0x50, 0x4f, 0x95, 0xdf, 0x46, 0x89, 0x88, 0x29, 0xd5, 0x15, 0xdf,
+	0xc1, 0x5a, 0x2e, 0x8e, 0x05, 0x1f, 0xc1, 0x4a, 0x8c, 0xb1, 0xa5, 0xeb, 0x69, 0x86, 0xbd, 0x05,
+	0x2d, 0xd9, 0x08, 0x0d, 0x3c, 0x16, 0xf8, 0xdb, 0xc3, 0x59, 0xf7, 0x58, 0x93, 0x34, 0xa0, 0xcc,
+	0xbc, 0xa6, 0xb1, 0x61, 0x74, 0x2a, 0xfd, 0xea, 0xe4, 0xa4, 0x5d, 0x7e, 0xf1, 0x6c, 0x50, 0x66,
+	0x9e, 0xfd, 0x11, 0xcc, 0x22, 0x12, 0x1a, 0x7a, 0x0c, 0x55, 0x37, 0x8d, 0xa4, 0xcc, 0xd5, 0x5e,
+	0xfb, 0xbc, 0x9d, 0x0c, 0x11, 0x3d, 0x21, 0xc9, 0xde, 0x2d, 0x12, 0x57, 0xd7, 0x40, 0x1e, 0x00,
+	0x9c, 0xbe, 0x12, 0x16, 0x68, 0x75, 0xf1, 0x4d, 0xe5, 0xd4, 0xec, 0xb8, 0x3e, 0x45, 0xf8, 0xe0,
+	0x0c, 0xd8, 0xfe, 0x61, 0xc0, 0xd5, 0x42, 0x65, 0xf4, 0xfd, 0x14, 0x2e, 0x48, 0x0b, 0xea, 0x1e,
+	0x97, 0x34, 0xae, 0x58, 0xe4, 0x61, 0xc6, 0x5b, 0x39, 0xf5, 0x66, 0x16, 0x79, 0x93, 0x05, 0x33,
+	0xe6, 0x06, 0xf8, 0xbc, 0xdb, 0x89, 0xc7, 0xe2, 0x57, 0xdc, 0xff, 0x17, 0x0d, 0x7f, 0x33, 0xa0,
+	0x91, 0x17, 0xc5, 0x5e, 0xef, 0x41, 0x65, 0xc4, 0xfd, 0x3f, 0x0c, 0x8c, 0xa2, 0x60, 0x8f, 0x29,
+	0xfa, 0xaf, 0x1a, 0xac, 0x03, 0x91, 0x97, 0xef, 0x46, 0xee, 0x58, 0x4f, 0xf5, 0x6b, 0xb8, 0x92,
+	0x89, 0xa2, 0xbd, 0xfb, 0x50, 0x0d, 0xd3, 0x08, 0x36, 0xdc, 0x2c, 0x78, 0x89, 0x34, 0xaf, 0x66,
+	0x47, 0xa2, 0x7b, 0x93, 0x0a, 0xfc, 0x9f, 0xea, 0x91, 0x3d, 0xa8, 0xe9, 0xdd, 0x24, 0x9b, 0xe7,
+	0xe9, 0x85, 0x6b, 0x6d, 0x76, 0x16, 0x03, 0xa5, 0x43, 0xbb, 0x44, 0x3e, 0xc1, 0x8a, 0xda, 0x45,
+	0x72, 0x73, 0x0e, 0x2f, 0xb7, 0xc4, 0xe6, 0xe6, 0x42, 0x9c, 0x96, 0x1f, 0xc1, 0xc5, 0xcc, 0xb0,
+	0x91, 0x5b, 0xf3, 0xbc, 0x15, 0x6c, 0xae, 0x79, 0x7b, 0x39, 0xb0, 0xae, 0xc6, 0xe1, 0x52, 0x26,
+	0x25, 0xc8, 0x52, 0x0a, 0xba, 0xb1, 0x3b, 0x4b, 0xa2, 0x75, 0xc1, 0x3d, 0xa8, 0xe9, 0xa9, 0x9c,
+	0xfb, 0x3e, 0xf9, 0x65, 0x30, 0x3b, 0x8b, 0x81, 0xba, 0xc2, 0x2e, 0x54, 0xe5, 0x8c, 0x90, 0xeb,
+	0xf3, 0xcc, 0x9d, 0x1d, 0x45, 0xf3, 0xc6, 0x02, 0x94, 0x12, 0xee, 0xbf, 0x3c, 0x9a, 0x58, 0xc6,
+	0xf1, 0xc4, 0x32, 0x7e, 0x4d, 0x2c, 0xe3, 0xfb, 0xd4, 0x2a, 0x1d, 0x4f, 0xad, 0xd2, 0xcf, 0xa9,
+	0x55, 0xfa, 0x70, 0xd7, 0x67, 0xf1, 0x4c, 0x60, 0xc8, 0xc7, 0xce, 0x4c, 0x2c, 0xa0, 0xb1, 0x83,
+	0xa2, 0xce, 0x98, 0x7b, 0xc9, 0x88, 0x0a, 0xfd, 0x6f, 0x72, 0xe2, 0xc3, 0x90, 0x8a, 0xfd, 0x6a,
+	0xfa, 0xa3, 0xd9, 0xfa, 0x3d, 0x00, 0x4a, 0x58, 0x6b, 0xe5, 0xfb, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -242,6 +636,14 @@ type QueryClient interface {
 	Profilers(ctx context.Context, in *QueryProfilersRequest, opts ...grpc.CallOption) (*QueryProfilersResponse, error)
 	// Trustees returns all trustees
 	Trustees(ctx context.Context, in *QueryTrusteesRequest, opts ...grpc.CallOption) (*QueryTrusteesResponse, error)
+	// PendingAction returns the pending guardian action by the id
+	PendingAction(ctx context.Context, in *QueryPendingActionRequest, opts ...grpc.CallOption) (*QueryPendingActionResponse, error)
+	// PendingActions returns the pending guardian actions
+	PendingActions(ctx context.Context, in *QueryPendingActionsRequest, opts ...grpc.CallOption) (*QueryPendingActionsResponse, error)
+	// AuditLogs returns the audit trail of the guardian add and delete operations
+	AuditLogs(ctx context.Context, in *QueryAuditLogsRequest, opts ...grpc.CallOption) (*QueryAuditLogsResponse, error)
+	// Params queries the guardian parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -270,12 +672,56 @@ func (c *queryClient) Trustees(ctx context.Context, in *QueryTrusteesRequest, op
 	return out, nil
 }
 
+func (c *queryClient) PendingAction(ctx context.Context, in *QueryPendingActionRequest, opts ...grpc.CallOption) (*QueryPendingActionResponse, error) {
+	out := new(QueryPendingActionResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/PendingAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingActions(ctx context.Context, in *QueryPendingActionsRequest, opts ...grpc.CallOption) (*QueryPendingActionsResponse, error) {
+	out := new(QueryPendingActionsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/PendingActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditLogs(ctx context.Context, in *QueryAuditLogsRequest, opts ...grpc.CallOption) (*QueryAuditLogsResponse, error) {
+	out := new(QueryAuditLogsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/AuditLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Profilers returns all profilers
 	Profilers(context.Context, *QueryProfilersRequest) (*QueryProfilersResponse, error)
 	// Trustees returns all trustees
 	Trustees(context.Context, *QueryTrusteesRequest) (*QueryTrusteesResponse, error)
+	// PendingAction returns the pending guardian action by the id
+	PendingAction(context.Context, *QueryPendingActionRequest) (*QueryPendingActionResponse, error)
+	// PendingActions returns the pending guardian actions
+	PendingActions(context.Context, *QueryPendingActionsRequest) (*QueryPendingActionsResponse, error)
+	// AuditLogs returns the audit trail of the guardian add and delete operations
+	AuditLogs(context.Context, *QueryAuditLogsRequest) (*QueryAuditLogsResponse, error)
+	// Params queries the guardian parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -288,6 +734,18 @@ func (*UnimplementedQueryServer) Profilers(ctx context.Context, req *QueryProfil
 func (*UnimplementedQueryServer) Trustees(ctx context.Context, req *QueryTrusteesRequest) (*QueryTrusteesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Trustees not implemented")
 }
+func (*UnimplementedQueryServer) PendingAction(ctx context.Context, req *QueryPendingActionRequest) (*QueryPendingActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingAction not implemented")
+}
+func (*UnimplementedQueryServer) PendingActions(ctx context.Context, req *QueryPendingActionsRequest) (*QueryPendingActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingActions not implemented")
+}
+func (*UnimplementedQueryServer) AuditLogs(ctx context.Context, req *QueryAuditLogsRequest) (*QueryAuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLogs not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -329,24 +787,112 @@ func _Query_Trustees_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "irishub.guardian.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Profilers",
-			Handler:    _Query_Profilers_Handler,
-		},
-		{
-			MethodName: "Trustees",
-			Handler:    _Query_Trustees_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "guardian/query.proto",
-}
-
-func (m *QueryProfilersRequest) Marshal() (dAtA []byte, err error) {
+func _Query_PendingAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/PendingAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAction(ctx, req.(*QueryPendingActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/PendingActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingActions(ctx, req.(*QueryPendingActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/AuditLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuditLogs(ctx, req.(*QueryAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irishub.guardian.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Profilers",
+			Handler:    _Query_Profilers_Handler,
+		},
+		{
+			MethodName: "Trustees",
+			Handler:    _Query_Trustees_Handler,
+		},
+		{
+			MethodName: "PendingAction",
+			Handler:    _Query_PendingAction_Handler,
+		},
+		{
+			MethodName: "PendingActions",
+			Handler:    _Query_PendingActions_Handler,
+		},
+		{
+			MethodName: "AuditLogs",
+			Handler:    _Query_AuditLogs_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "guardian/query.proto",
+}
+
+func (m *QueryProfilersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -459,79 +1005,1121 @@ func (m *QueryTrusteesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i -= size
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProfilersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProfilersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Profilers) > 0 {
+		for _, e := range m.Profilers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTrusteesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTrusteesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trustees) > 0 {
+		for _, e := range m.Trustees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovQuery(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *QueryPendingActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Action.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuditLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuditLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryProfilersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfilersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfilersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProfilersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfilersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfilersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profilers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profilers = append(m.Profilers, Guardian{})
+			if err := m.Profilers[len(m.Profilers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrusteesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrusteesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrusteesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrusteesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrusteesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrusteesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trustees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trustees = append(m.Trustees, Guardian{})
+			if err := m.Trustees[len(m.Trustees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryProfilersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryProfilersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Profilers) > 0 {
-		for _, e := range m.Profilers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPendingActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *QueryTrusteesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryTrusteesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Trustees) > 0 {
-		for _, e := range m.Trustees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, PendingAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryProfilersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuditLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -554,12 +2142,48 @@ func (m *QueryProfilersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProfilersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuditLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProfilersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuditLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -584,7 +2208,7 @@ func (m *QueryProfilersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProfilersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuditLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -607,15 +2231,15 @@ func (m *QueryProfilersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProfilersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuditLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProfilersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuditLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profilers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -642,8 +2266,44 @@ func (m *QueryProfilersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profilers = append(m.Profilers, Guardian{})
-			if err := m.Profilers[len(m.Profilers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Logs = append(m.Logs, AuditLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -671,7 +2331,7 @@ func (m *QueryProfilersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTrusteesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -694,10 +2354,10 @@ func (m *QueryTrusteesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrusteesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrusteesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -724,7 +2384,7 @@ func (m *QueryTrusteesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTrusteesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -747,15 +2407,15 @@ func (m *QueryTrusteesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTrusteesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTrusteesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trustees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -782,8 +2442,7 @@ func (m *QueryTrusteesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trustees = append(m.Trustees, Guardian{})
-			if err := m.Trustees[len(m.Trustees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
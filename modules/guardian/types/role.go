@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Roles which can be assigned to the guardians, each role is the name of the permission it grants
+const (
+	RoleFeedAdmin = "feed-admin" // create and manage the oracle feeds
+)
+
+// validRoles is the set of the roles which can be assigned to the guardians, a role is only
+// added along with the permission check of the module it is granted by
+var validRoles = map[string]bool{
+	RoleFeedAdmin: true,
+}
+
+// ValidRole returns true if the role is valid and false otherwise
+func ValidRole(role string) bool {
+	return validRoles[role]
+}
+
+// ValidateRoles checks that the roles are valid and not duplicated
+func ValidateRoles(roles []string) error {
+	seen := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		if !ValidRole(role) {
+			return sdkerrors.Wrapf(ErrInvalidRole, "%s", role)
+		}
+		if seen[role] {
+			return sdkerrors.Wrapf(ErrInvalidRole, "duplicate role %s", role)
+		}
+		seen[role] = true
+	}
+	return nil
+}
+
+// HasRole returns true if the guardian is assigned the role, the genesis guardians hold all the roles
+func (g Guardian) HasRole(role string) bool {
+	if g.AccountType == Genesis {
+		return true
+	}
+	for _, r := range g.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -3,10 +3,12 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type GuardianI interface {
@@ -14,6 +16,7 @@ type GuardianI interface {
 	GetAccountType() AccountType
 	GetAddress() sdk.AccAddress
 	GetAddedBy() sdk.AccAddress
+	GetRoles() []string
 }
 
 // Profilers is a collection of Guardian
@@ -30,7 +33,8 @@ func (ps Profilers) String() (out string) {
   Type:          %s
   Description:   %s
   AddedBy:       %s
-`, val.Address, val.AccountType, val.Description, val.AddedBy)
+  Roles:         %s
+`, val.Address, val.AccountType, val.Description, val.AddedBy, strings.Join(val.Roles, ","))
 	}
 	return strings.TrimSpace(out)
 }
@@ -49,18 +53,20 @@ func (ts Trustees) String() (out string) {
   Type:          %s
   Description:   %s
   AddedBy:       %s
-`, val.Address, val.AccountType, val.Description, val.AddedBy)
+  Roles:         %s
+`, val.Address, val.AccountType, val.Description, val.AddedBy, strings.Join(val.Roles, ","))
 	}
 	return strings.TrimSpace(out)
 }
 
-// NewGuardian constructs a Guardian
-func NewGuardian(description string, accountType AccountType, address, addedBy sdk.AccAddress) Guardian {
+// NewGuardian constructs a Guardian with the assigned roles
+func NewGuardian(description string, accountType AccountType, address, addedBy sdk.AccAddress, roles ...string) Guardian {
 	return Guardian{
 		Description: description,
 		AccountType: accountType,
 		Address:     address,
 		AddedBy:     addedBy,
+		Roles:       roles,
 	}
 }
 
@@ -69,7 +75,46 @@ func (g Guardian) Equal(guardian Guardian) bool {
 	return g.Address.Equals(guardian.Address) &&
 		g.AddedBy.Equals(guardian.AddedBy) &&
 		g.Description == guardian.Description &&
-		g.AccountType == guardian.AccountType
+		g.AccountType == guardian.AccountType &&
+		strings.Join(g.Roles, ",") == strings.Join(guardian.Roles, ",") &&
+		g.ExpiryHeight == guardian.ExpiryHeight &&
+		equalTime(g.ExpiryTime, guardian.ExpiryTime)
+}
+
+// WithExpiry returns the guardian expiring at the height if not zero or the time if not nil
+func (g Guardian) WithExpiry(height int64, t *time.Time) Guardian {
+	g.ExpiryHeight = height
+	g.ExpiryTime = t
+	return g
+}
+
+// IsExpired returns true if the guardian has expired at the given block height and time
+func (g Guardian) IsExpired(height int64, t time.Time) bool {
+	if g.ExpiryHeight > 0 && height >= g.ExpiryHeight {
+		return true
+	}
+	if g.ExpiryTime != nil && !t.Before(*g.ExpiryTime) {
+		return true
+	}
+	return false
+}
+
+// ValidateExpiry validates the expiry height and time of a guardian
+func ValidateExpiry(height int64, t *time.Time) error {
+	if height < 0 {
+		return sdkerrors.Wrapf(ErrInvalidExpiry, "expiry height must not be negative: %d", height)
+	}
+	if t != nil && t.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExpiry, "expiry time must not be zero")
+	}
+	return nil
+}
+
+func equalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
 }
 
 // AccountTypeFromString converts string to AccountType byte, Returns ff if invalid.
@@ -2,23 +2,40 @@ package cli
 
 import (
 	flag "github.com/spf13/pflag"
+
+	"github.com/irisnet/irishub/modules/oracle/types"
 )
 
 const (
-	FlagFeedName      = "feed-name"
-	FlagAggregateFunc = "aggregate-func"
-	FlagValueJsonPath = "value-json-path"
-	FlagLatestHistory = "latest-history"
-	FlagDescription   = "description"
-	FlagServiceName   = "service-name"
-	FlagProviders     = "providers"
-	FlagInput         = "input"
-	FlagTimeout       = "timeout"
-	FlagServiceFeeCap = "service-fee-cap"
-	FlagFrequency     = "frequency"
-	FlagThreshold     = "threshold"
-	FlagCreator       = "creator"
-	FlagFeedState     = "state"
+	FlagFeedName       = "feed-name"
+	FlagAggregateFunc  = "aggregate-func"
+	FlagTrimPercentage = "trim-percentage"
+	FlagPrecision      = "precision"
+	FlagDeviation      = "deviation"
+	FlagHeartbeat      = "heartbeat"
+	FlagNoDeviation    = "disable-deviation"
+	FlagNoHeartbeat    = "disable-heartbeat"
+	FlagValueJsonPath  = "value-json-path"
+	FlagValueFields    = "value-fields"
+	FlagLatestHistory  = "latest-history"
+	FlagDescription    = "description"
+	FlagServiceName    = "service-name"
+	FlagProviders      = "providers"
+	FlagInput          = "input"
+	FlagTimeout        = "timeout"
+	FlagServiceFeeCap  = "service-fee-cap"
+	FlagFrequency      = "frequency"
+	FlagThreshold      = "threshold"
+	FlagCreator        = "creator"
+	FlagFeedState      = "state"
+	FlagFromTime       = "from-time"
+	FlagToTime         = "to-time"
+	FlagFromBatch      = "from-batch"
+	FlagToBatch        = "to-batch"
+	FlagPageKey        = "page-key"
+	FlagOffset         = "offset"
+	FlagLimit          = "limit"
+	FlagCountTotal     = "count-total"
 )
 
 var (
@@ -33,8 +50,13 @@ var (
 
 func init() {
 	FsCreateFeed.String(FlagFeedName, "", "The unique identifier of the feed")
-	FsCreateFeed.String(FlagAggregateFunc, "", "The name of predefined function for processing the service responses, e.g.avg、max、min etc")
+	FsCreateFeed.String(FlagAggregateFunc, "", "The name of predefined function for processing the service responses, e.g.avg、max、min、median、trimmed_mean、weighted_median etc")
+	FsCreateFeed.Uint32(FlagTrimPercentage, 0, "The percentage of values trimmed from each end when aggregate-func is trimmed_mean, range [1, 49]")
+	FsCreateFeed.Uint32(FlagPrecision, types.DefaultPrecision, "The number of decimal places of the feed value, range [0, 18]")
+	FsCreateFeed.Uint32(FlagDeviation, 0, "The minimum change from the latest value, in basis points, for a new value to be saved, range [0, 10000]")
+	FsCreateFeed.Uint64(FlagHeartbeat, 0, "The maximum number of seconds between two saved values, regardless of the deviation, range [0, 31536000]")
 	FsCreateFeed.String(FlagValueJsonPath, "", "The field name or path of Service response result used to retrieve the value property of aggregate-func from response results")
+	FsCreateFeed.StringSlice(FlagValueFields, []string{}, "The named values retrieved from the response results instead of value-json-path and aggregate-func, each in the format <name>:<value-json-path>:<aggregate-func>")
 	FsCreateFeed.Uint64(FlagLatestHistory, 0, "The maximum Number of the latest history values to be saved for the Feed, range [1, 100]")
 	FsCreateFeed.String(FlagDescription, "", "The description of the feed.")
 	FsCreateFeed.String(FlagServiceName, "", "The name of the service to be invoked by the feed")
@@ -59,7 +81,20 @@ func init() {
 	FsEditFeed.String(FlagServiceFeeCap, "", "Only providers charging a fee lower than the cap will be invoked")
 	FsEditFeed.Uint64(FlagFrequency, 0, "The invocation frequency of sending repeated requests")
 	FsEditFeed.Uint16(FlagThreshold, 0, "The minimum number of responses needed for aggregation, range [1, Length(providers)]")
+	FsEditFeed.Uint32(FlagDeviation, 0, "The minimum change from the latest value, in basis points, for a new value to be saved, range [0, 10000], unchanged if 0")
+	FsEditFeed.Uint64(FlagHeartbeat, 0, "The maximum number of seconds between two saved values, regardless of the deviation, range [0, 31536000], unchanged if 0")
+	FsEditFeed.Bool(FlagNoDeviation, false, "Switch off the deviation threshold, can not be used together with deviation")
+	FsEditFeed.Bool(FlagNoHeartbeat, false, "Switch off the heartbeat, can not be used together with heartbeat")
 	FsEditFeed.String(FlagCreator, "", "Address of the feed creator")
 
 	FsQueryFeeds.String(FlagFeedState, "", "The state of the feed,paused|running")
+
+	FsQueryFeedValue.String(FlagFromTime, "", "Only query the values saved at or after the time, RFC3339 format")
+	FsQueryFeedValue.String(FlagToTime, "", "Only query the values saved at or before the time, RFC3339 format")
+	FsQueryFeedValue.Uint64(FlagFromBatch, 0, "Only query the values of the batches starting from the batch counter")
+	FsQueryFeedValue.Uint64(FlagToBatch, 0, "Only query the values of the batches up to the batch counter")
+	FsQueryFeedValue.String(FlagPageKey, "", "The base64 encoded key of the page to query, returned as next_key by the previous query")
+	FsQueryFeedValue.Uint64(FlagOffset, 0, "The number of values to skip, can not be used together with page-key")
+	FsQueryFeedValue.Uint64(FlagLimit, 0, "The maximum number of values to return, default to 100")
+	FsQueryFeedValue.Bool(FlagCountTotal, false, "Count the total number of values matching the filters")
 }
@@ -2,13 +2,16 @@ package cli
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/irisnet/irishub/modules/oracle/types"
@@ -27,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeed(),
 		GetCmdQueryFeeds(),
 		GetCmdQueryFeedValue(),
+		GetCmdQueryProviderStats(),
 	)
 	return txCmd
 }
@@ -93,7 +97,7 @@ func GetCmdQueryFeedValue() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "value [feed-name]",
 		Short:   "Query the feed result",
-		Example: fmt.Sprintf("%s q oracle query-value <feed-name>", version.AppName),
+		Example: fmt.Sprintf("%s q oracle query-value <feed-name> [--from-time=<from-time>] [--to-time=<to-time>] [--from-batch=<from-batch>] [--to-batch=<to-batch>] [--limit=<limit>]", version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -104,7 +108,12 @@ func GetCmdQueryFeedValue() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.FeedValue(context.Background(), &types.QueryFeedValueRequest{FeedName: args[0]})
+			req, err := buildQueryFeedValueRequest(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeedValue(context.Background(), req)
 			if err != nil {
 				return err
 			}
@@ -116,3 +125,67 @@ func GetCmdQueryFeedValue() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryProviderStats implements the query provider statistics command
+func GetCmdQueryProviderStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "provider-stats [feed-name]",
+		Short:   "Query the response statistics of the feed providers",
+		Example: fmt.Sprintf("%s q oracle provider-stats <feed-name>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProviderStats(context.Background(), &types.QueryProviderStatsRequest{FeedName: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func buildQueryFeedValueRequest(feedName string) (*types.QueryFeedValueRequest, error) {
+	req := &types.QueryFeedValueRequest{
+		FeedName:  feedName,
+		FromBatch: viper.GetUint64(FlagFromBatch),
+		ToBatch:   viper.GetUint64(FlagToBatch),
+		Pagination: &query.PageRequest{
+			Offset:     viper.GetUint64(FlagOffset),
+			Limit:      viper.GetUint64(FlagLimit),
+			CountTotal: viper.GetBool(FlagCountTotal),
+		},
+	}
+
+	if str := viper.GetString(FlagFromTime); len(str) > 0 {
+		fromTime, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from time: %s", err)
+		}
+		req.FromTime = &fromTime
+	}
+	if str := viper.GetString(FlagToTime); len(str) > 0 {
+		toTime, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to time: %s", err)
+		}
+		req.ToTime = &toTime
+	}
+	if str := viper.GetString(FlagPageKey); len(str) > 0 {
+		key, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page key: %s", err)
+		}
+		req.Pagination.Key = key
+	}
+	return req, nil
+}
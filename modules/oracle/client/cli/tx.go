@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -29,6 +30,9 @@ func GetTxCmd() *cobra.Command {
 		GetCmdStartFeed(),
 		GetCmdPauseFeed(),
 		GetCmdEditFeed(),
+		GetCmdDeleteFeed(),
+		GetCmdTransferFeedOwner(),
+		GetCmdAcceptFeedOwner(),
 	)
 	return txCmd
 }
@@ -50,7 +54,8 @@ func GetCmdCreateFeed() *cobra.Command {
 				`--total=10 `+
 				`--threshold=1 `+
 				`--aggregate-func="avg" `+
-				`--value-json-path="high"`,
+				`--value-json-path="high" `+
+				`[--value-fields="bid:data.bid:median,ask:data.ask:median"]`,
 			version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -76,20 +81,30 @@ func GetCmdCreateFeed() *cobra.Command {
 				return err
 			}
 
+			valueFields, err := parseValueFields(viper.GetStringSlice(FlagValueFields))
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgCreateFeed{
-				FeedName:          viper.GetString(FlagFeedName),
-				AggregateFunc:     viper.GetString(FlagAggregateFunc),
-				ValueJsonPath:     viper.GetString(FlagValueJsonPath),
-				LatestHistory:     uint64(viper.GetInt64(FlagLatestHistory)),
-				Description:       viper.GetString(FlagDescription),
-				ServiceName:       viper.GetString(FlagServiceName),
-				Providers:         providers,
-				Input:             viper.GetString(FlagInput),
-				Timeout:           viper.GetInt64(FlagTimeout),
-				ServiceFeeCap:     serviceFeeCap,
-				RepeatedFrequency: uint64(viper.GetInt64(FlagFrequency)),
-				ResponseThreshold: uint32(viper.GetInt32(FlagThreshold)),
-				Creator:           creator,
+				FeedName:           viper.GetString(FlagFeedName),
+				AggregateFunc:      viper.GetString(FlagAggregateFunc),
+				TrimPercentage:     viper.GetUint32(FlagTrimPercentage),
+				Precision:          viper.GetUint32(FlagPrecision),
+				DeviationThreshold: viper.GetUint32(FlagDeviation),
+				Heartbeat:          viper.GetUint64(FlagHeartbeat),
+				ValueJsonPath:      viper.GetString(FlagValueJsonPath),
+				ValueFields:        valueFields,
+				LatestHistory:      uint64(viper.GetInt64(FlagLatestHistory)),
+				Description:        viper.GetString(FlagDescription),
+				ServiceName:        viper.GetString(FlagServiceName),
+				Providers:          providers,
+				Input:              viper.GetString(FlagInput),
+				Timeout:            viper.GetInt64(FlagTimeout),
+				ServiceFeeCap:      serviceFeeCap,
+				RepeatedFrequency:  uint64(viper.GetInt64(FlagFrequency)),
+				ResponseThreshold:  uint32(viper.GetInt32(FlagThreshold)),
+				Creator:            creator,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -100,8 +115,6 @@ func GetCmdCreateFeed() *cobra.Command {
 	}
 	cmd.Flags().AddFlagSet(FsCreateFeed)
 	_ = cmd.MarkFlagRequired(FlagFeedName)
-	_ = cmd.MarkFlagRequired(FlagAggregateFunc)
-	_ = cmd.MarkFlagRequired(FlagValueJsonPath)
 	_ = cmd.MarkFlagRequired(FlagLatestHistory)
 	_ = cmd.MarkFlagRequired(FlagServiceName)
 	_ = cmd.MarkFlagRequired(FlagProviders)
@@ -213,15 +226,19 @@ func GetCmdEditFeed() *cobra.Command {
 			}
 
 			msg := &types.MsgEditFeed{
-				FeedName:          args[0],
-				Description:       viper.GetString(FlagDescription),
-				LatestHistory:     uint64(viper.GetInt64(FlagLatestHistory)),
-				Providers:         providers,
-				Timeout:           viper.GetInt64(FlagTimeout),
-				ServiceFeeCap:     serviceFeeCap,
-				RepeatedFrequency: uint64(viper.GetInt64(FlagFrequency)),
-				ResponseThreshold: uint32(viper.GetInt(FlagThreshold)),
-				Creator:           creator,
+				FeedName:                  args[0],
+				Description:               viper.GetString(FlagDescription),
+				LatestHistory:             uint64(viper.GetInt64(FlagLatestHistory)),
+				Providers:                 providers,
+				Timeout:                   viper.GetInt64(FlagTimeout),
+				ServiceFeeCap:             serviceFeeCap,
+				RepeatedFrequency:         uint64(viper.GetInt64(FlagFrequency)),
+				ResponseThreshold:         uint32(viper.GetInt(FlagThreshold)),
+				DeviationThreshold:        viper.GetUint32(FlagDeviation),
+				Heartbeat:                 viper.GetUint64(FlagHeartbeat),
+				DisableDeviationThreshold: viper.GetBool(FlagNoDeviation),
+				DisableHeartbeat:          viper.GetBool(FlagNoHeartbeat),
+				Creator:                   creator,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -234,3 +251,114 @@ func GetCmdEditFeed() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdDeleteFeed implements delete a feed command
+func GetCmdDeleteFeed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete [feed-name]",
+		Short:   "Delete a feed by the feed creator or a feed admin, the service request context of the feed will be killed",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%s tx oracle delete <feed-name> --chain-id=<chain-id> --from=<key-name> --fee=0.3iris`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDeleteFeed{
+				FeedName: args[0],
+				Sender:   clientCtx.GetFromAddress(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdTransferFeedOwner implements transfer the ownership of a feed command
+func GetCmdTransferFeedOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer [feed-name] [new-owner]",
+		Short:   "Transfer the ownership of a feed to a feed admin by the feed creator or a feed admin, the transfer takes effect once the new owner accepts it",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%s tx oracle transfer <feed-name> <new-owner> --chain-id=<chain-id> --from=<key-name> --fee=0.3iris`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferFeedOwner{
+				FeedName: args[0],
+				Sender:   clientCtx.GetFromAddress(),
+				NewOwner: newOwner,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAcceptFeedOwner implements accept the ownership of a feed command
+func GetCmdAcceptFeedOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-transfer [feed-name]",
+		Short:   "Accept the ownership of a feed transferred to the new owner, the new owner will pay the service fees",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%s tx oracle accept-transfer <feed-name> --chain-id=<chain-id> --from=<key-name> --fee=0.3iris`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAcceptFeedOwner{
+				FeedName: args[0],
+				NewOwner: clientCtx.GetFromAddress(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseValueFields parses the value fields in the format <name>:<value-json-path>:<aggregate-func>
+func parseValueFields(strs []string) ([]types.ValueField, error) {
+	var valueFields []types.ValueField
+	for _, str := range strs {
+		first := strings.Index(str, ":")
+		last := strings.LastIndex(str, ":")
+		if first <= 0 || first == last {
+			return nil, fmt.Errorf("invalid value field %s, expected <name>:<value-json-path>:<aggregate-func>", str)
+		}
+		valueFields = append(valueFields, types.ValueField{
+			Name:          strings.TrimSpace(str[:first]),
+			ValueJsonPath: strings.TrimSpace(str[first+1 : last]),
+			AggregateFunc: strings.TrimSpace(str[last+1:]),
+		})
+	}
+	return valueFields, nil
+}
@@ -3,4 +3,10 @@ package rest
 const (
 	FeedName  = "feed-name"
 	FeedState = "state"
+	FromTime  = "from_time"
+	ToTime    = "to_time"
+	FromBatch = "from_batch"
+	ToBatch   = "to_batch"
+	Page      = "page"
+	Limit     = "limit"
 )
@@ -3,6 +3,8 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -31,6 +33,12 @@ func registerQueryRoutes(cliCtx client.Context, r *mux.Router) {
 		fmt.Sprintf("/oracle/feeds/{%s}/values", FeedName),
 		queryFeedValuesHandlerFn(cliCtx),
 	).Methods("GET")
+
+	// query the provider statistics by feed name
+	r.HandleFunc(
+		fmt.Sprintf("/oracle/feeds/{%s}/provider-stats", FeedName),
+		queryProviderStatsHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 func queryFeedHandlerFn(cliCtx client.Context) http.HandlerFunc {
@@ -96,9 +104,12 @@ func queryFeedsHandlerFn(cliCtx client.Context) http.HandlerFunc {
 func queryFeedValuesHandlerFn(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
-		params := types.QueryFeedValueParams{
-			FeedName: vars[FeedName],
+		params, err := parseFeedValueParams(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
 		}
+		params.FeedName = vars[FeedName]
 
 		bz, err := cliCtx.JSONMarshaler.MarshalJSON(params)
 		if err != nil {
@@ -122,3 +133,78 @@ func queryFeedValuesHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func queryProviderStatsHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		params := types.QueryProviderStatsParams{
+			FeedName: vars[FeedName],
+		}
+
+		bz, err := cliCtx.JSONMarshaler.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProviderStats)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func parseFeedValueParams(r *http.Request) (params types.QueryFeedValueParams, err error) {
+	parseTime := func(key string) (*time.Time, error) {
+		str := oracleClient.GetUrlParam(r.URL, key)
+		if len(str) == 0 {
+			return nil, nil
+		}
+		t, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", key, err)
+		}
+		return &t, nil
+	}
+	parseUint := func(key string) (uint64, error) {
+		str := oracleClient.GetUrlParam(r.URL, key)
+		if len(str) == 0 {
+			return 0, nil
+		}
+		n, err := strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s: %s", key, err)
+		}
+		return n, nil
+	}
+
+	if params.FromTime, err = parseTime(FromTime); err != nil {
+		return params, err
+	}
+	if params.ToTime, err = parseTime(ToTime); err != nil {
+		return params, err
+	}
+	if params.FromBatch, err = parseUint(FromBatch); err != nil {
+		return params, err
+	}
+	if params.ToBatch, err = parseUint(ToBatch); err != nil {
+		return params, err
+	}
+	if params.Page, err = parseUint(Page); err != nil {
+		return params, err
+	}
+	if params.Limit, err = parseUint(Limit); err != nil {
+		return params, err
+	}
+	return params, nil
+}
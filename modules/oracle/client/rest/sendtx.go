@@ -23,36 +23,51 @@ func registerTxRoutes(cliCtx client.Context, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/oracle/feeds/{%s}/start", FeedName), startFeedHandlerFn(cliCtx)).Methods("POST")
 	// pause a feed
 	r.HandleFunc(fmt.Sprintf("/oracle/feeds/{%s}/pause", FeedName), pauseFeedHandlerFn(cliCtx)).Methods("POST")
+	// delete a feed
+	r.HandleFunc(fmt.Sprintf("/oracle/feeds/{%s}/delete", FeedName), deleteFeedHandlerFn(cliCtx)).Methods("POST")
+	// transfer the ownership of a feed
+	r.HandleFunc(fmt.Sprintf("/oracle/feeds/{%s}/transfer", FeedName), transferFeedOwnerHandlerFn(cliCtx)).Methods("POST")
+	// accept the ownership of a feed
+	r.HandleFunc(fmt.Sprintf("/oracle/feeds/{%s}/accept-transfer", FeedName), acceptFeedOwnerHandlerFn(cliCtx)).Methods("POST")
 }
 
 type createFeedReq struct {
-	BaseReq           rest.BaseReq `json:"base_req" yaml:"base_req"`
-	FeedName          string       `json:"feed_name"`
-	AggregateFunc     string       `json:"aggregate_func"`
-	ValueJsonPath     string       `json:"value_json_path"`
-	LatestHistory     uint64       `json:"latest_history"`
-	Description       string       `json:"description"`
-	Creator           string       `json:"creator"`
-	ServiceName       string       `json:"service_name"`
-	Providers         []string     `json:"providers"`
-	Input             string       `json:"input"`
-	Timeout           int64        `json:"timeout"`
-	ServiceFeeCap     string       `json:"service_fee_cap"`
-	RepeatedFrequency uint64       `json:"repeated_frequency"`
-	ResponseThreshold uint32       `json:"response_threshold"`
+	BaseReq            rest.BaseReq       `json:"base_req" yaml:"base_req"`
+	FeedName           string             `json:"feed_name"`
+	AggregateFunc      string             `json:"aggregate_func"`
+	TrimPercentage     uint32             `json:"trim_percentage"`
+	Precision          *uint32            `json:"precision"` // default to types.DefaultPrecision if omitted
+	DeviationThreshold uint32             `json:"deviation_threshold"`
+	Heartbeat          uint64             `json:"heartbeat"`
+	ValueJsonPath      string             `json:"value_json_path"`
+	ValueFields        []types.ValueField `json:"value_fields"`
+	LatestHistory      uint64             `json:"latest_history"`
+	Description        string             `json:"description"`
+	Creator            string             `json:"creator"`
+	ServiceName        string             `json:"service_name"`
+	Providers          []string           `json:"providers"`
+	Input              string             `json:"input"`
+	Timeout            int64              `json:"timeout"`
+	ServiceFeeCap      string             `json:"service_fee_cap"`
+	RepeatedFrequency  uint64             `json:"repeated_frequency"`
+	ResponseThreshold  uint32             `json:"response_threshold"`
 }
 
 type editFeedReq struct {
-	BaseReq           rest.BaseReq `json:"base_req" yaml:"base_req"`
-	FeedName          string       `json:"feed_name"`
-	Description       string       `json:"description"`
-	LatestHistory     uint64       `json:"latest_history"`
-	Creator           string       `json:"creator"`
-	Providers         []string     `json:"providers"`
-	Timeout           int64        `json:"timeout"`
-	ServiceFeeCap     string       `json:"service_fee_cap"`
-	RepeatedFrequency uint64       `json:"repeated_frequency"`
-	ResponseThreshold uint32       `json:"response_threshold"`
+	BaseReq            rest.BaseReq `json:"base_req" yaml:"base_req"`
+	FeedName           string       `json:"feed_name"`
+	Description        string       `json:"description"`
+	LatestHistory      uint64       `json:"latest_history"`
+	Creator            string       `json:"creator"`
+	Providers          []string     `json:"providers"`
+	Timeout            int64        `json:"timeout"`
+	ServiceFeeCap      string       `json:"service_fee_cap"`
+	RepeatedFrequency  uint64       `json:"repeated_frequency"`
+	ResponseThreshold  uint32       `json:"response_threshold"`
+	DeviationThreshold uint32       `json:"deviation_threshold"`
+	Heartbeat          uint64       `json:"heartbeat"`
+	DisableDeviation   bool         `json:"disable_deviation_threshold"`
+	DisableHeartbeat   bool         `json:"disable_heartbeat"`
 }
 
 type startFeedReq struct {
@@ -65,6 +80,22 @@ type pauseFeedReq struct {
 	Creator string       `json:"creator"`
 }
 
+type deleteFeedReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Sender  string       `json:"sender"`
+}
+
+type transferFeedOwnerReq struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Sender   string       `json:"sender"`
+	NewOwner string       `json:"new_owner"`
+}
+
+type acceptFeedOwnerReq struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	NewOwner string       `json:"new_owner"`
+}
+
 func createFeedHandlerFn(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req createFeedReq
@@ -99,20 +130,30 @@ func createFeedHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
+		precision := uint32(types.DefaultPrecision)
+		if req.Precision != nil {
+			precision = *req.Precision
+		}
+
 		msg := &types.MsgCreateFeed{
-			FeedName:          req.FeedName,
-			LatestHistory:     req.LatestHistory,
-			Description:       req.Description,
-			Creator:           creator,
-			ServiceName:       req.ServiceName,
-			Providers:         providers,
-			Input:             req.Input,
-			Timeout:           req.Timeout,
-			ServiceFeeCap:     serviceFeeCap,
-			RepeatedFrequency: req.RepeatedFrequency,
-			ResponseThreshold: req.ResponseThreshold,
-			AggregateFunc:     req.AggregateFunc,
-			ValueJsonPath:     req.ValueJsonPath,
+			FeedName:           req.FeedName,
+			LatestHistory:      req.LatestHistory,
+			Description:        req.Description,
+			Creator:            creator,
+			ServiceName:        req.ServiceName,
+			Providers:          providers,
+			Input:              req.Input,
+			Timeout:            req.Timeout,
+			ServiceFeeCap:      serviceFeeCap,
+			RepeatedFrequency:  req.RepeatedFrequency,
+			ResponseThreshold:  req.ResponseThreshold,
+			AggregateFunc:      req.AggregateFunc,
+			TrimPercentage:     req.TrimPercentage,
+			Precision:          precision,
+			DeviationThreshold: req.DeviationThreshold,
+			Heartbeat:          req.Heartbeat,
+			ValueJsonPath:      req.ValueJsonPath,
+			ValueFields:        req.ValueFields,
 		}
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -158,15 +199,19 @@ func editFeedHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		}
 
 		msg := &types.MsgEditFeed{
-			FeedName:          req.FeedName,
-			LatestHistory:     req.LatestHistory,
-			Description:       req.Description,
-			Providers:         providers,
-			Timeout:           req.Timeout,
-			ServiceFeeCap:     serviceFeeCap,
-			RepeatedFrequency: req.RepeatedFrequency,
-			ResponseThreshold: req.ResponseThreshold,
-			Creator:           creator,
+			FeedName:                  req.FeedName,
+			LatestHistory:             req.LatestHistory,
+			Description:               req.Description,
+			Providers:                 providers,
+			Timeout:                   req.Timeout,
+			ServiceFeeCap:             serviceFeeCap,
+			RepeatedFrequency:         req.RepeatedFrequency,
+			ResponseThreshold:         req.ResponseThreshold,
+			DeviationThreshold:        req.DeviationThreshold,
+			Heartbeat:                 req.Heartbeat,
+			DisableDeviationThreshold: req.DisableDeviation,
+			DisableHeartbeat:          req.DisableHeartbeat,
+			Creator:                   creator,
 		}
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -244,3 +289,112 @@ func pauseFeedHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
 	}
 }
+
+func deleteFeedHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req deleteFeedReq
+		if !rest.ReadRESTReq(w, r, cliCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, err := sdk.AccAddressFromBech32(req.Sender)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		vars := mux.Vars(r)
+		feedName := vars[FeedName]
+
+		msg := &types.MsgDeleteFeed{
+			FeedName: feedName,
+			Sender:   sender,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+func transferFeedOwnerHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req transferFeedOwnerReq
+		if !rest.ReadRESTReq(w, r, cliCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, err := sdk.AccAddressFromBech32(req.Sender)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		newOwner, err := sdk.AccAddressFromBech32(req.NewOwner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		vars := mux.Vars(r)
+		feedName := vars[FeedName]
+
+		msg := &types.MsgTransferFeedOwner{
+			FeedName: feedName,
+			Sender:   sender,
+			NewOwner: newOwner,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+func acceptFeedOwnerHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req acceptFeedOwnerReq
+		if !rest.ReadRESTReq(w, r, cliCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		newOwner, err := sdk.AccAddressFromBech32(req.NewOwner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		vars := mux.Vars(r)
+		feedName := vars[FeedName]
+
+		msg := &types.MsgAcceptFeedOwner{
+			FeedName: feedName,
+			NewOwner: newOwner,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
@@ -19,8 +19,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	for _, entry := range data.Entries {
 		k.SetFeed(ctx, entry.Feed)
 
-		reqCtx, found := k.GetRequestContext(ctx, entry.Feed.RequestContextID)
-		if !found {
+		if _, found := k.GetRequestContext(ctx, entry.Feed.RequestContextID); !found {
 			panic(fmt.Errorf("unknown servcie request context: %s", entry.Feed.RequestContextID))
 		}
 
@@ -28,12 +27,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 			k.SetFeedValue(
 				ctx,
 				entry.Feed.FeedName,
-				reqCtx.BatchCounter,
+				value.BatchCounter,
 				entry.Feed.LatestHistory,
 				value,
 			)
 		}
 
+		for _, stats := range entry.ProviderStats {
+			k.SetProviderStats(ctx, entry.Feed.FeedName, stats)
+		}
+
 		k.Enqueue(ctx, entry.Feed.FeedName, entry.State)
 	}
 }
@@ -45,12 +48,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	k.IteratorFeeds(ctx, func(feed types.Feed) {
 		reqCtx, found := k.GetRequestContext(ctx, feed.RequestContextID)
 		if found {
+			var providerStats []types.ProviderStats
+			k.IteratorProviderStats(ctx, feed.FeedName, func(stats types.ProviderStats) {
+				providerStats = append(providerStats, stats)
+			})
 			entries = append(
 				entries,
 				types.FeedEntry{
-					Feed:   feed,
-					Values: k.GetFeedValues(ctx, feed.FeedName),
-					State:  reqCtx.State,
+					Feed:          feed,
+					Values:        k.GetFeedValues(ctx, feed.FeedName),
+					State:         reqCtx.State,
+					ProviderStats: providerStats,
 				},
 			)
 		}
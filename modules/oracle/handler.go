@@ -21,6 +21,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgPauseFeed(ctx, k, msg)
 		case *types.MsgEditFeed:
 			return handleMsgEditFeed(ctx, k, msg)
+		case *types.MsgDeleteFeed:
+			return handleMsgDeleteFeed(ctx, k, msg)
+		case *types.MsgTransferFeedOwner:
+			return handleMsgTransferFeedOwner(ctx, k, msg)
+		case *types.MsgAcceptFeedOwner:
+			return handleMsgAcceptFeedOwner(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -88,3 +94,48 @@ func handleMsgEditFeed(ctx sdk.Context, k keeper.Keeper, msg *types.MsgEditFeed)
 	})
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
+
+// handleMsgDeleteFeed handles MsgDeleteFeed
+func handleMsgDeleteFeed(ctx sdk.Context, k keeper.Keeper, msg *types.MsgDeleteFeed) (*sdk.Result, error) {
+	if err := k.DeleteFeed(ctx, msg); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgTransferFeedOwner handles MsgTransferFeedOwner
+func handleMsgTransferFeedOwner(ctx sdk.Context, k keeper.Keeper, msg *types.MsgTransferFeedOwner) (*sdk.Result, error) {
+	if err := k.TransferFeedOwner(ctx, msg); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgAcceptFeedOwner handles MsgAcceptFeedOwner
+func handleMsgAcceptFeedOwner(ctx sdk.Context, k keeper.Keeper, msg *types.MsgAcceptFeedOwner) (*sdk.Result, error) {
+	if err := k.AcceptFeedOwner(ctx, msg); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.NewOwner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
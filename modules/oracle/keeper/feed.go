@@ -1,16 +1,23 @@
 package keeper
 
 import (
+	"bytes"
+	"math"
+
 	gogotypes "github.com/gogo/protobuf/types"
 
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irismod/service/exported"
 	servicetypes "github.com/irismod/service/types"
 
 	"github.com/irisnet/irishub/modules/oracle/types"
+	"github.com/irisnet/irishub/pagination"
 )
 
 //GetFeed return the feed by feedName
@@ -85,6 +92,7 @@ func (k Keeper) SetFeedValue(
 	counter := k.getFeedValuesCnt(ctx, feedName)
 	delta := counter - int(latestHistory)
 	k.deleteOldestFeedValue(ctx, feedName, delta+1)
+	value.BatchCounter = batchCounter
 	bz := k.cdc.MustMarshalBinaryBare(&value)
 	store.Set(types.GetFeedValueKey(feedName, batchCounter), bz)
 }
@@ -102,6 +110,70 @@ func (k Keeper) GetFeedValues(ctx sdk.Context, feedName string) (result types.Fe
 	return
 }
 
+//GetPaginatedFeedValues return the feed values of feedName matching the filter, latest first
+func (k Keeper) GetPaginatedFeedValues(
+	ctx sdk.Context,
+	feedName string,
+	filter types.FeedValueFilter,
+	pageReq *query.PageRequest,
+) (types.FeedValues, *query.PageResponse, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	var start, end []byte
+	if filter.FromBatch > 0 {
+		start = sdk.Uint64ToBigEndian(filter.FromBatch)
+	}
+	if filter.ToBatch > 0 && filter.ToBatch < math.MaxUint64 {
+		end = sdk.Uint64ToBigEndian(filter.ToBatch + 1)
+	}
+
+	var result types.FeedValues
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFeedValuePrefixKey(feedName))
+	pageRes, err := pagination.Paginate(
+		pageReq, types.DefaultFeedValuesLimit,
+		func(pageKey []byte) sdk.Iterator {
+			// the values are iterated in reverse order, the page key is the first key of the page
+			if len(pageKey) > 0 {
+				keyEnd := append(append([]byte{}, pageKey...), 0x00)
+				if end == nil || bytes.Compare(keyEnd, end) < 0 {
+					end = keyEnd
+				}
+			}
+			return store.ReverseIterator(start, end)
+		},
+		func(key, bz []byte, accumulate bool) bool {
+			var value types.FeedValue
+			k.cdc.MustUnmarshalBinaryBare(bz, &value)
+			if !filter.MatchTime(value) {
+				return false
+			}
+
+			if accumulate {
+				result = append(result, value)
+			}
+			return true
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, pageRes, nil
+}
+
+//GetLatestFeedValue return the latest feed value by feedName
+func (k Keeper) GetLatestFeedValue(ctx sdk.Context, feedName string) (value types.FeedValue, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetFeedValuePrefixKey(feedName))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return value, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &value)
+	return value, true
+}
+
 //Enqueue will put feedName to a 'state' queue
 func (k Keeper) Enqueue(ctx sdk.Context, feedName string, state servicetypes.RequestContextState) {
 	store := ctx.KVStore(k.storeKey)
@@ -149,6 +221,33 @@ func (k Keeper) deleteOldestFeedValue(ctx sdk.Context, feedName string, delta in
 	}
 }
 
+//deleteFeed remove the feed and all the records of the feed from store
+func (k Keeper) deleteFeed(ctx sdk.Context, feed types.Feed) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeedKey(feed.FeedName))
+	store.Delete(types.GetReqCtxIDKey(feed.RequestContextID))
+	store.Delete(types.GetFeedStateKey(feed.FeedName, servicetypes.RUNNING))
+	store.Delete(types.GetFeedStateKey(feed.FeedName, servicetypes.PAUSED))
+
+	k.deleteByPrefix(ctx, types.GetFeedValuePrefixKey(feed.FeedName))
+	k.deleteByPrefix(ctx, types.GetProviderResponsePrefixKey(feed.FeedName))
+	k.deleteByPrefix(ctx, types.GetProviderStatsPrefixKey(feed.FeedName))
+}
+
+func (k Keeper) deleteByPrefix(ctx sdk.Context, prefix []byte) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 func (k Keeper) ResetFeedEntryState(ctx sdk.Context) error {
 	k.IteratorFeedsByState(
 		ctx,
@@ -60,6 +60,29 @@ func (k Keeper) FeedValue(c context.Context, req *types.QueryFeedValueRequest) (
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	result := k.GetFeedValues(ctx, req.FeedName)
-	return &types.QueryFeedValueResponse{FeedValues: result}, nil
+	filter := types.FeedValueFilter{
+		FromTime:  req.FromTime,
+		ToTime:    req.ToTime,
+		FromBatch: req.FromBatch,
+		ToBatch:   req.ToBatch,
+	}
+	result, pageRes, err := k.GetPaginatedFeedValues(ctx, req.FeedName, filter, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryFeedValueResponse{FeedValues: result, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ProviderStats(c context.Context, req *types.QueryProviderStatsRequest) (*types.QueryProviderStatsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetFeed(ctx, req.FeedName); !found {
+		return nil, status.Errorf(codes.NotFound, "feed %s not found", req.FeedName)
+	}
+
+	result := k.GetProvidersAccuracy(ctx, req.FeedName)
+	return &types.QueryProviderStatsResponse{Stats: result}, nil
 }
@@ -2,10 +2,12 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/oracle/keeper"
 	"github.com/irisnet/irishub/modules/oracle/types"
@@ -69,3 +71,90 @@ func (suite *KeeperTestSuite) TestGRPCQueryFeedValue() {
 	expectedValues := app.OracleKeeper.GetFeedValues(ctx, feedName)
 	suite.Equal([]types.FeedValue(expectedValues), valueResp.FeedValues)
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryFeedValuePagination() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.OracleKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	// Add feed values of batch 1 to 10, one minute apart
+	feedName := "test"
+	baseTime := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	for i := uint64(1); i <= 10; i++ {
+		feedValue := types.FeedValue{Data: fmt.Sprintf("%d", i), Timestamp: baseTime.Add(time.Duration(i) * time.Minute)}
+		app.OracleKeeper.SetFeedValue(ctx, feedName, i, 10, feedValue)
+	}
+
+	// Query the first page, latest first
+	valueResp, err := queryClient.FeedValue(gocontext.Background(), &types.QueryFeedValueRequest{
+		FeedName:   feedName,
+		Pagination: &query.PageRequest{Limit: 4, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(valueResp.FeedValues, 4)
+	suite.Equal("10", valueResp.FeedValues[0].Data)
+	suite.Equal(uint64(10), valueResp.FeedValues[0].BatchCounter)
+	suite.Equal("7", valueResp.FeedValues[3].Data)
+	suite.Equal(uint64(10), valueResp.Pagination.Total)
+	suite.NotNil(valueResp.Pagination.NextKey)
+
+	// Query the next page by key
+	valueResp, err = queryClient.FeedValue(gocontext.Background(), &types.QueryFeedValueRequest{
+		FeedName:   feedName,
+		Pagination: &query.PageRequest{Key: valueResp.Pagination.NextKey, Limit: 4},
+	})
+	suite.NoError(err)
+	suite.Len(valueResp.FeedValues, 4)
+	suite.Equal("6", valueResp.FeedValues[0].Data)
+	suite.Equal("3", valueResp.FeedValues[3].Data)
+
+	// Query by offset
+	valueResp, err = queryClient.FeedValue(gocontext.Background(), &types.QueryFeedValueRequest{
+		FeedName:   feedName,
+		Pagination: &query.PageRequest{Offset: 8, Limit: 4},
+	})
+	suite.NoError(err)
+	suite.Len(valueResp.FeedValues, 2)
+	suite.Equal("2", valueResp.FeedValues[0].Data)
+	suite.Nil(valueResp.Pagination.NextKey)
+
+	// Query by batch range
+	valueResp, err = queryClient.FeedValue(gocontext.Background(), &types.QueryFeedValueRequest{
+		FeedName:  feedName,
+		FromBatch: 3,
+		ToBatch:   5,
+	})
+	suite.NoError(err)
+	suite.Len(valueResp.FeedValues, 3)
+	suite.Equal("5", valueResp.FeedValues[0].Data)
+	suite.Equal("3", valueResp.FeedValues[2].Data)
+
+	// Query by time range
+	fromTime := baseTime.Add(2 * time.Minute)
+	toTime := baseTime.Add(4 * time.Minute)
+	valueResp, err = queryClient.FeedValue(gocontext.Background(), &types.QueryFeedValueRequest{
+		FeedName: feedName,
+		FromTime: &fromTime,
+		ToTime:   &toTime,
+	})
+	suite.NoError(err)
+	suite.Len(valueResp.FeedValues, 3)
+	suite.Equal("4", valueResp.FeedValues[0].Data)
+	suite.Equal("2", valueResp.FeedValues[2].Data)
+
+	// Invalid ranges
+	_, err = queryClient.FeedValue(gocontext.Background(), &types.QueryFeedValueRequest{
+		FeedName:  feedName,
+		FromBatch: 5,
+		ToBatch:   3,
+	})
+	suite.Error(err)
+
+	_, err = queryClient.FeedValue(gocontext.Background(), &types.QueryFeedValueRequest{
+		FeedName:   feedName,
+		Pagination: &query.PageRequest{Key: []byte{0x01}, Offset: 1},
+	})
+	suite.Error(err)
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/tidwall/gjson"
@@ -15,6 +17,7 @@ import (
 
 	servicetypes "github.com/irismod/service/types"
 
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 	"github.com/irisnet/irishub/modules/oracle/types"
 )
 
@@ -26,6 +29,10 @@ type Keeper struct {
 	gk types.GuardianKeeper
 	sk types.ServiceKeeper
 
+	// hooks are shared by the copies of the keeper, since the service
+	// callbacks are bound to the keeper before the hooks are set
+	hooks *types.MultiOracleHooks
+
 	paramSpace paramtypes.Subspace
 }
 
@@ -42,6 +49,7 @@ func NewKeeper(
 		cdc:        cdc,
 		gk:         gk,
 		sk:         sk,
+		hooks:      &types.MultiOracleHooks{},
 		paramSpace: paramSpace,
 	}
 	_ = sk.RegisterResponseCallback(types.ModuleName, keeper.HandlerResponse)
@@ -49,11 +57,19 @@ func NewKeeper(
 	return keeper
 }
 
+//SetHooks set the oracle hooks, which are notified when a new feed value is saved
+func (k *Keeper) SetHooks(hooks ...types.OracleHooks) *Keeper {
+	if len(*k.hooks) > 0 {
+		panic("cannot set oracle hooks twice")
+	}
+	*k.hooks = types.NewMultiOracleHooks(hooks...)
+	return k
+}
+
 //CreateFeed create a stopped feed
 func (k Keeper) CreateFeed(ctx sdk.Context, msg *types.MsgCreateFeed) error {
-	_, existed := k.gk.GetProfiler(ctx, msg.Creator)
-	if !existed {
-		return sdkerrors.Wrapf(types.ErrNotProfiler, msg.Creator.String())
+	if !k.gk.HasPermission(ctx, msg.Creator, guardiantypes.RoleFeedAdmin) {
+		return sdkerrors.Wrapf(types.ErrNotProfiler, "%s has no %s permission", msg.Creator, guardiantypes.RoleFeedAdmin)
 	}
 
 	if _, found := k.GetFeed(ctx, msg.FeedName); found {
@@ -81,13 +97,18 @@ func (k Keeper) CreateFeed(ctx sdk.Context, msg *types.MsgCreateFeed) error {
 	}
 
 	k.SetFeed(ctx, types.Feed{
-		FeedName:         msg.FeedName,
-		AggregateFunc:    msg.AggregateFunc,
-		ValueJsonPath:    msg.ValueJsonPath,
-		LatestHistory:    msg.LatestHistory,
-		RequestContextID: requestContextID,
-		Description:      msg.Description,
-		Creator:          msg.Creator,
+		FeedName:           msg.FeedName,
+		AggregateFunc:      msg.AggregateFunc,
+		ValueJsonPath:      msg.ValueJsonPath,
+		LatestHistory:      msg.LatestHistory,
+		RequestContextID:   requestContextID,
+		Description:        msg.Description,
+		Creator:            msg.Creator,
+		TrimPercentage:     msg.TrimPercentage,
+		Precision:          msg.Precision,
+		DeviationThreshold: msg.DeviationThreshold,
+		Heartbeat:          msg.Heartbeat,
+		ValueFields:        msg.ValueFields,
 	})
 	k.Enqueue(ctx, msg.FeedName, servicetypes.PAUSED)
 
@@ -189,7 +210,141 @@ func (k Keeper) EditFeed(ctx sdk.Context, msg *types.MsgEditFeed) error {
 		feed.Description = strings.TrimSpace(msg.Description)
 	}
 
+	if msg.DisableDeviationThreshold {
+		feed.DeviationThreshold = 0
+	} else if msg.DeviationThreshold > 0 {
+		feed.DeviationThreshold = msg.DeviationThreshold
+	}
+
+	if msg.DisableHeartbeat {
+		feed.Heartbeat = 0
+	} else if msg.Heartbeat > 0 {
+		feed.Heartbeat = msg.Heartbeat
+	}
+
+	k.SetFeed(ctx, feed)
+	return nil
+}
+
+//DeleteFeed kill the request context of a feed and remove all the records of the feed,
+//the service fees of the unanswered requests are refunded to the consumer by the service module
+func (k Keeper) DeleteFeed(ctx sdk.Context, msg *types.MsgDeleteFeed) error {
+	feed, found := k.GetFeed(ctx, msg.FeedName)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownFeedName, msg.FeedName)
+	}
+
+	//Only the creator or a feed admin can delete the feed
+	if !msg.Sender.Equals(feed.Creator) {
+		if !k.gk.HasPermission(ctx, msg.Sender, guardiantypes.RoleFeedAdmin) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, msg.Sender.String())
+		}
+	}
+
+	reqCtx, existed := k.sk.GetRequestContext(ctx, feed.RequestContextID)
+	if existed && reqCtx.State != servicetypes.COMPLETED {
+		if err := k.sk.KillRequestContext(ctx, feed.RequestContextID, reqCtx.Consumer); err != nil {
+			return err
+		}
+	}
+
+	values := k.GetFeedValues(ctx, feed.FeedName)
+	k.deleteFeed(ctx, feed)
+
+	feedBz, _ := json.Marshal(feed)
+	var valueBz []byte
+	if len(values) > 0 {
+		valueBz, _ = json.Marshal(values[0])
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteFeed,
+			sdk.NewAttribute(types.AttributeKeyFeedName, feed.FeedName),
+			sdk.NewAttribute(types.AttributeKeyRequestContextID, feed.RequestContextID.String()),
+			sdk.NewAttribute(types.AttributeKeyFeed, string(feedBz)),
+			sdk.NewAttribute(types.AttributeKeyFeedValue, string(valueBz)),
+			sdk.NewAttribute(types.AttributeKeyValueCount, strconv.Itoa(len(values))),
+		),
+	)
+	return nil
+}
+
+//TransferFeedOwner proposes to transfer the ownership of a feed to a feed admin,
+//the transfer takes effect once the new owner accepts it
+func (k Keeper) TransferFeedOwner(ctx sdk.Context, msg *types.MsgTransferFeedOwner) error {
+	feed, found := k.GetFeed(ctx, msg.FeedName)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownFeedName, msg.FeedName)
+	}
+
+	//Only the owner or a feed admin can transfer the feed
+	if !msg.Sender.Equals(feed.Creator) {
+		if !k.gk.HasPermission(ctx, msg.Sender, guardiantypes.RoleFeedAdmin) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, msg.Sender.String())
+		}
+	}
+
+	if msg.NewOwner.Equals(feed.Creator) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already the owner of the feed", msg.NewOwner)
+	}
+
+	if !k.gk.HasPermission(ctx, msg.NewOwner, guardiantypes.RoleFeedAdmin) {
+		return sdkerrors.Wrapf(types.ErrNotProfiler, "%s has no %s permission", msg.NewOwner, guardiantypes.RoleFeedAdmin)
+	}
+
+	feed.PendingOwner = msg.NewOwner
 	k.SetFeed(ctx, feed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferFeedOwner,
+			sdk.NewAttribute(types.AttributeKeyFeedName, feed.FeedName),
+			sdk.NewAttribute(types.AttributeKeyOldOwner, feed.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner.String()),
+		),
+	)
+	return nil
+}
+
+//AcceptFeedOwner accepts the ownership of a feed transferred to the new owner, the new owner
+//becomes the consumer of the request context and pays the service fees
+func (k Keeper) AcceptFeedOwner(ctx sdk.Context, msg *types.MsgAcceptFeedOwner) error {
+	feed, found := k.GetFeed(ctx, msg.FeedName)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownFeedName, msg.FeedName)
+	}
+
+	if feed.PendingOwner.Empty() || !msg.NewOwner.Equals(feed.PendingOwner) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "the feed is not transferred to %s", msg.NewOwner)
+	}
+
+	//the permission may have been revoked since the transfer was proposed
+	if !k.gk.HasPermission(ctx, msg.NewOwner, guardiantypes.RoleFeedAdmin) {
+		return sdkerrors.Wrapf(types.ErrNotProfiler, "%s has no %s permission", msg.NewOwner, guardiantypes.RoleFeedAdmin)
+	}
+
+	reqCtx, existed := k.sk.GetRequestContext(ctx, feed.RequestContextID)
+	if !existed {
+		return sdkerrors.Wrapf(types.ErrUnknownFeedName, msg.FeedName)
+	}
+
+	reqCtx.Consumer = msg.NewOwner
+	k.sk.SetRequestContext(ctx, feed.RequestContextID, reqCtx)
+
+	oldOwner := feed.Creator
+	feed.Creator = msg.NewOwner
+	feed.PendingOwner = nil
+	k.SetFeed(ctx, feed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcceptFeedOwner,
+			sdk.NewAttribute(types.AttributeKeyFeedName, feed.FeedName),
+			sdk.NewAttribute(types.AttributeKeyOldOwner, oldOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner.String()),
+		),
+	)
 	return nil
 }
 
@@ -223,25 +378,44 @@ func (k Keeper) HandlerResponse(ctx sdk.Context,
 		return
 	}
 
-	aggregate, err := types.GetAggregateFunc(feed.AggregateFunc)
-	if err != nil {
-		ctx.Logger().Error(
-			"Not existed aggregateFunc", "aggregateFunc", feed.AggregateFunc,
-		)
-		return
-	}
+	providers := k.getResponseProviders(ctx, requestContextID, reqCtx.BatchCounter, responseOutput)
+
+	fields := feed.Fields()
+	results := make([]sdk.Dec, len(fields))
+	var primaryData []types.ArgsType
+	for i, field := range fields {
+		var data []types.ArgsType
+		for _, jsonStr := range responseOutput {
+			result := gjson.Get(jsonStr, field.ValueJsonPath)
+			data = append(data, result)
+		}
+
+		result, err := k.aggregate(ctx, feed, field.AggregateFunc, reqCtx.ServiceName, data, providers)
+		if err != nil {
+			ctx.Logger().Error(
+				"Not existed aggregateFunc", "aggregateFunc", field.AggregateFunc,
+			)
+			return
+		}
+		results[i] = result
 
-	var data []types.ArgsType
-	for _, jsonStr := range responseOutput {
-		result := gjson.Get(jsonStr, feed.ValueJsonPath)
-		data = append(data, result)
+		if i == 0 {
+			primaryData = data
+		}
 	}
 
-	result := aggregate(data)
-	value := types.FeedValue{
-		Data:      result,
-		Timestamp: ctx.BlockTime(),
+	//the provider statistics are measured by the first value field
+	k.recordProviderResponses(ctx, feed, reqCtx, primaryData, providers, results[0])
+
+	if latest, found := k.GetLatestFeedValue(ctx, feed.FeedName); found && !feed.IsTriggered(latest, results, ctx.BlockTime()) {
+		ctx.Logger().Debug(
+			"Feed value not triggered", "feed", feed.FeedName, "value", results[0].String(),
+		)
+		return
 	}
+
+	value := feed.NewFeedValue(results, ctx.BlockTime())
+	value.BatchCounter = reqCtx.BatchCounter
 	k.SetFeedValue(ctx, feed.FeedName, reqCtx.BatchCounter, feed.LatestHistory, value)
 
 	bz, _ := json.Marshal(value)
@@ -253,6 +427,53 @@ func (k Keeper) HandlerResponse(ctx sdk.Context,
 			sdk.NewAttribute(types.AttributeKeyFeedValue, string(bz)),
 		),
 	)
+
+	for _, hooks := range *k.hooks {
+		k.afterFeedValueSet(ctx, hooks, feed.FeedName, value)
+	}
+}
+
+//afterFeedValueSet calls back the hooks with the saved feed value, the state changes
+//of the hooks are discarded if they panic
+func (k Keeper) afterFeedValueSet(ctx sdk.Context, hooks types.OracleHooks, feedName string, value types.FeedValue) {
+	defer func() {
+		if r := recover(); r != nil {
+			ctx.Logger().Error("Oracle hooks panicked", "feed", feedName, "err", fmt.Sprintf("%v", r))
+		}
+	}()
+
+	//the hooks are run in a cached context so that a failed callback does not
+	//interfere with the feed value which has been saved
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	hooks.AfterFeedValueSet(cacheCtx, feedName, value)
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+//aggregate processes the values retrieved from the service responses by the aggregate function
+func (k Keeper) aggregate(ctx sdk.Context,
+	feed types.Feed,
+	aggregateFunc string,
+	serviceName string,
+	data []types.ArgsType,
+	providers []responseProvider) (sdk.Dec, error) {
+	aggregate, err := types.GetAggregateFunc(aggregateFunc)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	switch aggregateFunc {
+	case types.AggregateFuncTrimmedMean:
+		return types.TrimmedMean(data, feed.TrimPercentage), nil
+	case types.AggregateFuncWeightedMedian:
+		weights := k.getProviderWeights(ctx, serviceName, providers)
+		return types.WeightedMedian(data, weights), nil
+	default:
+		return aggregate(data), nil
+	}
 }
 
 //HandlerStateChanged is responsible for update feed state
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -8,6 +9,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,6 +29,13 @@ var (
 
 	addrs = []sdk.AccAddress{testAddr1, testAddr2}
 
+	responseProviders = []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("provider1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("provider2"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("provider3"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("provider4"))),
+	}
+
 	mockReqCtxID = []byte("mockRequest")
 	responses    = []string{
 		`{"last":100,"high":100,"low":50}`,
@@ -52,7 +61,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
 	suite.app = app
 
-	serviceKeeper := NewMockServiceKeeper()
+	serviceKeeper := NewMockServiceKeeper(app.AppCodec())
 	suite.keeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.GuardianKeeper, serviceKeeper)
 }
 
@@ -60,14 +69,115 @@ func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
 
+func (suite *KeeperTestSuite) TestProviderStats() {
+	// add profiler
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[0], addrs[0], guardiantypes.RoleFeedAdmin))
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+
+	msg := &types.MsgCreateFeed{
+		FeedName:          "ethPrice",
+		ServiceName:       "GetEthPrice",
+		AggregateFunc:     "weighted_median",
+		Precision:         8,
+		ValueJsonPath:     "high",
+		LatestHistory:     5,
+		Providers:         append([]sdk.AccAddress{addrs[1]}, responseProviders...),
+		Input:             "xxxx",
+		Timeout:           10,
+		ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		RepeatedFrequency: 1,
+		ResponseThreshold: 1,
+		Creator:           addrs[0],
+	}
+	err := suite.keeper.CreateFeed(suite.ctx, msg)
+	suite.NoError(err)
+
+	err = suite.keeper.StartFeed(suite.ctx, &types.MsgStartFeed{
+		FeedName: msg.FeedName,
+		Creator:  addrs[0],
+	})
+	suite.NoError(err)
+
+	// the weights of the providers are 1000, 2000, 3000 and 4000
+	result := suite.keeper.GetFeedValues(suite.ctx, msg.FeedName)
+	suite.Equal("300.00000000", result[0].Data)
+
+	providerResponses := suite.keeper.GetProviderResponses(suite.ctx, msg.FeedName, 0)
+	suite.Len(providerResponses, len(responseProviders))
+	for _, response := range providerResponses {
+		suite.Equal(int64(2), response.Latency)
+	}
+
+	accuracies := suite.keeper.GetProvidersAccuracy(suite.ctx, msg.FeedName)
+	suite.Len(accuracies, len(responseProviders)+1)
+	for _, accuracy := range accuracies {
+		if accuracy.Provider.Equals(addrs[1]) {
+			suite.Equal(uint64(0), accuracy.ResponseCount)
+			suite.Equal(uint64(1), accuracy.MissedCount)
+			continue
+		}
+		suite.Equal(uint64(1), accuracy.ResponseCount)
+		suite.Equal(uint64(0), accuracy.MissedCount)
+		suite.Equal(sdk.NewDec(2), accuracy.AverageLatency)
+	}
+
+	stats := suite.keeper.GetProviderStats(suite.ctx, msg.FeedName, responseProviders[0])
+	suite.Equal(sdk.NewDec(2).Quo(sdk.NewDec(3)), stats.MaxDeviation)
+}
+
+func (suite *KeeperTestSuite) TestWeightedMedian() {
+	// add profiler
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[0], addrs[0], guardiantypes.RoleFeedAdmin))
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+
+	msg := &types.MsgCreateFeed{
+		FeedName:    "ethPrice",
+		ServiceName: "GetEthPrice",
+		Precision:   8,
+		ValueFields: []types.ValueField{
+			{Name: "weighted", ValueJsonPath: "high", AggregateFunc: types.AggregateFuncWeightedMedian},
+			{Name: "median", ValueJsonPath: "high", AggregateFunc: types.AggregateFuncMedian},
+		},
+		LatestHistory:     5,
+		Providers:         responseProviders,
+		Input:             "xxxx",
+		Timeout:           10,
+		ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		RepeatedFrequency: 1,
+		ResponseThreshold: 1,
+		Creator:           addrs[0],
+	}
+	err := suite.keeper.CreateFeed(suite.ctx, msg)
+	suite.NoError(err)
+
+	err = suite.keeper.StartFeed(suite.ctx, &types.MsgStartFeed{
+		FeedName: msg.FeedName,
+		Creator:  addrs[0],
+	})
+	suite.NoError(err)
+
+	// the responses are matched to their providers through the body of the stored outputs,
+	// so the weights of 1000, 2000, 3000 and 4000 move the median from 250 to 300
+	result := suite.keeper.GetFeedValues(suite.ctx, msg.FeedName)
+	suite.Len(result, 1)
+	suite.Equal([]types.FieldValue{
+		{Name: "weighted", Data: "300.00000000"},
+		{Name: "median", Data: "250.00000000"},
+	}, result[0].Fields)
+
+	providerResponses := suite.keeper.GetProviderResponses(suite.ctx, msg.FeedName, 0)
+	suite.Len(providerResponses, len(responseProviders))
+}
+
 func (suite *KeeperTestSuite) TestFeed() {
 	// add profiler
-	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[0], addrs[0]))
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[0], addrs[0], guardiantypes.RoleFeedAdmin))
 
 	msg := &types.MsgCreateFeed{
 		FeedName:          "ethPrice",
 		ServiceName:       "GetEthPrice",
 		AggregateFunc:     "avg",
+		Precision:         8,
 		ValueJsonPath:     "high",
 		LatestHistory:     5,
 		Providers:         []sdk.AccAddress{addrs[1]},
@@ -93,6 +203,7 @@ func (suite *KeeperTestSuite) TestFeed() {
 		LatestHistory:    msg.LatestHistory,
 		RequestContextID: mockReqCtxID,
 		Creator:          msg.Creator,
+		Precision:        msg.Precision,
 	}, feed)
 
 	//check feed state
@@ -154,7 +265,38 @@ func (suite *KeeperTestSuite) TestFeed() {
 		LatestHistory:    latestHistory,
 		RequestContextID: feed.RequestContextID,
 		Creator:          msg.Creator,
+		Precision:        msg.Precision,
 	}, feed)
+
+	editMsg := &types.MsgEditFeed{
+		FeedName:           msg.FeedName,
+		Providers:          []sdk.AccAddress{addrs[0]},
+		ServiceFeeCap:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		RepeatedFrequency:  1,
+		ResponseThreshold:  1,
+		DeviationThreshold: 100,
+		Heartbeat:          60,
+		Creator:            addrs[0],
+	}
+	suite.NoError(suite.keeper.EditFeed(suite.ctx, editMsg))
+
+	//the triggers are kept if omitted
+	editMsg.DeviationThreshold = 0
+	editMsg.Heartbeat = 0
+	suite.NoError(suite.keeper.EditFeed(suite.ctx, editMsg))
+
+	feed, _ = suite.keeper.GetFeed(suite.ctx, msg.FeedName)
+	suite.Equal(uint32(100), feed.DeviationThreshold)
+	suite.Equal(uint64(60), feed.Heartbeat)
+
+	//the triggers are switched off explicitly
+	editMsg.DisableDeviationThreshold = true
+	editMsg.DisableHeartbeat = true
+	suite.NoError(suite.keeper.EditFeed(suite.ctx, editMsg))
+
+	feed, _ = suite.keeper.GetFeed(suite.ctx, msg.FeedName)
+	suite.Zero(feed.DeviationThreshold)
+	suite.Zero(feed.Heartbeat)
 	//================test EditFeed end================
 
 	//================test PauseFeed start================
@@ -197,19 +339,361 @@ func (suite *KeeperTestSuite) TestFeed() {
 	//================test PauseFeed end================
 }
 
+func (suite *KeeperTestSuite) TestMultiFieldFeed() {
+	// add profiler
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[0], addrs[0], guardiantypes.RoleFeedAdmin))
+
+	msg := &types.MsgCreateFeed{
+		FeedName:    "ethPrice",
+		ServiceName: "GetEthPrice",
+		Precision:   8,
+		ValueFields: []types.ValueField{
+			{Name: "high", ValueJsonPath: "high", AggregateFunc: "avg"},
+			{Name: "low", ValueJsonPath: "low", AggregateFunc: "max"},
+			{Name: "last", ValueJsonPath: "last", AggregateFunc: "median"},
+		},
+		LatestHistory:     5,
+		Providers:         []sdk.AccAddress{addrs[1]},
+		Input:             "xxxx",
+		Timeout:           10,
+		ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		RepeatedFrequency: 1,
+		ResponseThreshold: 1,
+		Creator:           addrs[0],
+	}
+
+	err := suite.keeper.CreateFeed(suite.ctx, msg)
+	suite.NoError(err)
+
+	feed, existed := suite.keeper.GetFeed(suite.ctx, msg.FeedName)
+	suite.True(existed)
+	suite.Equal(msg.ValueFields, feed.ValueFields)
+
+	err = suite.keeper.StartFeed(suite.ctx, &types.MsgStartFeed{
+		FeedName: msg.FeedName,
+		Creator:  addrs[0],
+	})
+	suite.NoError(err)
+
+	//check feed result
+	result := suite.keeper.GetFeedValues(suite.ctx, msg.FeedName)
+	suite.Len(result, 1)
+	suite.Empty(result[0].Data)
+	suite.Equal([]types.FieldValue{
+		{Name: "high", Data: "250.00000000"},
+		{Name: "low", Data: "50.00000000"},
+		{Name: "last", Data: "100.00000000"},
+	}, result[0].Fields)
+
+	//the provider statistics are measured by the first field
+	var values []string
+	for _, response := range suite.keeper.GetProviderResponses(suite.ctx, msg.FeedName, 0) {
+		values = append(values, response.Value)
+	}
+	suite.ElementsMatch([]string{"100", "200", "300", "400"}, values)
+}
+
+func (suite *KeeperTestSuite) TestHooks() {
+	// add profiler
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[0], addrs[0], guardiantypes.RoleFeedAdmin))
+
+	hooks := &MockOracleHooks{}
+	suite.keeper.SetHooks(hooks)
+	suite.Panics(func() { suite.keeper.SetHooks(hooks) })
+
+	msg := &types.MsgCreateFeed{
+		FeedName:          "ethPrice",
+		ServiceName:       "GetEthPrice",
+		AggregateFunc:     "avg",
+		Precision:         8,
+		ValueJsonPath:     "high",
+		LatestHistory:     5,
+		Providers:         []sdk.AccAddress{addrs[1]},
+		Input:             "xxxx",
+		Timeout:           10,
+		ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		RepeatedFrequency: 1,
+		ResponseThreshold: 1,
+		Creator:           addrs[0],
+	}
+
+	err := suite.keeper.CreateFeed(suite.ctx, msg)
+	suite.NoError(err)
+	suite.Len(hooks.feedNames, 0)
+
+	err = suite.keeper.StartFeed(suite.ctx, &types.MsgStartFeed{
+		FeedName: msg.FeedName,
+		Creator:  addrs[0],
+	})
+	suite.NoError(err)
+
+	//the hooks are called with the saved value
+	suite.Equal([]string{msg.FeedName}, hooks.feedNames)
+	suite.Equal([]types.FeedValue(suite.keeper.GetFeedValues(suite.ctx, msg.FeedName)), hooks.values)
+}
+
+func (suite *KeeperTestSuite) TestPanickingHooks() {
+	// add profiler
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[0], addrs[0], guardiantypes.RoleFeedAdmin))
+
+	panicking := &MockOracleHooks{
+		onSet: func(ctx sdk.Context) {
+			suite.app.GuardianKeeper.AddProfiler(ctx, guardiantypes.NewGuardian("hook", guardiantypes.Ordinary, addrs[1], addrs[0], guardiantypes.RoleFeedAdmin))
+			panic("hook failed")
+		},
+	}
+	hooks := &MockOracleHooks{}
+	suite.keeper.SetHooks(panicking, hooks)
+
+	msg := &types.MsgCreateFeed{
+		FeedName:          "ethPrice",
+		ServiceName:       "GetEthPrice",
+		AggregateFunc:     "avg",
+		Precision:         8,
+		ValueJsonPath:     "high",
+		LatestHistory:     5,
+		Providers:         []sdk.AccAddress{addrs[1]},
+		Input:             "xxxx",
+		Timeout:           10,
+		ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		RepeatedFrequency: 1,
+		ResponseThreshold: 1,
+		Creator:           addrs[0],
+	}
+
+	err := suite.keeper.CreateFeed(suite.ctx, msg)
+	suite.NoError(err)
+
+	err = suite.keeper.StartFeed(suite.ctx, &types.MsgStartFeed{
+		FeedName: msg.FeedName,
+		Creator:  addrs[0],
+	})
+	suite.NoError(err)
+
+	//the feed value is saved and the other hooks are called although a hook panicked
+	suite.Len(suite.keeper.GetFeedValues(suite.ctx, msg.FeedName), 1)
+	suite.Equal([]string{msg.FeedName}, hooks.feedNames)
+
+	//the state changes of the panicking hook are discarded
+	suite.False(suite.app.GuardianKeeper.HasPermission(suite.ctx, addrs[1], guardiantypes.RoleFeedAdmin))
+}
+
+func (suite *KeeperTestSuite) TestDeleteFeed() {
+	// add profiler
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[0], addrs[0], guardiantypes.RoleFeedAdmin))
+
+	msg := &types.MsgCreateFeed{
+		FeedName:          "ethPrice",
+		ServiceName:       "GetEthPrice",
+		AggregateFunc:     "avg",
+		Precision:         8,
+		ValueJsonPath:     "high",
+		LatestHistory:     5,
+		Providers:         []sdk.AccAddress{addrs[1]},
+		Input:             "xxxx",
+		Timeout:           10,
+		ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		RepeatedFrequency: 1,
+		ResponseThreshold: 1,
+		Creator:           addrs[0],
+	}
+
+	err := suite.keeper.CreateFeed(suite.ctx, msg)
+	suite.NoError(err)
+
+	err = suite.keeper.StartFeed(suite.ctx, &types.MsgStartFeed{
+		FeedName: msg.FeedName,
+		Creator:  addrs[0],
+	})
+	suite.NoError(err)
+	suite.Len(suite.keeper.GetFeedValues(suite.ctx, msg.FeedName), 1)
+	suite.Len(suite.keeper.GetProvidersAccuracy(suite.ctx, msg.FeedName), len(responseProviders))
+
+	//neither the creator nor a feed admin, will return error
+	err = suite.keeper.DeleteFeed(suite.ctx, &types.MsgDeleteFeed{
+		FeedName: msg.FeedName,
+		Sender:   addrs[1],
+	})
+	suite.Error(err)
+
+	//a profiler without the feed admin role, will return error
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[1], addrs[0]))
+	err = suite.keeper.DeleteFeed(suite.ctx, &types.MsgDeleteFeed{
+		FeedName: msg.FeedName,
+		Sender:   addrs[1],
+	})
+	suite.Error(err)
+
+	//delete by a feed admin
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[1], addrs[0], guardiantypes.RoleFeedAdmin))
+	err = suite.keeper.DeleteFeed(suite.ctx, &types.MsgDeleteFeed{
+		FeedName: msg.FeedName,
+		Sender:   addrs[1],
+	})
+	suite.NoError(err)
+
+	//check the request context is killed
+	reqCtx, existed := suite.keeper.GetRequestContext(suite.ctx, mockReqCtxID)
+	suite.True(existed)
+	suite.Equal(exported.COMPLETED, reqCtx.State)
+
+	//check all the feed records are removed
+	_, existed = suite.keeper.GetFeed(suite.ctx, msg.FeedName)
+	suite.False(existed)
+	_, existed = suite.keeper.GetFeedByReqCtxID(suite.ctx, mockReqCtxID)
+	suite.False(existed)
+	suite.Len(suite.keeper.GetFeedValues(suite.ctx, msg.FeedName), 0)
+	suite.Len(suite.keeper.GetProvidersAccuracy(suite.ctx, msg.FeedName), 0)
+	suite.Len(suite.keeper.GetProviderResponses(suite.ctx, msg.FeedName, reqCtx.BatchCounter), 0)
+
+	var feeds []types.Feed
+	suite.keeper.IteratorFeeds(suite.ctx, func(feed types.Feed) {
+		feeds = append(feeds, feed)
+	})
+	suite.keeper.IteratorFeedsByState(suite.ctx, exported.RUNNING, func(feed types.Feed) {
+		feeds = append(feeds, feed)
+	})
+	suite.Len(feeds, 0)
+
+	//delete again, will return error
+	err = suite.keeper.DeleteFeed(suite.ctx, &types.MsgDeleteFeed{
+		FeedName: msg.FeedName,
+		Sender:   addrs[0],
+	})
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestTransferFeedOwner() {
+	// add profiler
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[0], addrs[0], guardiantypes.RoleFeedAdmin))
+
+	msg := &types.MsgCreateFeed{
+		FeedName:          "ethPrice",
+		ServiceName:       "GetEthPrice",
+		AggregateFunc:     "avg",
+		Precision:         8,
+		ValueJsonPath:     "high",
+		LatestHistory:     5,
+		Providers:         []sdk.AccAddress{addrs[1]},
+		Input:             "xxxx",
+		Timeout:           10,
+		ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		RepeatedFrequency: 1,
+		ResponseThreshold: 1,
+		Creator:           addrs[0],
+	}
+
+	err := suite.keeper.CreateFeed(suite.ctx, msg)
+	suite.NoError(err)
+
+	transferMsg := &types.MsgTransferFeedOwner{
+		FeedName: msg.FeedName,
+		Sender:   addrs[0],
+		NewOwner: addrs[1],
+	}
+
+	//the new owner is not a feed admin, will return error
+	err = suite.keeper.TransferFeedOwner(suite.ctx, transferMsg)
+	suite.Error(err)
+
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[1], addrs[0], guardiantypes.RoleFeedAdmin))
+	err = suite.keeper.TransferFeedOwner(suite.ctx, transferMsg)
+	suite.NoError(err)
+
+	//the ownership is not transferred until the new owner accepts it
+	feed, existed := suite.keeper.GetFeed(suite.ctx, msg.FeedName)
+	suite.True(existed)
+	suite.Equal(addrs[0], feed.Creator)
+	suite.Equal(addrs[1], feed.PendingOwner)
+
+	//only the new owner can accept the transfer
+	err = suite.keeper.AcceptFeedOwner(suite.ctx, &types.MsgAcceptFeedOwner{
+		FeedName: msg.FeedName,
+		NewOwner: addrs[0],
+	})
+	suite.Error(err)
+
+	err = suite.keeper.AcceptFeedOwner(suite.ctx, &types.MsgAcceptFeedOwner{
+		FeedName: msg.FeedName,
+		NewOwner: addrs[1],
+	})
+	suite.NoError(err)
+
+	//check the owner of the feed and the consumer of the request context
+	feed, existed = suite.keeper.GetFeed(suite.ctx, msg.FeedName)
+	suite.True(existed)
+	suite.Equal(addrs[1], feed.Creator)
+	suite.Empty(feed.PendingOwner)
+
+	reqCtx, existed := suite.keeper.GetRequestContext(suite.ctx, feed.RequestContextID)
+	suite.True(existed)
+	suite.Equal(addrs[1], reqCtx.Consumer)
+
+	//transfer to the current owner, will return error
+	err = suite.keeper.TransferFeedOwner(suite.ctx, transferMsg)
+	suite.Error(err)
+
+	//the old owner can not start the feed any more
+	err = suite.keeper.StartFeed(suite.ctx, &types.MsgStartFeed{
+		FeedName: msg.FeedName,
+		Creator:  addrs[0],
+	})
+	suite.Error(err)
+
+	err = suite.keeper.StartFeed(suite.ctx, &types.MsgStartFeed{
+		FeedName: msg.FeedName,
+		Creator:  addrs[1],
+	})
+	suite.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMigrateFeedPrecision() {
+	//a feed created before the precision was introduced
+	suite.keeper.SetFeed(suite.ctx, types.Feed{FeedName: "legacy", RequestContextID: []byte("legacy")})
+	suite.keeper.SetFeed(suite.ctx, types.Feed{FeedName: "cents", RequestContextID: []byte("cents"), Precision: 2})
+
+	suite.keeper.MigrateFeedPrecision(suite.ctx)
+
+	feed, found := suite.keeper.GetFeed(suite.ctx, "legacy")
+	suite.True(found)
+	suite.Equal(uint32(types.DefaultPrecision), feed.Precision)
+
+	feed, found = suite.keeper.GetFeed(suite.ctx, "cents")
+	suite.True(found)
+	suite.Equal(uint32(2), feed.Precision)
+}
+
+var _ types.OracleHooks = &MockOracleHooks{}
+
+type MockOracleHooks struct {
+	feedNames []string
+	values    []types.FeedValue
+	onSet     func(ctx sdk.Context)
+}
+
+func (h *MockOracleHooks) AfterFeedValueSet(ctx sdk.Context, feedName string, value types.FeedValue) {
+	if h.onSet != nil {
+		h.onSet(ctx)
+	}
+	h.feedNames = append(h.feedNames, feedName)
+	h.values = append(h.values, value)
+}
+
 var _ types.ServiceKeeper = MockServiceKeeper{}
 
 type MockServiceKeeper struct {
+	cdc              codec.Marshaler
 	cxtMap           map[string]exported.RequestContext
 	callbackMap      map[string]exported.ResponseCallback
 	stateCallbackMap map[string]exported.StateCallback
 }
 
-func NewMockServiceKeeper() MockServiceKeeper {
+func NewMockServiceKeeper(cdc codec.Marshaler) MockServiceKeeper {
 	cxtMap := make(map[string]exported.RequestContext)
 	callbackMap := make(map[string]exported.ResponseCallback)
 	stateCallbackMap := make(map[string]exported.StateCallback)
 	return MockServiceKeeper{
+		cdc:              cdc,
 		cxtMap:           cxtMap,
 		callbackMap:      callbackMap,
 		stateCallbackMap: stateCallbackMap,
@@ -234,6 +718,11 @@ func (m MockServiceKeeper) GetRequestContext(ctx sdk.Context,
 	return reqCtx, ok
 }
 
+func (m MockServiceKeeper) SetRequestContext(ctx sdk.Context,
+	requestContextID tmbytes.HexBytes, requestContext exported.RequestContext) {
+	m.cxtMap[string(requestContextID)] = requestContext
+}
+
 func (m MockServiceKeeper) CreateRequestContext(ctx sdk.Context,
 	serviceName string,
 	providers []sdk.AccAddress,
@@ -296,3 +785,56 @@ func (m MockServiceKeeper) PauseRequestContext(ctx sdk.Context, requestContextID
 	m.cxtMap[string(requestContextID)] = reqCtx
 	return nil
 }
+
+func (m MockServiceKeeper) KillRequestContext(ctx sdk.Context, requestContextID tmbytes.HexBytes, consumer sdk.AccAddress) error {
+	reqCtx := m.cxtMap[string(requestContextID)]
+	reqCtx.State = exported.COMPLETED
+	m.cxtMap[string(requestContextID)] = reqCtx
+	return nil
+}
+
+func (m MockServiceKeeper) RequestsIteratorByReqCtx(ctx sdk.Context, requestContextID tmbytes.HexBytes, batchCounter uint64) sdk.Iterator {
+	db := dbm.NewMemDB()
+	for i, provider := range responseProviders {
+		request := servicetypes.CompactRequest{
+			RequestContextID:           requestContextID,
+			RequestContextBatchCounter: batchCounter,
+			Provider:                   provider,
+			RequestHeight:              ctx.BlockHeight() - 2,
+		}
+		_ = db.Set([]byte{byte(i)}, m.cdc.MustMarshalBinaryBare(&request))
+	}
+
+	iterator, _ := db.Iterator(nil, nil)
+	return iterator
+}
+
+func (m MockServiceKeeper) ResponsesIteratorByReqCtx(ctx sdk.Context, requestContextID tmbytes.HexBytes, batchCounter uint64) sdk.Iterator {
+	db := dbm.NewMemDB()
+	for i, output := range responses {
+		// the service module stores the output wrapped in a header/body envelope
+		response := servicetypes.Response{
+			Provider:                   responseProviders[i],
+			Output:                     fmt.Sprintf(`{"header":{},"body":%s}`, output),
+			RequestContextID:           requestContextID,
+			RequestContextBatchCounter: batchCounter,
+		}
+		_ = db.Set([]byte{byte(i)}, m.cdc.MustMarshalBinaryBare(&response))
+	}
+
+	iterator, _ := db.Iterator(nil, nil)
+	return iterator
+}
+
+func (m MockServiceKeeper) GetServiceBinding(ctx sdk.Context, serviceName string, provider sdk.AccAddress) (servicetypes.ServiceBinding, bool) {
+	for i, p := range responseProviders {
+		if p.Equals(provider) {
+			return servicetypes.ServiceBinding{
+				ServiceName: serviceName,
+				Provider:    provider,
+				Deposit:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(i+1)*1000))),
+			}, true
+		}
+	}
+	return servicetypes.ServiceBinding{}, false
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/oracle/types"
+)
+
+// MigrateFeedPrecision sets the default precision of the feeds created before the precision was
+// introduced, whose values were formatted with the default number of decimal places
+func (k Keeper) MigrateFeedPrecision(ctx sdk.Context) {
+	var feeds []types.Feed
+	k.IteratorFeeds(ctx, func(feed types.Feed) {
+		if feed.Precision == 0 {
+			feeds = append(feeds, feed)
+		}
+	})
+
+	for _, feed := range feeds {
+		feed.Precision = types.DefaultPrecision
+		k.SetFeed(ctx, feed)
+	}
+}
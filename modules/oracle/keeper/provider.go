@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"github.com/tidwall/gjson"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	servicetypes "github.com/irismod/service/types"
+
+	"github.com/irisnet/irishub/modules/oracle/types"
+)
+
+// responseProvider is the provider of a response output and the height its request was sent at
+type responseProvider struct {
+	provider      sdk.AccAddress
+	requestHeight int64
+}
+
+//getResponseProviders returns the provider of each response output of the batch,
+//the provider is left empty if the output can not be matched to a response
+func (k Keeper) getResponseProviders(ctx sdk.Context,
+	requestContextID tmbytes.HexBytes,
+	batchCounter uint64,
+	responseOutput []string) []responseProvider {
+	requestHeights := k.getRequestHeights(ctx, requestContextID, batchCounter)
+
+	iterator := k.sk.ResponsesIteratorByReqCtx(ctx, requestContextID, batchCounter)
+	defer iterator.Close()
+
+	var responses []servicetypes.Response
+	for ; iterator.Valid(); iterator.Next() {
+		var response servicetypes.Response
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &response)
+		responses = append(responses, response)
+	}
+
+	providers := make([]responseProvider, len(responseOutput))
+	for i, output := range responseOutput {
+		for j, response := range responses {
+			// the stored output is wrapped in a header/body envelope while
+			// the callback only receives the body
+			if gjson.Get(response.Output, "body").String() != output {
+				continue
+			}
+
+			providers[i] = responseProvider{
+				provider:      response.Provider,
+				requestHeight: requestHeights[response.Provider.String()],
+			}
+
+			// each response can only be matched once
+			responses = append(responses[:j], responses[j+1:]...)
+			break
+		}
+	}
+	return providers
+}
+
+//getRequestHeights returns the height at which the request of the batch was sent to each provider
+func (k Keeper) getRequestHeights(ctx sdk.Context,
+	requestContextID tmbytes.HexBytes,
+	batchCounter uint64) map[string]int64 {
+	iterator := k.sk.RequestsIteratorByReqCtx(ctx, requestContextID, batchCounter)
+	defer iterator.Close()
+
+	heights := make(map[string]int64)
+	for ; iterator.Valid(); iterator.Next() {
+		var request servicetypes.CompactRequest
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &request)
+		heights[request.Provider.String()] = request.RequestHeight
+	}
+	return heights
+}
+
+//getProviderWeights returns the weight of the provider of each response output,
+//measured by the deposit of its service binding
+func (k Keeper) getProviderWeights(ctx sdk.Context,
+	serviceName string,
+	providers []responseProvider) []sdk.Int {
+	weights := make([]sdk.Int, len(providers))
+	for i, p := range providers {
+		weights[i] = sdk.ZeroInt()
+		if p.provider.Empty() {
+			continue
+		}
+		if binding, found := k.sk.GetServiceBinding(ctx, serviceName, p.provider); found {
+			for _, deposit := range binding.Deposit {
+				weights[i] = weights[i].Add(deposit.Amount)
+			}
+		}
+	}
+	return weights
+}
+
+//recordProviderResponses saves the value, latency and deviation from the aggregated value
+//of every provider response in the batch, and accumulates them into the provider statistics
+func (k Keeper) recordProviderResponses(ctx sdk.Context,
+	feed types.Feed,
+	reqCtx servicetypes.RequestContext,
+	data []types.ArgsType,
+	providers []responseProvider,
+	aggregated sdk.Dec) {
+	responded := make(map[string]bool)
+	for i, p := range providers {
+		if p.provider.Empty() || responded[p.provider.String()] {
+			continue
+		}
+		responded[p.provider.String()] = true
+
+		// non-numeric values are regarded as completely deviated
+		deviation := sdk.OneDec()
+		if value, ok := types.ParseDec(data[i]); ok {
+			deviation = types.Deviation(value, aggregated)
+		}
+
+		var latency int64
+		if p.requestHeight > 0 {
+			latency = ctx.BlockHeight() - p.requestHeight
+		}
+
+		k.SetProviderResponse(ctx, feed.FeedName, types.ProviderResponse{
+			Provider:     p.provider,
+			BatchCounter: reqCtx.BatchCounter,
+			Value:        data[i].String(),
+			Latency:      latency,
+			Deviation:    deviation,
+		})
+
+		stats := k.GetProviderStats(ctx, feed.FeedName, p.provider)
+		stats.AddResponse(latency, deviation)
+		k.SetProviderStats(ctx, feed.FeedName, stats)
+	}
+
+	for _, provider := range reqCtx.Providers {
+		if responded[provider.String()] {
+			continue
+		}
+		stats := k.GetProviderStats(ctx, feed.FeedName, provider)
+		stats.MissedCount++
+		k.SetProviderStats(ctx, feed.FeedName, stats)
+	}
+
+	if reqCtx.BatchCounter >= feed.LatestHistory {
+		k.deleteProviderResponses(ctx, feed.FeedName, reqCtx.BatchCounter-feed.LatestHistory)
+	}
+}
+
+//SetProviderResponse will save a provider response to store
+func (k Keeper) SetProviderResponse(ctx sdk.Context, feedName string, response types.ProviderResponse) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&response)
+	store.Set(types.GetProviderResponseKey(feedName, response.BatchCounter, response.Provider), bz)
+}
+
+//GetProviderResponses return the provider responses of the feed batch
+func (k Keeper) GetProviderResponses(ctx sdk.Context, feedName string, batchCounter uint64) (responses []types.ProviderResponse) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetProviderResponseBatchKey(feedName, batchCounter))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var response types.ProviderResponse
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &response)
+		responses = append(responses, response)
+	}
+	return
+}
+
+//deleteProviderResponses deletes the provider responses of the batches up to the given batch counter
+func (k Keeper) deleteProviderResponses(ctx sdk.Context, feedName string, batchCounter uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.GetProviderResponsePrefixKey(feedName),
+		types.GetProviderResponseBatchKey(feedName, batchCounter+1),
+	)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+//GetProviderStats return the statistics of the feed provider
+func (k Keeper) GetProviderStats(ctx sdk.Context, feedName string, provider sdk.AccAddress) types.ProviderStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetProviderStatsKey(feedName, provider))
+	if bz == nil {
+		return types.NewProviderStats(provider)
+	}
+
+	var stats types.ProviderStats
+	k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	return stats
+}
+
+//SetProviderStats will save the statistics of the feed provider to store
+func (k Keeper) SetProviderStats(ctx sdk.Context, feedName string, stats types.ProviderStats) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&stats)
+	store.Set(types.GetProviderStatsKey(feedName, stats.Provider), bz)
+}
+
+//IteratorProviderStats will foreach the statistics of all providers of the feed
+func (k Keeper) IteratorProviderStats(ctx sdk.Context, feedName string, fn func(stats types.ProviderStats)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetProviderStatsPrefixKey(feedName))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.ProviderStats
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stats)
+		fn(stats)
+	}
+}
+
+//GetProvidersAccuracy return the accuracy statistics of all providers of the feed
+func (k Keeper) GetProvidersAccuracy(ctx sdk.Context, feedName string) (result []types.ProviderAccuracy) {
+	k.IteratorProviderStats(ctx, feedName, func(stats types.ProviderStats) {
+		result = append(result, stats.Accuracy())
+	})
+	return
+}
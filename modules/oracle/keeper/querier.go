@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/oracle/types"
 )
@@ -22,6 +23,8 @@ func NewQuerier(k Keeper, legacyQuerierCdc codec.JSONMarshaler) sdk.Querier {
 			return queryFeeds(ctx, req, k, legacyQuerierCdc)
 		case types.QueryFeedValue:
 			return queryFeedValue(ctx, req, k, legacyQuerierCdc)
+		case types.QueryProviderStats:
+			return queryProviderStats(ctx, req, k, legacyQuerierCdc)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -82,7 +85,44 @@ func queryFeedValue(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuer
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	result := k.GetFeedValues(ctx, params.FeedName)
+	filter := types.FeedValueFilter{
+		FromTime:  params.FromTime,
+		ToTime:    params.ToTime,
+		FromBatch: params.FromBatch,
+		ToBatch:   params.ToBatch,
+	}
+	limit := params.Limit
+	if limit == 0 {
+		limit = types.DefaultFeedValuesLimit
+	}
+	pageReq := &query.PageRequest{Limit: limit}
+	if params.Page > 1 {
+		pageReq.Offset = (params.Page - 1) * limit
+	}
+
+	result, _, err := k.GetPaginatedFeedValues(ctx, params.FeedName, filter, pageReq)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, result)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryProviderStats(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryProviderStatsParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if _, found := k.GetFeed(ctx, params.FeedName); !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFeedName, params.FeedName)
+	}
+
+	result := k.GetProvidersAccuracy(ctx, params.FeedName)
 	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, result)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
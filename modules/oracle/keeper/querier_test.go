@@ -14,12 +14,13 @@ import (
 
 func (suite *KeeperTestSuite) TestNewQuerier() {
 	// add profiler
-	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[0], addrs[0]))
+	suite.app.GuardianKeeper.AddProfiler(suite.ctx, guardiantypes.NewGuardian("test", guardiantypes.Ordinary, addrs[0], addrs[0], guardiantypes.RoleFeedAdmin))
 
 	msg := &types.MsgCreateFeed{
 		FeedName:          "ethPrice",
 		ServiceName:       "GetEthPrice",
 		AggregateFunc:     "avg",
+		Precision:         8,
 		ValueJsonPath:     "high",
 		LatestHistory:     5,
 		Providers:         []sdk.AccAddress{addrs[1]},
@@ -58,6 +59,7 @@ func (suite *KeeperTestSuite) TestNewQuerier() {
 			LatestHistory:    msg.LatestHistory,
 			RequestContextID: mockReqCtxID,
 			Creator:          msg.Creator,
+			Precision:        msg.Precision,
 		},
 		ServiceName:       msg.ServiceName,
 		Providers:         msg.Providers,
@@ -90,6 +92,7 @@ func (suite *KeeperTestSuite) TestNewQuerier() {
 			LatestHistory:    msg.LatestHistory,
 			RequestContextID: mockReqCtxID,
 			Creator:          msg.Creator,
+			Precision:        msg.Precision,
 		},
 		ServiceName:       msg.ServiceName,
 		Providers:         msg.Providers,
@@ -123,4 +126,17 @@ func (suite *KeeperTestSuite) TestNewQuerier() {
 	suite.cdc.MustUnmarshalJSON(res, &feedValues)
 	suite.Len(feedsCtx, 1)
 	suite.Equal("250.00000000", feedValues[0].Data)
+
+	//the second page of the default limit is empty
+	params2.Page = 2
+	bz = suite.cdc.MustMarshalJSON(params2)
+	res, err = querier(
+		suite.ctx,
+		[]string{types.QueryFeedValue},
+		abci.RequestQuery{Data: bz},
+	)
+	suite.NoError(err)
+	feedValues = nil
+	suite.cdc.MustUnmarshalJSON(res, &feedValues)
+	suite.Empty(feedValues)
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/irisnet/irishub/modules/oracle/client/cli"
 	"github.com/irisnet/irishub/modules/oracle/client/rest"
 	"github.com/irisnet/irishub/modules/oracle/keeper"
+	"github.com/irisnet/irishub/modules/oracle/simulation"
 	"github.com/irisnet/irishub/modules/oracle/types"
 )
 
@@ -90,7 +91,11 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 type AppModule struct {
 	AppModuleBasic
 
-	keeper keeper.Keeper
+	keeper         keeper.Keeper
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	guardianKeeper types.GuardianSimKeeper
+	serviceKeeper  types.ServiceSimKeeper
 }
 
 func (am AppModule) RegisterQueryService(server grpc.Server) {
@@ -98,10 +103,21 @@ func (am AppModule) RegisterQueryService(server grpc.Server) {
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Marshaler, keeper keeper.Keeper) AppModule {
+func NewAppModule(
+	cdc codec.Marshaler,
+	keeper keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	guardianKeeper types.GuardianSimKeeper,
+	serviceKeeper types.ServiceSimKeeper,
+) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         keeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		guardianKeeper: guardianKeeper,
+		serviceKeeper:  serviceKeeper,
 	}
 }
 
@@ -157,6 +173,7 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 
 // GenerateGenesisState creates a randomized GenState of the oracle module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
 }
 
 // ProposalContents doesn't return any content functions for governance proposals.
@@ -166,14 +183,18 @@ func (AppModule) ProposalContents(simState module.SimulationState) []simtypes.We
 
 // RandomizedParams creates randomized oracle param changes for the simulator.
 func (AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
-	return nil
+	return simulation.ParamChanges(r)
 }
 
 // RegisterStoreDecoder registers a decoder for supply module's types
 func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
 }
 
 // WeightedOperations returns the all the oracle module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, am.keeper,
+		am.accountKeeper, am.bankKeeper, am.guardianKeeper, am.serviceKeeper,
+	)
 }
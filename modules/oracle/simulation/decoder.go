@@ -0,0 +1,51 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/irisnet/irishub/modules/oracle/types"
+)
+
+// NewDecodeStore returns a function closure that unmarshals the KVPair's values
+// to the corresponding oracle types.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.PrefixFeedKey):
+			var feedA, feedB types.Feed
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &feedA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &feedB)
+			return fmt.Sprintf("feedA: %v\nfeedB: %v", feedA, feedB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixReqCtxIdKey),
+			bytes.Equal(kvA.Key[:1], types.PrefixFeedRunningStateKey),
+			bytes.Equal(kvA.Key[:1], types.PrefixFeedPauseStateKey):
+			var feedNameA, feedNameB gogotypes.StringValue
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &feedNameA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &feedNameB)
+			return fmt.Sprintf("feedNameA: %s\nfeedNameB: %s", feedNameA.Value, feedNameB.Value)
+		case bytes.Equal(kvA.Key[:1], types.PrefixFeedValueKey):
+			var valueA, valueB types.FeedValue
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &valueA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &valueB)
+			return fmt.Sprintf("valueA: %v\nvalueB: %v", valueA, valueB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixProviderResponseKey):
+			var responseA, responseB types.ProviderResponse
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &responseA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &responseB)
+			return fmt.Sprintf("responseA: %v\nresponseB: %v", responseA, responseB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixProviderStatsKey):
+			var statsA, statsB types.ProviderStats
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &statsA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &statsB)
+			return fmt.Sprintf("statsA: %v\nstatsB: %v", statsA, statsB)
+		default:
+			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/irisnet/irishub/modules/oracle/simulation"
+	"github.com/irisnet/irishub/modules/oracle/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	provider := sdk.AccAddress("provider")
+	feed := types.Feed{
+		FeedName:         "ethPrice",
+		AggregateFunc:    "avg",
+		ValueJsonPath:    "price",
+		LatestHistory:    10,
+		RequestContextID: []byte("requestContextID"),
+		Creator:          sdk.AccAddress("creator"),
+	}
+	feedName := gogotypes.StringValue{Value: feed.FeedName}
+	value := types.FeedValue{Data: "250.00000000", Timestamp: time.Now().UTC(), BatchCounter: 1}
+	response := types.ProviderResponse{Provider: provider, BatchCounter: 1, Value: "250", Deviation: sdk.ZeroDec()}
+	stats := types.ProviderStats{Provider: provider, ResponseCount: 1, TotalDeviation: sdk.ZeroDec(), MaxDeviation: sdk.ZeroDec()}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetFeedKey(feed.FeedName), Value: cdc.MustMarshalBinaryBare(&feed)},
+			{Key: types.GetReqCtxIDKey(feed.RequestContextID), Value: cdc.MustMarshalBinaryBare(&feedName)},
+			{Key: types.GetFeedValueKey(feed.FeedName, 1), Value: cdc.MustMarshalBinaryBare(&value)},
+			{Key: types.GetProviderResponseKey(feed.FeedName, 1, provider), Value: cdc.MustMarshalBinaryBare(&response)},
+			{Key: types.GetProviderStatsKey(feed.FeedName, provider), Value: cdc.MustMarshalBinaryBare(&stats)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"feeds", fmt.Sprintf("feedA: %v\nfeedB: %v", feed, feed)},
+		{"request contexts", fmt.Sprintf("feedNameA: %s\nfeedNameB: %s", feed.FeedName, feed.FeedName)},
+		{"feed values", fmt.Sprintf("valueA: %v\nvalueB: %v", value, value)},
+		{"provider responses", fmt.Sprintf("responseA: %v\nresponseB: %v", response, response)},
+		{"provider stats", fmt.Sprintf("statsA: %v\nstatsB: %v", stats, stats)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
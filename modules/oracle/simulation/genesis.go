@@ -0,0 +1,22 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/irisnet/irishub/modules/oracle/types"
+)
+
+// RandomizedGenState generates a random GenesisState for oracle, the feeds are
+// created by the simulated operations since they depend on the request contexts
+// of the service module
+func RandomizedGenState(simState *module.SimulationState) {
+	oracleGenesis := types.DefaultGenesisState()
+
+	fmt.Printf("Selected randomly generated oracle parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, oracleGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(oracleGenesis)
+}
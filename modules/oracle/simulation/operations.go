@@ -0,0 +1,479 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	servicetypes "github.com/irismod/service/types"
+
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/modules/oracle/keeper"
+	"github.com/irisnet/irishub/modules/oracle/types"
+	"github.com/irisnet/irishub/simapp/helpers"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateFeed      = "op_weight_msg_create_feed"
+	OpWeightMsgStartFeed       = "op_weight_msg_start_feed"
+	OpWeightMsgPauseFeed       = "op_weight_msg_pause_feed"
+	OpWeightMsgEditFeed        = "op_weight_msg_edit_feed"
+	OpWeightMsgDeleteFeed      = "op_weight_msg_delete_feed"
+	OpWeightMsgTransferFeed    = "op_weight_msg_transfer_feed_owner"
+	OpWeightMsgAcceptFeed      = "op_weight_msg_accept_feed_owner"
+	OpWeightMsgRespondService  = "op_weight_msg_respond_service"
+	DefaultWeightMsgCreateFeed = 30
+	DefaultWeightMsgStartFeed  = 30
+	DefaultWeightMsgPauseFeed  = 10
+	DefaultWeightMsgEditFeed   = 20
+	DefaultWeightMsgDeleteFeed = 5
+	DefaultWeightMsgTransfer   = 10
+	DefaultWeightMsgAccept     = 10
+	DefaultWeightMsgRespond    = 100
+)
+
+// Mock service the simulated feeds are created on
+const (
+	ServiceName          = "oracle-sim"
+	ServiceDesc          = "mock service definition of the oracle simulation"
+	ServiceValueJsonPath = "price"
+	ServiceQoS           = 1
+	ServiceOptions       = "{}"
+	AuthorDescription    = "oracle simulation account"
+	FeedInput            = `{"header":{},"body":{}}`
+	ServiceSchemas       = `
+	{
+		"input": {
+			"$schema": "http://json-schema.org/draft-04/schema#",
+			"title": "oracle-sim-input",
+			"description": "Oracle Simulation Input Schema",
+			"type": "object"
+		},
+		"output": {
+			"$schema": "http://json-schema.org/draft-04/schema#",
+			"title": "oracle-sim-output",
+			"description": "Oracle Simulation Output Schema",
+			"type": "object",
+			"properties": {
+				"price": {
+					"description": "price",
+					"type": "number"
+				}
+			},
+			"required": [
+				"price"
+			]
+		}
+	}
+	`
+)
+
+var (
+	ServiceTags    = []string{types.ModuleName}
+	ServicePricing = fmt.Sprintf(`{"price":"1%s"}`, sdk.DefaultBondDenom)
+
+	mockService = helpers.MockService{
+		Name:              ServiceName,
+		Description:       ServiceDesc,
+		Tags:              ServiceTags,
+		AuthorDescription: AuthorDescription,
+		Schemas:           ServiceSchemas,
+		Pricing:           ServicePricing,
+		QoS:               ServiceQoS,
+		Options:           ServiceOptions,
+	}
+
+	aggregateFuncs = []string{
+		"max", "min", "avg",
+		types.AggregateFuncMedian,
+		types.AggregateFuncTrimmedMean,
+		types.AggregateFuncWeightedMedian,
+	}
+)
+
+// WeightedOperations returns all the operations from the oracle module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONMarshaler,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	gk types.GuardianSimKeeper,
+	sk types.ServiceSimKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightCreate   int
+		weightStart    int
+		weightPause    int
+		weightEdit     int
+		weightDelete   int
+		weightTransfer int
+		weightAccept   int
+		weightRespond  int
+	)
+
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgCreateFeed, &weightCreate, nil,
+		func(_ *rand.Rand) { weightCreate = DefaultWeightMsgCreateFeed },
+	)
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgStartFeed, &weightStart, nil,
+		func(_ *rand.Rand) { weightStart = DefaultWeightMsgStartFeed },
+	)
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgPauseFeed, &weightPause, nil,
+		func(_ *rand.Rand) { weightPause = DefaultWeightMsgPauseFeed },
+	)
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgEditFeed, &weightEdit, nil,
+		func(_ *rand.Rand) { weightEdit = DefaultWeightMsgEditFeed },
+	)
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgDeleteFeed, &weightDelete, nil,
+		func(_ *rand.Rand) { weightDelete = DefaultWeightMsgDeleteFeed },
+	)
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgTransferFeed, &weightTransfer, nil,
+		func(_ *rand.Rand) { weightTransfer = DefaultWeightMsgTransfer },
+	)
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgAcceptFeed, &weightAccept, nil,
+		func(_ *rand.Rand) { weightAccept = DefaultWeightMsgAccept },
+	)
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgRespondService, &weightRespond, nil,
+		func(_ *rand.Rand) { weightRespond = DefaultWeightMsgRespond },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightCreate, SimulateMsgCreateFeed(k, ak, bk, gk, sk)),
+		simulation.NewWeightedOperation(weightStart, SimulateMsgStartFeed(k, ak, bk)),
+		simulation.NewWeightedOperation(weightPause, SimulateMsgPauseFeed(k, ak, bk)),
+		simulation.NewWeightedOperation(weightEdit, SimulateMsgEditFeed(k, ak, bk)),
+		simulation.NewWeightedOperation(weightDelete, SimulateMsgDeleteFeed(k, ak, bk)),
+		simulation.NewWeightedOperation(weightTransfer, SimulateMsgTransferFeedOwner(k, ak, bk, gk)),
+		simulation.NewWeightedOperation(weightAccept, SimulateMsgAcceptFeedOwner(k, ak, bk)),
+		simulation.NewWeightedOperation(weightRespond, SimulateMsgRespondService(ak, bk, sk)),
+	}
+}
+
+// SimulateMsgCreateFeed generates a MsgCreateFeed with random values, the creator is added as a
+// profiler with the feed admin role and the providers are bound to the mock service by the txs
+func SimulateMsgCreateFeed(
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	gk types.GuardianSimKeeper,
+	sk types.ServiceSimKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		creator, _ := simtypes.RandomAcc(r, accs)
+		providers := helpers.RandomProviders(r, accs, 1)
+
+		feedName := "feed" + simtypes.RandStringOfLength(r, 10)
+		if _, found := k.GetFeed(ctx, feedName); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateFeed, "feed already exists"), nil, nil
+		}
+
+		if err := mockService.Bind(r, app, ctx, ak, bk, sk, creator, providers, chainID); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateFeed, err.Error()), nil, nil
+		}
+
+		if err := addFeedAdmin(r, app, ctx, ak, bk, gk, accs, creator, chainID); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateFeed, err.Error()), nil, nil
+		}
+
+		addresses := make([]sdk.AccAddress, len(providers))
+		for i, provider := range providers {
+			addresses[i] = provider.Address
+		}
+
+		frequency := simtypes.RandIntBetween(r, 10, 100)
+		msg := &types.MsgCreateFeed{
+			FeedName:          feedName,
+			Description:       simtypes.RandStringOfLength(r, 50),
+			AggregateFunc:     aggregateFuncs[r.Intn(len(aggregateFuncs))],
+			ValueJsonPath:     ServiceValueJsonPath,
+			LatestHistory:     uint64(simtypes.RandIntBetween(r, 1, types.MaxLatestHistory+1)),
+			Providers:         addresses,
+			ServiceName:       ServiceName,
+			Input:             FeedInput,
+			Timeout:           int64(simtypes.RandIntBetween(r, 1, frequency+1)),
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1, 10)))),
+			RepeatedFrequency: uint64(frequency),
+			ResponseThreshold: uint32(simtypes.RandIntBetween(r, 1, len(providers)+1)),
+			Precision:         uint32(r.Intn(types.MaxPrecision + 1)),
+			Creator:           creator.Address,
+		}
+		if msg.AggregateFunc == types.AggregateFuncTrimmedMean {
+			msg.TrimPercentage = uint32(simtypes.RandIntBetween(r, 1, types.MaxTrimPercentage+1))
+		}
+
+		return helpers.DeliverTx(r, app, ctx, ak, bk, creator, msg, chainID, nil)
+	}
+}
+
+// SimulateMsgStartFeed generates a MsgStartFeed for a random paused feed
+func SimulateMsgStartFeed(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		feed, creator, found := randomFeed(r, ctx, k, accs, servicetypes.PAUSED)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStartFeed, "no paused feed found"), nil, nil
+		}
+
+		msg := &types.MsgStartFeed{
+			FeedName: feed.FeedName,
+			Creator:  creator.Address,
+		}
+		return helpers.DeliverTx(r, app, ctx, ak, bk, creator, msg, chainID, nil)
+	}
+}
+
+// SimulateMsgPauseFeed generates a MsgPauseFeed for a random running feed
+func SimulateMsgPauseFeed(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		feed, creator, found := randomFeed(r, ctx, k, accs, servicetypes.RUNNING)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgPauseFeed, "no running feed found"), nil, nil
+		}
+
+		msg := &types.MsgPauseFeed{
+			FeedName: feed.FeedName,
+			Creator:  creator.Address,
+		}
+		return helpers.DeliverTx(r, app, ctx, ak, bk, creator, msg, chainID, nil)
+	}
+}
+
+// SimulateMsgEditFeed generates a MsgEditFeed with random values for a random feed
+func SimulateMsgEditFeed(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		state := servicetypes.PAUSED
+		if r.Intn(2) == 0 {
+			state = servicetypes.RUNNING
+		}
+
+		feed, creator, found := randomFeed(r, ctx, k, accs, state)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditFeed, "no feed found"), nil, nil
+		}
+
+		reqCtx, _ := k.GetRequestContext(ctx, feed.RequestContextID)
+
+		frequency := simtypes.RandIntBetween(r, 10, 100)
+		msg := &types.MsgEditFeed{
+			FeedName:           feed.FeedName,
+			Description:        simtypes.RandStringOfLength(r, 50),
+			LatestHistory:      uint64(simtypes.RandIntBetween(r, 1, types.MaxLatestHistory+1)),
+			Timeout:            int64(simtypes.RandIntBetween(r, 1, frequency+1)),
+			RepeatedFrequency:  uint64(frequency),
+			ResponseThreshold:  uint32(simtypes.RandIntBetween(r, 1, len(reqCtx.Providers)+1)),
+			DeviationThreshold: uint32(r.Intn(types.MaxDeviationThreshold + 1)),
+			Heartbeat:          uint64(r.Intn(1000)),
+			Creator:            creator.Address,
+		}
+		if r.Intn(5) == 0 {
+			msg.DeviationThreshold, msg.DisableDeviationThreshold = 0, true
+		}
+		if r.Intn(5) == 0 {
+			msg.Heartbeat, msg.DisableHeartbeat = 0, true
+		}
+		return helpers.DeliverTx(r, app, ctx, ak, bk, creator, msg, chainID, nil)
+	}
+}
+
+// SimulateMsgDeleteFeed generates a MsgDeleteFeed for a random feed by its creator
+func SimulateMsgDeleteFeed(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		feed, creator, found := randomFeedBy(r, ctx, k, accs, func(feed types.Feed) sdk.AccAddress {
+			return feed.Creator
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteFeed, "no feed found"), nil, nil
+		}
+
+		msg := &types.MsgDeleteFeed{
+			FeedName: feed.FeedName,
+			Sender:   creator.Address,
+		}
+		return helpers.DeliverTx(r, app, ctx, ak, bk, creator, msg, chainID, nil)
+	}
+}
+
+// SimulateMsgTransferFeedOwner generates a MsgTransferFeedOwner of a random feed to a random account,
+// which is added as a profiler with the feed admin role if it is not a feed admin
+func SimulateMsgTransferFeedOwner(
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	gk types.GuardianSimKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		feed, creator, found := randomFeedBy(r, ctx, k, accs, func(feed types.Feed) sdk.AccAddress {
+			return feed.Creator
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferFeedOwner, "no feed found"), nil, nil
+		}
+
+		newOwner, _ := simtypes.RandomAcc(r, accs)
+		if newOwner.Address.Equals(creator.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferFeedOwner, "the new owner is the creator"), nil, nil
+		}
+
+		if err := addFeedAdmin(r, app, ctx, ak, bk, gk, accs, newOwner, chainID); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTransferFeedOwner, err.Error()), nil, nil
+		}
+
+		msg := &types.MsgTransferFeedOwner{
+			FeedName: feed.FeedName,
+			Sender:   creator.Address,
+			NewOwner: newOwner.Address,
+		}
+		return helpers.DeliverTx(r, app, ctx, ak, bk, creator, msg, chainID, nil)
+	}
+}
+
+// SimulateMsgAcceptFeedOwner generates a MsgAcceptFeedOwner of a random feed by its pending owner
+func SimulateMsgAcceptFeedOwner(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		feed, newOwner, found := randomFeedBy(r, ctx, k, accs, func(feed types.Feed) sdk.AccAddress {
+			return feed.PendingOwner
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAcceptFeedOwner, "no transferred feed found"), nil, nil
+		}
+
+		msg := &types.MsgAcceptFeedOwner{
+			FeedName: feed.FeedName,
+			NewOwner: newOwner.Address,
+		}
+		return helpers.DeliverTx(r, app, ctx, ak, bk, newOwner, msg, chainID, nil)
+	}
+}
+
+// SimulateMsgRespondService mocks the response of a provider to a random active request
+// of the mock service, which triggers the aggregation of the feed values
+func SimulateMsgRespondService(ak types.AccountKeeper, bk types.BankKeeper, sk types.ServiceSimKeeper) simtypes.Operation {
+	return helpers.SimulateMsgRespondService(ak, bk, sk, ServiceName, func(r *rand.Rand) string {
+		return fmt.Sprintf(`{"header":{},"body":{"%s":%d.%02d}}`, ServiceValueJsonPath, r.Intn(10000), r.Intn(100))
+	})
+}
+
+// addFeedAdmin adds the account as a profiler with the feed admin role by a MsgAddProfiler of a
+// genesis profiler if it has no feed admin permission
+func addFeedAdmin(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	gk types.GuardianSimKeeper,
+	accs []simtypes.Account,
+	account simtypes.Account,
+	chainID string,
+) error {
+	if gk.HasPermission(ctx, account.Address, guardiantypes.RoleFeedAdmin) {
+		return nil
+	}
+
+	var operator simtypes.Account
+	var found bool
+	gk.IterateProfilers(ctx, func(profiler guardiantypes.Guardian) bool {
+		if profiler.AccountType == guardiantypes.Genesis {
+			operator, found = simtypes.FindAccount(accs, profiler.Address)
+		}
+		return found
+	})
+	if !found {
+		return errors.New("no genesis profiler found")
+	}
+
+	msg := guardiantypes.NewMsgAddProfiler(AuthorDescription, account.Address, operator.Address, guardiantypes.RoleFeedAdmin)
+	if err := helpers.DeliverMsg(r, app, ctx, ak, bk, operator, msg, chainID, nil); err != nil {
+		return err
+	}
+
+	// the profiler is added once the action is approved by enough profilers
+	if !gk.HasPermission(ctx, account.Address, guardiantypes.RoleFeedAdmin) {
+		return fmt.Errorf("%s is not a feed admin", account.Address)
+	}
+	return nil
+}
+
+// randomFeed returns a random feed in the given state whose creator is a simulation account
+func randomFeed(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+	state servicetypes.RequestContextState,
+) (types.Feed, simtypes.Account, bool) {
+	var feeds []types.Feed
+	k.IteratorFeedsByState(ctx, state, func(feed types.Feed) {
+		if reqCtx, found := k.GetRequestContext(ctx, feed.RequestContextID); found && reqCtx.State == state {
+			feeds = append(feeds, feed)
+		}
+	})
+
+	if len(feeds) == 0 {
+		return types.Feed{}, simtypes.Account{}, false
+	}
+
+	feed := feeds[r.Intn(len(feeds))]
+	creator, found := simtypes.FindAccount(accs, feed.Creator)
+	return feed, creator, found
+}
+
+// randomFeedBy returns a random feed along with its account returned by account, which must be a simulation account
+func randomFeedBy(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+	account func(feed types.Feed) sdk.AccAddress,
+) (types.Feed, simtypes.Account, bool) {
+	var feeds []types.Feed
+	var feedAccs []simtypes.Account
+	k.IteratorFeeds(ctx, func(feed types.Feed) {
+		if acc, found := simtypes.FindAccount(accs, account(feed)); found {
+			feeds = append(feeds, feed)
+			feedAccs = append(feedAccs, acc)
+		}
+	})
+
+	if len(feeds) == 0 {
+		return types.Feed{}, simtypes.Account{}, false
+	}
+
+	i := r.Intn(len(feeds))
+	return feeds[i], feedAccs[i], true
+}
@@ -2,27 +2,50 @@ package types
 
 import (
 	"fmt"
-	"math"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/tidwall/gjson"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+const (
+	AggregateFuncMedian         = "median"
+	AggregateFuncTrimmedMean    = "trimmed_mean"
+	AggregateFuncWeightedMedian = "weighted_median"
+
+	DefaultTrimPercentage = 10 // percentage trimmed from each end by default
+	MaxTrimPercentage     = 49 // at least one value must be left after trimming
+
+	DefaultPrecision = 8             // default number of decimal places of the feed value
+	MaxPrecision     = sdk.Precision // maximum number of decimal places of the feed value
+)
+
 var (
 	router funcRouter
 )
 
 type ArgsType = gjson.Result
 type funcRouter map[string]Aggregate
-type Aggregate func(args []ArgsType) string
+type Aggregate func(args []ArgsType) sdk.Dec
 
 func init() {
 	router = make(funcRouter)
 	_ = RegisterAggregateFunc("max", Max)
 	_ = RegisterAggregateFunc("min", Min)
 	_ = RegisterAggregateFunc("avg", Avg)
+	_ = RegisterAggregateFunc(AggregateFuncMedian, Median)
+	_ = RegisterAggregateFunc(AggregateFuncTrimmedMean, func(data []ArgsType) sdk.Dec {
+		return TrimmedMean(data, DefaultTrimPercentage)
+	})
+	// the provider weights are only known to the keeper, which aggregates weighted_median
+	// by WeightedMedian; without the weights it falls back to the plain median
+	_ = RegisterAggregateFunc(AggregateFuncWeightedMedian, func(data []ArgsType) sdk.Dec {
+		return WeightedMedian(data, nil)
+	})
 }
 
 func GetAggregateFunc(methodNm string) (Aggregate, error) {
@@ -42,33 +65,184 @@ func RegisterAggregateFunc(methodNm string, fun Aggregate) error {
 	return nil
 }
 
-func Max(data []ArgsType) string {
-	var maxNumber = math.SmallestNonzeroFloat64
-	for _, d := range data {
-		f := d.Float()
-		if maxNumber < f {
-			maxNumber = f
+func Max(data []ArgsType) sdk.Dec {
+	values := toDecs(data)
+	if len(values) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	maxNumber := values[0]
+	for _, d := range values[1:] {
+		if maxNumber.LT(d) {
+			maxNumber = d
 		}
 	}
-	return strconv.FormatFloat(maxNumber, 'f', 8, 64)
+	return maxNumber
 }
 
-func Min(data []ArgsType) string {
-	var minNum = math.MaxFloat64
-	for _, d := range data {
-		f := d.Float()
-		if minNum > d.Float() {
-			minNum = f
+func Min(data []ArgsType) sdk.Dec {
+	values := toDecs(data)
+	if len(values) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	minNum := values[0]
+	for _, d := range values[1:] {
+		if minNum.GT(d) {
+			minNum = d
+		}
+	}
+	return minNum
+}
+
+func Avg(data []ArgsType) sdk.Dec {
+	return mean(toDecs(data))
+}
+
+func Median(data []ArgsType) sdk.Dec {
+	values := sortedDecs(data)
+	if len(values) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return values[mid-1].Add(values[mid]).QuoInt64(2)
+	}
+	return values[mid]
+}
+
+// TrimmedMean discards trimPercentage percent of the values from each end
+// of the sorted data and returns the mean of the remaining values
+func TrimmedMean(data []ArgsType, trimPercentage uint32) sdk.Dec {
+	values := sortedDecs(data)
+	if len(values) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	trim := len(values) * int(trimPercentage) / 100
+	if 2*trim >= len(values) {
+		trim = (len(values) - 1) / 2
+	}
+	return mean(values[trim : len(values)-trim])
+}
+
+// WeightedMedian returns the value at which the cumulative weight of the
+// sorted data reaches half of the total weight. weights[i] is the weight
+// of data[i]; it falls back to Median if no weight is positive
+func WeightedMedian(data []ArgsType, weights []sdk.Int) sdk.Dec {
+	if len(data) != len(weights) {
+		return Median(data)
+	}
+
+	type weighted struct {
+		value  sdk.Dec
+		weight sdk.Int
+	}
+
+	total := sdk.ZeroInt()
+	items := make([]weighted, 0, len(data))
+	for i, d := range data {
+		weight := weights[i]
+		if weight.IsNil() || !weight.IsPositive() {
+			continue
+		}
+		value, ok := ParseDec(d)
+		if !ok {
+			continue
+		}
+		total = total.Add(weight)
+		items = append(items, weighted{value: value, weight: weight})
+	}
+	if len(items) == 0 {
+		return Median(data)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].value.LT(items[j].value)
+	})
+
+	cumulative := sdk.ZeroInt()
+	for i, item := range items {
+		cumulative = cumulative.Add(item.weight)
+		half := cumulative.MulRaw(2)
+		if half.Equal(total) && i+1 < len(items) {
+			return item.value.Add(items[i+1].value).QuoInt64(2)
+		}
+		if half.GTE(total) {
+			return item.value
 		}
 	}
-	return strconv.FormatFloat(minNum, 'f', 8, 64)
+	return items[len(items)-1].value
 }
 
-func Avg(data []ArgsType) string {
-	var total = 0.0
+// ParseDec converts a service response value into sdk.Dec. Fractional digits
+// beyond sdk.Precision are truncated; non-numeric values are reported as not ok
+func ParseDec(arg ArgsType) (sdk.Dec, bool) {
+	var str string
+	switch arg.Type {
+	case gjson.Number:
+		str = arg.Raw
+		if len(str) == 0 || strings.ContainsAny(str, "eE") {
+			str = strconv.FormatFloat(arg.Num, 'f', -1, 64)
+		}
+	case gjson.String:
+		str = strings.TrimSpace(arg.Str)
+	default:
+		return sdk.Dec{}, false
+	}
+
+	if i := strings.IndexByte(str, '.'); i >= 0 && len(str)-i-1 > sdk.Precision {
+		str = str[:i+1+sdk.Precision]
+	}
+
+	value, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return sdk.Dec{}, false
+	}
+	return value, true
+}
+
+// FormatValue rounds the value half to even to the given number of decimal places
+func FormatValue(value sdk.Dec, precision uint32) string {
+	if precision > MaxPrecision {
+		precision = MaxPrecision
+	}
+
+	scale := sdk.NewDecWithPrec(1, int64(precision))
+	rounded := sdk.NewDecFromIntWithPrec(value.Quo(scale).RoundInt(), int64(precision)).String()
+	if precision == 0 {
+		return rounded[:strings.IndexByte(rounded, '.')]
+	}
+	return rounded[:strings.IndexByte(rounded, '.')+1+int(precision)]
+}
+
+func toDecs(data []ArgsType) []sdk.Dec {
+	values := make([]sdk.Dec, 0, len(data))
 	for _, d := range data {
-		f := d.Float()
-		total += f
+		if value, ok := ParseDec(d); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func sortedDecs(data []ArgsType) []sdk.Dec {
+	values := toDecs(data)
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].LT(values[j])
+	})
+	return values
+}
+
+func mean(values []sdk.Dec) sdk.Dec {
+	if len(values) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	total := sdk.ZeroDec()
+	for _, d := range values {
+		total = total.Add(d)
 	}
-	return strconv.FormatFloat(total/float64(len(data)), 'f', 8, 64)
+	return total.QuoInt64(int64(len(values)))
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestMax(t *testing.T) {
@@ -16,7 +18,7 @@ func TestMax(t *testing.T) {
 		NumArgsType(7.0),
 	}
 	max, _ := GetAggregateFunc("max")
-	result := max(data)
+	result := FormatValue(max(data), 8)
 	require.Equal(t, "7.00000000", result)
 }
 
@@ -30,7 +32,7 @@ func TestMin(t *testing.T) {
 		NumArgsType(7.0),
 	}
 	min, _ := GetAggregateFunc("min")
-	result := min(data)
+	result := FormatValue(min(data), 8)
 	require.Equal(t, "-1.00000000", result)
 }
 
@@ -48,8 +50,102 @@ func TestAvg(t *testing.T) {
 		StringArgsType("10"),
 	}
 	avg, _ := GetAggregateFunc("avg")
-	result := avg(data)
+	result := FormatValue(avg(data), 8)
+	require.Equal(t, "5.50000000", result)
+}
+
+func TestMedian(t *testing.T) {
+	var data = []ArgsType{
+		StringArgsType("3"),
+		StringArgsType("1"),
+		StringArgsType("2"),
+		NumArgsType(7.0),
+		StringArgsType("100"),
+	}
+	median, _ := GetAggregateFunc("median")
+	result := FormatValue(median(data), 8)
+	require.Equal(t, "3.00000000", result)
+
+	result = FormatValue(median(data[:4]), 8)
+	require.Equal(t, "2.50000000", result)
+}
+
+func TestTrimmedMean(t *testing.T) {
+	var data = []ArgsType{
+		StringArgsType("1"),
+		StringArgsType("2"),
+		StringArgsType("3"),
+		StringArgsType("4"),
+		StringArgsType("5"),
+		StringArgsType("6"),
+		StringArgsType("7"),
+		StringArgsType("8"),
+		StringArgsType("9"),
+		StringArgsType("100"),
+	}
+	result := FormatValue(TrimmedMean(data, 10), 8)
+	require.Equal(t, "5.50000000", result)
+
+	result = FormatValue(TrimmedMean(data, 20), 8)
 	require.Equal(t, "5.50000000", result)
+
+	result = FormatValue(TrimmedMean(data, 49), 8)
+	require.Equal(t, "5.50000000", result)
+
+	result = FormatValue(TrimmedMean(data[:3], 10), 8)
+	require.Equal(t, "2.00000000", result)
+}
+
+func TestWeightedMedian(t *testing.T) {
+	var data = []ArgsType{
+		StringArgsType("1"),
+		StringArgsType("2"),
+		StringArgsType("3"),
+		StringArgsType("4"),
+	}
+
+	result := FormatValue(WeightedMedian(data, []sdk.Int{sdk.NewInt(1), sdk.NewInt(1), sdk.NewInt(1), sdk.NewInt(10)}), 8)
+	require.Equal(t, "4.00000000", result)
+
+	result = FormatValue(WeightedMedian(data, []sdk.Int{sdk.NewInt(1), sdk.NewInt(1), sdk.NewInt(1), sdk.NewInt(1)}), 8)
+	require.Equal(t, "2.50000000", result)
+
+	result = FormatValue(WeightedMedian(data, []sdk.Int{sdk.NewInt(5), sdk.NewInt(1), sdk.NewInt(1), sdk.ZeroInt()}), 8)
+	require.Equal(t, "1.00000000", result)
+
+	// falls back to median if no weight is positive
+	result = FormatValue(WeightedMedian(data, []sdk.Int{sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()}), 8)
+	require.Equal(t, "2.50000000", result)
+}
+
+func TestAggregatePrecision(t *testing.T) {
+	var data = []ArgsType{
+		StringArgsType("1.000000000000000001"),
+		StringArgsType("1.000000000000000003"),
+	}
+	avg, _ := GetAggregateFunc("avg")
+	require.Equal(t, "1.000000000000000002", FormatValue(avg(data), 18))
+
+	max, _ := GetAggregateFunc("max")
+	require.Equal(t, "1.000000000000000003", FormatValue(max(data), 18))
+
+	// digits beyond sdk.Precision are truncated
+	data = []ArgsType{gjson.Parse("0.1234567890123456789")}
+	require.Equal(t, "0.123456789012345678", FormatValue(max(data), 18))
+
+	// non-numeric values are ignored
+	data = []ArgsType{StringArgsType("abc"), NumArgsType(2.5), NumArgsType(1e2)}
+	require.Equal(t, "51.25000000", FormatValue(avg(data), 8))
+}
+
+func TestFormatValue(t *testing.T) {
+	value := sdk.MustNewDecFromStr("1234.56785")
+	require.Equal(t, "1235", FormatValue(value, 0))
+	require.Equal(t, "1234.6", FormatValue(value, 1))
+	require.Equal(t, "1234.5678", FormatValue(value, 4))
+	require.Equal(t, "1234.56785000", FormatValue(value, 8))
+	require.Equal(t, "1234.567850000000000000", FormatValue(value, 18))
+	require.Equal(t, "-1234.57", FormatValue(value.Neg(), 2))
 }
 
 func StringArgsType(v string) ArgsType {
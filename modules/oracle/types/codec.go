@@ -14,6 +14,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgStartFeed{}, "irishub/oracle/MsgStartFeed", nil)
 	cdc.RegisterConcrete(&MsgPauseFeed{}, "irishub/oracle/MsgPauseFeed", nil)
 	cdc.RegisterConcrete(&MsgEditFeed{}, "irishub/oracle/MsgEditFeed", nil)
+	cdc.RegisterConcrete(&MsgDeleteFeed{}, "irishub/oracle/MsgDeleteFeed", nil)
+	cdc.RegisterConcrete(&MsgTransferFeedOwner{}, "irishub/oracle/MsgTransferFeedOwner", nil)
+	cdc.RegisterConcrete(&MsgAcceptFeedOwner{}, "irishub/oracle/MsgAcceptFeedOwner", nil)
 
 	cdc.RegisterConcrete(&Feed{}, "irishub/oracle/Feed", nil)
 	cdc.RegisterConcrete(&FeedContext{}, "irishub/oracle/FeedContext", nil)
@@ -25,6 +28,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgStartFeed{},
 		&MsgPauseFeed{},
 		&MsgEditFeed{},
+		&MsgDeleteFeed{},
+		&MsgTransferFeedOwner{},
+		&MsgAcceptFeedOwner{},
 	)
 }
 
@@ -13,7 +13,7 @@ var (
 	ErrInvalidServiceName   = sdkerrors.Register(ModuleName, 6, "invalid service name")
 	ErrInvalidDescription   = sdkerrors.Register(ModuleName, 7, "invalid description")
 	ErrNotRegisterFunc      = sdkerrors.Register(ModuleName, 8, "method don't register")
-	ErrNotProfiler          = sdkerrors.Register(ModuleName, 9, "not a profiler address")
+	ErrNotProfiler          = sdkerrors.Register(ModuleName, 9, "not a feed admin")
 	ErrInvalidFeedState     = sdkerrors.Register(ModuleName, 10, "invalid state feed")
 	ErrInvalidServiceFeeCap = sdkerrors.Register(ModuleName, 11, "service fee cap is invalid")
 )
@@ -2,9 +2,17 @@ package types
 
 // guardian module event types
 const (
-	EventTypeSetFeed = "set_feed"
+	EventTypeSetFeed           = "set_feed"
+	EventTypeDeleteFeed        = "delete_feed"
+	EventTypeTransferFeedOwner = "transfer_feed_owner"
+	EventTypeAcceptFeedOwner   = "accept_feed_owner"
 
-	AttributeValueCategory = ModuleName
-	AttributeKeyFeedName   = "feed_name"
-	AttributeKeyFeedValue  = "feed_value"
+	AttributeValueCategory       = ModuleName
+	AttributeKeyFeedName         = "feed_name"
+	AttributeKeyFeedValue        = "feed_value"
+	AttributeKeyFeed             = "feed"
+	AttributeKeyRequestContextID = "request_context_id"
+	AttributeKeyValueCount       = "value_count"
+	AttributeKeyOldOwner         = "old_owner"
+	AttributeKeyNewOwner         = "new_owner"
 )
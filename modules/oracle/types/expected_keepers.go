@@ -4,8 +4,10 @@ import (
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	service "github.com/irismod/service/exported"
+	servicetypes "github.com/irismod/service/types"
 
 	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 )
@@ -24,6 +26,12 @@ type ServiceKeeper interface {
 		ctx sdk.Context, requestContextID tmbytes.HexBytes,
 	) (service.RequestContext, bool)
 
+	SetRequestContext(
+		ctx sdk.Context,
+		requestContextID tmbytes.HexBytes,
+		requestContext service.RequestContext,
+	)
+
 	CreateRequestContext(
 		ctx sdk.Context,
 		serviceName string,
@@ -64,11 +72,75 @@ type ServiceKeeper interface {
 		requestContextID tmbytes.HexBytes,
 		consumer sdk.AccAddress,
 	) error
+
+	KillRequestContext(
+		ctx sdk.Context,
+		requestContextID tmbytes.HexBytes,
+		consumer sdk.AccAddress,
+	) error
+
+	RequestsIteratorByReqCtx(
+		ctx sdk.Context,
+		requestContextID tmbytes.HexBytes,
+		batchCounter uint64,
+	) sdk.Iterator
+
+	ResponsesIteratorByReqCtx(
+		ctx sdk.Context,
+		requestContextID tmbytes.HexBytes,
+		batchCounter uint64,
+	) sdk.Iterator
+
+	GetServiceBinding(
+		ctx sdk.Context,
+		serviceName string,
+		provider sdk.AccAddress,
+	) (servicetypes.ServiceBinding, bool)
 }
 
 // GuardianKeeper defines the expected guardian keeper (noalias)
 type GuardianKeeper interface {
-	GetProfiler(ctx sdk.Context, addr sdk.AccAddress) (guardian guardiantypes.Guardian, found bool)
+	HasPermission(ctx sdk.Context, addr sdk.AccAddress, perm string) bool
+}
+
+// GuardianSimKeeper defines the expected guardian keeper used for simulations to find the
+// genesis profilers which add the feed admins (noalias)
+type GuardianSimKeeper interface {
+	GuardianKeeper
+
+	IterateProfilers(ctx sdk.Context, op func(profiler guardiantypes.Guardian) (stop bool))
+}
+
+// ServiceSimKeeper defines the expected service keeper used for simulations to find the
+// service definition, bindings and requests of the feeds (noalias)
+type ServiceSimKeeper interface {
+	ServiceKeeper
+
+	GetServiceDefinition(
+		ctx sdk.Context,
+		serviceName string,
+	) (servicetypes.ServiceDefinition, bool)
+
+	GetMinDeposit(ctx sdk.Context, pricing servicetypes.Pricing) (sdk.Coins, error)
+
+	ActiveRequestsIterator(ctx sdk.Context, serviceName string, provider sdk.AccAddress) sdk.Iterator
+
+	GetRequest(ctx sdk.Context, requestID tmbytes.HexBytes) (servicetypes.Request, bool)
+}
+
+// AccountKeeper defines the expected account keeper used for simulations (noalias)
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// BankKeeper defines the expected bank keeper used for simulations (noalias)
+type BankKeeper interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// OracleHooks event hooks for the feeds of the oracle module (noalias)
+type OracleHooks interface {
+	AfterFeedValueSet(ctx sdk.Context, feedName string, value FeedValue) // Must be called when a new feed value is saved
 }
 
 var (
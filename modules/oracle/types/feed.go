@@ -3,8 +3,122 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Fields returns the value fields of the feed, a feed defined by a single
+// value json path is treated as one unnamed field
+func (f Feed) Fields() []ValueField {
+	if len(f.ValueFields) > 0 {
+		return f.ValueFields
+	}
+	return []ValueField{{
+		ValueJsonPath: f.ValueJsonPath,
+		AggregateFunc: f.AggregateFunc,
+	}}
+}
+
+// NewFeedValue builds the feed value from the aggregated results of the value fields
+func (f Feed) NewFeedValue(results []sdk.Dec, timestamp time.Time) FeedValue {
+	value := FeedValue{Timestamp: timestamp}
+	if len(f.ValueFields) == 0 {
+		value.Data = FormatValue(results[0], f.Precision)
+		return value
+	}
+	for i, field := range f.ValueFields {
+		value.Fields = append(value.Fields, FieldValue{
+			Name: field.Name,
+			Data: FormatValue(results[i], f.Precision),
+		})
+	}
+	return value
+}
+
+// IsTriggered returns true if the values should be saved, i.e. any of them deviates from the
+// latest value by more than DeviationThreshold basis points or Heartbeat seconds
+// have elapsed since the latest value. A feed without any trigger is always updated
+func (f Feed) IsTriggered(latest FeedValue, values []sdk.Dec, blockTime time.Time) bool {
+	if f.DeviationThreshold == 0 && f.Heartbeat == 0 {
+		return true
+	}
+
+	if f.Heartbeat > 0 && !blockTime.Before(latest.Timestamp.Add(time.Duration(f.Heartbeat)*time.Second)) {
+		return true
+	}
+
+	if f.DeviationThreshold > 0 {
+		latestData := latest.Values()
+		if len(latestData) != len(values) {
+			return true
+		}
+		for i, value := range values {
+			if f.isDeviated(latestData[i], value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (f Feed) isDeviated(latestData string, value sdk.Dec) bool {
+	latestValue, err := sdk.NewDecFromStr(latestData)
+	if err != nil {
+		return true
+	}
+	if latestValue.IsZero() {
+		return !value.IsZero()
+	}
+
+	// |value - latest| / |latest| > threshold / 10000
+	deviation := value.Sub(latestValue).Abs().MulInt64(MaxDeviationThreshold)
+	return deviation.GT(latestValue.Abs().MulInt64(int64(f.DeviationThreshold)))
+}
+
+// Values returns the data of the value fields, or the single data of the value
+func (v FeedValue) Values() []string {
+	if len(v.Fields) == 0 {
+		return []string{v.Data}
+	}
+	values := make([]string, len(v.Fields))
+	for i, field := range v.Fields {
+		values[i] = field.Data
+	}
+	return values
+}
+
+// FeedValueFilter defines the time and batch ranges used to filter the feed values,
+// a nil time or zero batch counter means the range is unbounded on that side
+type FeedValueFilter struct {
+	FromTime  *time.Time
+	ToTime    *time.Time
+	FromBatch uint64
+	ToBatch   uint64
+}
+
+// Validate checks that the ranges of the filter are well formed
+func (f FeedValueFilter) Validate() error {
+	if f.FromTime != nil && f.ToTime != nil && f.FromTime.After(*f.ToTime) {
+		return fmt.Errorf("from time %s is after to time %s", f.FromTime, f.ToTime)
+	}
+	if f.ToBatch > 0 && f.FromBatch > f.ToBatch {
+		return fmt.Errorf("from batch %d is greater than to batch %d", f.FromBatch, f.ToBatch)
+	}
+	return nil
+}
+
+// MatchTime returns true if the timestamp of the value falls in the time range [FromTime, ToTime]
+func (f FeedValueFilter) MatchTime(value FeedValue) bool {
+	if f.FromTime != nil && value.Timestamp.Before(*f.FromTime) {
+		return false
+	}
+	if f.ToTime != nil && value.Timestamp.After(*f.ToTime) {
+		return false
+	}
+	return true
+}
+
 type FeedValues []FeedValue
 
 // String implements fmt.Stringer
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeed_IsTriggered(t *testing.T) {
+	now := time.Now().UTC()
+	latest := FeedValue{
+		Data:      "100.00000000",
+		Timestamp: now,
+	}
+
+	tests := []struct {
+		testCase  string
+		feed      Feed
+		value     string
+		blockTime time.Time
+		triggered bool
+	}{
+		{"no trigger", Feed{}, "100", now, true},
+		{"deviation below threshold", Feed{DeviationThreshold: 100}, "100.5", now, false},
+		{"deviation equals threshold", Feed{DeviationThreshold: 100}, "99", now, false},
+		{"deviation above threshold", Feed{DeviationThreshold: 100}, "101.01", now, true},
+		{"negative deviation above threshold", Feed{DeviationThreshold: 100}, "98.9", now, true},
+		{"heartbeat not elapsed", Feed{Heartbeat: 60}, "200", now.Add(59 * time.Second), false},
+		{"heartbeat elapsed", Feed{Heartbeat: 60}, "100", now.Add(60 * time.Second), true},
+		{"heartbeat elapsed below threshold", Feed{DeviationThreshold: 100, Heartbeat: 60}, "100", now.Add(time.Minute), true},
+		{"deviation above threshold before heartbeat", Feed{DeviationThreshold: 100, Heartbeat: 60}, "110", now, true},
+	}
+
+	for _, tc := range tests {
+		value := sdk.MustNewDecFromStr(tc.value)
+		require.Equal(t, tc.triggered, tc.feed.IsTriggered(latest, []sdk.Dec{value}, tc.blockTime), tc.testCase)
+	}
+}
+
+func TestFeedValueFilter(t *testing.T) {
+	now := time.Now().UTC()
+	before := now.Add(-time.Minute)
+	after := now.Add(time.Minute)
+
+	tests := []struct {
+		testCase string
+		filter   FeedValueFilter
+		valid    bool
+		match    bool
+	}{
+		{"empty filter", FeedValueFilter{}, true, true},
+		{"time in range", FeedValueFilter{FromTime: &before, ToTime: &after}, true, true},
+		{"time equals bounds", FeedValueFilter{FromTime: &now, ToTime: &now}, true, true},
+		{"time before range", FeedValueFilter{FromTime: &after}, true, false},
+		{"time after range", FeedValueFilter{ToTime: &before}, true, false},
+		{"invalid time range", FeedValueFilter{FromTime: &after, ToTime: &before}, false, false},
+		{"valid batch range", FeedValueFilter{FromBatch: 1, ToBatch: 1}, true, true},
+		{"open batch range", FeedValueFilter{FromBatch: 10}, true, true},
+		{"invalid batch range", FeedValueFilter{FromBatch: 2, ToBatch: 1}, false, true},
+	}
+
+	value := FeedValue{Data: "100", Timestamp: now}
+	for _, tc := range tests {
+		if tc.valid {
+			require.NoError(t, tc.filter.Validate(), tc.testCase)
+			require.Equal(t, tc.match, tc.filter.MatchTime(value), tc.testCase)
+		} else {
+			require.Error(t, tc.filter.Validate(), tc.testCase)
+		}
+	}
+}
+
+func TestFeed_ValueFields(t *testing.T) {
+	now := time.Now().UTC()
+	feed := Feed{
+		Precision:          2,
+		DeviationThreshold: 100,
+		ValueFields: []ValueField{
+			{Name: "bid", ValueJsonPath: "bid", AggregateFunc: AggregateFuncMedian},
+			{Name: "ask", ValueJsonPath: "ask", AggregateFunc: AggregateFuncMedian},
+		},
+	}
+	require.Equal(t, feed.ValueFields, feed.Fields())
+
+	latest := feed.NewFeedValue([]sdk.Dec{sdk.NewDec(100), sdk.NewDec(101)}, now)
+	require.Empty(t, latest.Data)
+	require.Equal(t, []FieldValue{{Name: "bid", Data: "100.00"}, {Name: "ask", Data: "101.00"}}, latest.Fields)
+	require.Equal(t, []string{"100.00", "101.00"}, latest.Values())
+
+	// a single field deviation triggers the feed
+	require.False(t, feed.IsTriggered(latest, []sdk.Dec{sdk.NewDec(100), sdk.NewDec(102)}, now))
+	require.True(t, feed.IsTriggered(latest, []sdk.Dec{sdk.NewDec(100), sdk.NewDec(103)}, now))
+
+	single := Feed{Precision: 2, ValueJsonPath: "price", AggregateFunc: AggregateFuncMedian}
+	require.Equal(t, []ValueField{{ValueJsonPath: "price", AggregateFunc: AggregateFuncMedian}}, single.Fields())
+
+	value := single.NewFeedValue([]sdk.Dec{sdk.NewDec(100)}, now)
+	require.Equal(t, "100.00", value.Data)
+	require.Empty(t, value.Fields)
+	require.Equal(t, []string{"100.00"}, value.Values())
+}
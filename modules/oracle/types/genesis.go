@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // get raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -18,10 +22,16 @@ func ValidateGenesis(data GenesisState) error {
 		if err := ValidateDescription(feed.Description); err != nil {
 			return err
 		}
-		if err := ValidateAggregateFunc(feed.AggregateFunc); err != nil {
+		if err := ValidateValueFields(feed.AggregateFunc, feed.ValueJsonPath, feed.ValueFields, feed.TrimPercentage); err != nil {
+			return err
+		}
+		if err := ValidatePrecision(feed.Precision); err != nil {
 			return err
 		}
-		if err := ValidateValueJsonPath(feed.ValueJsonPath); err != nil {
+		if err := ValidateDeviationThreshold(feed.DeviationThreshold); err != nil {
+			return err
+		}
+		if err := ValidateHeartbeat(feed.Heartbeat); err != nil {
 			return err
 		}
 		if err := ValidateLatestHistory(feed.LatestHistory); err != nil {
@@ -30,6 +40,11 @@ func ValidateGenesis(data GenesisState) error {
 		if err := ValidateCreator(feed.Creator); err != nil {
 			return err
 		}
+		for _, stats := range entry.ProviderStats {
+			if len(stats.Provider) == 0 {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "provider missing")
+			}
+		}
 	}
 	return nil
 }
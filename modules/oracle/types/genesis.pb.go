@@ -70,9 +70,10 @@ func (m *GenesisState) GetEntries() []FeedEntry {
 }
 
 type FeedEntry struct {
-	Feed   Feed                      `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed"`
-	State  types.RequestContextState `protobuf:"varint,2,opt,name=state,proto3,enum=irismod.service.RequestContextState" json:"state,omitempty"`
-	Values []FeedValue               `protobuf:"bytes,3,rep,name=values,proto3" json:"values"`
+	Feed          Feed                      `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed"`
+	State         types.RequestContextState `protobuf:"varint,2,opt,name=state,proto3,enum=irismod.service.RequestContextState" json:"state,omitempty"`
+	Values        []FeedValue               `protobuf:"bytes,3,rep,name=values,proto3" json:"values"`
+	ProviderStats []ProviderStats           `protobuf:"bytes,4,rep,name=provider_stats,json=providerStats,proto3" json:"provider_stats" yaml:"provider_stats"`
 }
 
 func (m *FeedEntry) Reset()         { *m = FeedEntry{} }
@@ -129,6 +130,13 @@ func (m *FeedEntry) GetValues() []FeedValue {
 	return nil
 }
 
+func (m *FeedEntry) GetProviderStats() []ProviderStats {
+	if m != nil {
+		return m.ProviderStats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "irishub.oracle.GenesisState")
 	proto.RegisterType((*FeedEntry)(nil), "irishub.oracle.FeedEntry")
@@ -137,27 +145,29 @@ func init() {
 func init() { proto.RegisterFile("oracle/genesis.proto", fileDescriptor_ce0b3a2b4a184fc3) }
 
 var fileDescriptor_ce0b3a2b4a184fc3 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0xfb, 0x30,
-	0x10, 0xc6, 0xe3, 0x7f, 0xfb, 0x2f, 0xc2, 0x45, 0x1d, 0x42, 0x91, 0x42, 0x07, 0x53, 0x55, 0x0c,
-	0x9d, 0x6c, 0xa9, 0x0c, 0x08, 0xc6, 0x22, 0x40, 0x5d, 0x83, 0xc4, 0xc0, 0x96, 0x26, 0x47, 0xb0,
-	0x94, 0xc4, 0xc5, 0x76, 0x2a, 0xfa, 0x16, 0x3c, 0x0a, 0x8f, 0xd1, 0xb1, 0x23, 0x13, 0x42, 0xc9,
-	0x8b, 0x20, 0xc7, 0x0e, 0x12, 0x12, 0x4c, 0x3e, 0xdd, 0xfd, 0xfc, 0x7d, 0xdf, 0x1d, 0x1e, 0x0a,
-	0x19, 0xc5, 0x19, 0xb0, 0x14, 0x0a, 0x50, 0x5c, 0xd1, 0x95, 0x14, 0x5a, 0xf8, 0x03, 0x2e, 0xb9,
-	0x7a, 0x2a, 0x97, 0xd4, 0x4e, 0x47, 0x87, 0x8e, 0xb2, 0x8f, 0x85, 0x46, 0x47, 0x06, 0xca, 0x45,
-	0xc2, 0x14, 0xc8, 0x35, 0x8f, 0xdb, 0xf6, 0x30, 0x15, 0xa9, 0x68, 0x4a, 0x66, 0x2a, 0xdb, 0x9d,
-	0x2c, 0xf0, 0xc1, 0xad, 0xb5, 0xb8, 0xd3, 0x91, 0x06, 0xff, 0x02, 0xef, 0x41, 0xa1, 0x25, 0x07,
-	0x15, 0xa0, 0x71, 0x67, 0xda, 0x9f, 0x1d, 0xd3, 0x9f, 0x9e, 0xf4, 0x06, 0x20, 0xb9, 0x2e, 0xb4,
-	0xdc, 0xcc, 0xbb, 0xdb, 0x8f, 0x13, 0x2f, 0x6c, 0xf9, 0xc9, 0x1b, 0xc2, 0xfb, 0xdf, 0x43, 0x9f,
-	0xe2, 0xee, 0x23, 0x40, 0x12, 0xa0, 0x31, 0x9a, 0xf6, 0x67, 0xc3, 0xdf, 0x54, 0x9c, 0x40, 0xc3,
-	0xf9, 0x97, 0xf8, 0xbf, 0x32, 0x09, 0x82, 0x7f, 0x63, 0x34, 0x1d, 0xcc, 0x4e, 0xa9, 0xdb, 0x82,
-	0xb6, 0x5b, 0x84, 0xf0, 0x5c, 0x82, 0xd2, 0x57, 0xa2, 0xd0, 0xf0, 0xa2, 0x9b, 0xb4, 0xa1, 0xfd,
-	0xe2, 0x9f, 0xe3, 0xde, 0x3a, 0xca, 0x4a, 0x50, 0x41, 0xe7, 0xef, 0xcc, 0xf7, 0x86, 0x70, 0x96,
-	0x0e, 0x9f, 0x2f, 0xb6, 0x15, 0x41, 0xbb, 0x8a, 0xa0, 0xcf, 0x8a, 0xa0, 0xd7, 0x9a, 0x78, 0xbb,
-	0x9a, 0x78, 0xef, 0x35, 0xf1, 0x1e, 0x58, 0xca, 0xb5, 0x11, 0x88, 0x45, 0xce, 0x8c, 0x58, 0x01,
-	0x9a, 0x39, 0x51, 0x96, 0x8b, 0xa4, 0xcc, 0x40, 0xb9, 0xab, 0x33, 0xbd, 0x59, 0x81, 0x5a, 0xf6,
-	0x9a, 0x7b, 0x9e, 0x7d, 0x05, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xca, 0x68, 0x8c, 0xb9, 0x01, 0x00,
-	0x00,
+	// 346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4f, 0xc2, 0x40,
+	0x14, 0xc7, 0x5b, 0x40, 0x8c, 0x87, 0x32, 0x54, 0x48, 0x2a, 0x09, 0x85, 0x34, 0x0e, 0x4c, 0x77,
+	0x09, 0x0e, 0x46, 0x46, 0x8c, 0x1a, 0x36, 0x53, 0x13, 0x07, 0x17, 0x53, 0xda, 0x67, 0xbd, 0xa4,
+	0xed, 0xd5, 0xbb, 0x2b, 0x91, 0x6f, 0xe1, 0xe2, 0x77, 0x62, 0x64, 0x74, 0x22, 0x06, 0xbe, 0x81,
+	0x9f, 0xc0, 0x5c, 0x7b, 0x98, 0x60, 0x74, 0xea, 0xe5, 0xbd, 0x5f, 0xff, 0xbf, 0xf7, 0xf2, 0x50,
+	0x8b, 0x71, 0x3f, 0x88, 0x81, 0x44, 0x90, 0x82, 0xa0, 0x02, 0x67, 0x9c, 0x49, 0x66, 0x35, 0x29,
+	0xa7, 0xe2, 0x39, 0x9f, 0xe2, 0xb2, 0xdb, 0x39, 0xd6, 0x54, 0xf9, 0x29, 0xa1, 0x4e, 0x5b, 0x41,
+	0x09, 0x0b, 0x89, 0x00, 0x3e, 0xa3, 0xc1, 0xb6, 0xdc, 0x8a, 0x58, 0xc4, 0x8a, 0x27, 0x51, 0xaf,
+	0xb2, 0xea, 0x4e, 0xd0, 0xe1, 0x4d, 0xa9, 0xb8, 0x93, 0xbe, 0x04, 0xeb, 0x02, 0xed, 0x43, 0x2a,
+	0x39, 0x05, 0x61, 0x9b, 0xfd, 0xea, 0xa0, 0x31, 0x3c, 0xc1, 0xbb, 0x4e, 0x7c, 0x0d, 0x10, 0x5e,
+	0xa5, 0x92, 0xcf, 0xc7, 0xb5, 0xc5, 0xaa, 0x67, 0x78, 0x5b, 0xde, 0x7d, 0xaf, 0xa0, 0x83, 0x9f,
+	0xa6, 0x85, 0x51, 0xed, 0x09, 0x20, 0xb4, 0xcd, 0xbe, 0x39, 0x68, 0x0c, 0x5b, 0x7f, 0xa5, 0xe8,
+	0x80, 0x82, 0xb3, 0x46, 0x68, 0x4f, 0xa8, 0x09, 0xec, 0x4a, 0xdf, 0x1c, 0x34, 0x87, 0xa7, 0x58,
+	0x6f, 0x81, 0xb7, 0x5b, 0x78, 0xf0, 0x92, 0x83, 0x90, 0x97, 0x2c, 0x95, 0xf0, 0x2a, 0x8b, 0x69,
+	0xbd, 0xf2, 0x17, 0xeb, 0x1c, 0xd5, 0x67, 0x7e, 0x9c, 0x83, 0xb0, 0xab, 0xff, 0xcf, 0x7c, 0xaf,
+	0x08, 0xad, 0xd4, 0xb8, 0x15, 0xa0, 0x66, 0xc6, 0xd9, 0x8c, 0x86, 0xc0, 0x1f, 0x55, 0x94, 0xb0,
+	0x6b, 0x45, 0x40, 0xf7, 0x77, 0xc0, 0xad, 0xa6, 0x94, 0x56, 0x8c, 0xbb, 0x2a, 0xe4, 0x6b, 0xd5,
+	0x6b, 0xcf, 0xfd, 0x24, 0x1e, 0xb9, 0xbb, 0x11, 0xae, 0x77, 0x94, 0xed, 0xd0, 0x93, 0xc5, 0xda,
+	0x31, 0x97, 0x6b, 0xc7, 0xfc, 0x5c, 0x3b, 0xe6, 0xdb, 0xc6, 0x31, 0x96, 0x1b, 0xc7, 0xf8, 0xd8,
+	0x38, 0xc6, 0x03, 0x89, 0xa8, 0x54, 0x92, 0x80, 0x25, 0x44, 0x09, 0x53, 0x90, 0x44, 0x8b, 0x49,
+	0xc2, 0xc2, 0x3c, 0x06, 0xa1, 0x4f, 0x4b, 0xe4, 0x3c, 0x03, 0x31, 0xad, 0x17, 0x47, 0x3b, 0xfb,
+	0x1e, 0x00, 0x15, 0x47, 0x70, 0xcb, 0x1e, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -217,6 +227,20 @@ func (m *FeedEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProviderStats) > 0 {
+		for iNdEx := len(m.ProviderStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Values) > 0 {
 		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -292,6 +316,12 @@ func (m *FeedEntry) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ProviderStats) > 0 {
+		for _, e := range m.ProviderStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -503,6 +533,40 @@ func (m *FeedEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderStats = append(m.ProviderStats, ProviderStats{})
+			if err := m.ProviderStats[len(m.ProviderStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple oracle hooks, all hook functions are run in array sequence
+type MultiOracleHooks []OracleHooks
+
+func NewMultiOracleHooks(hooks ...OracleHooks) MultiOracleHooks {
+	return hooks
+}
+
+func (h MultiOracleHooks) AfterFeedValueSet(ctx sdk.Context, feedName string, value FeedValue) {
+	for i := range h {
+		h[i].AfterFeedValueSet(ctx, feedName, value)
+	}
+}
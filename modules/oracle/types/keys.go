@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/binary"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	servicetypes "github.com/irismod/service/types"
 )
 
@@ -29,6 +31,8 @@ var (
 	PrefixFeedValueKey        = []byte{0x03}
 	PrefixFeedRunningStateKey = []byte{0x04}
 	PrefixFeedPauseStateKey   = []byte{0x05}
+	PrefixProviderResponseKey = []byte{0x06}
+	PrefixProviderStatsKey    = []byte{0x07}
 )
 
 func GetFeedKey(feedName string) []byte {
@@ -63,3 +67,25 @@ func GetFeedStatePrefixKey(state servicetypes.RequestContextState) []byte {
 	}
 	return PrefixFeedPauseStateKey
 }
+
+func GetProviderResponseKey(feedName string, batchCounter uint64, provider sdk.AccAddress) []byte {
+	return append(GetProviderResponseBatchKey(feedName, batchCounter), provider.Bytes()...)
+}
+
+func GetProviderResponseBatchKey(feedName string, batchCounter uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key[:], batchCounter)
+	return append(GetProviderResponsePrefixKey(feedName), key...)
+}
+
+func GetProviderResponsePrefixKey(feedName string) []byte {
+	return append(append(PrefixProviderResponseKey, []byte(feedName)...), separator...)
+}
+
+func GetProviderStatsKey(feedName string, provider sdk.AccAddress) []byte {
+	return append(GetProviderStatsPrefixKey(feedName), provider.Bytes()...)
+}
+
+func GetProviderStatsPrefixKey(feedName string) []byte {
+	return append(append(PrefixProviderStatsKey, []byte(feedName)...), separator...)
+}
@@ -11,14 +11,21 @@ import (
 const (
 	MaxLatestHistory    = 100
 	MaxNameLen          = 70
-	MaxAggregateFuncLen = 10
+	MaxAggregateFuncLen = 20
 	MaxValueJsonPath    = 70
+	MaxValueFields      = 10
 	MaxDescriptionLen   = 200
 
-	TypeMsgCreateFeed = "create_feed" // type for MsgCreateFeed
-	TypeMsgStartFeed  = "start_feed"  // type for MsgStartFeed
-	TypeMsgPauseFeed  = "pause_feed"  // type for MsgPauseFeed
-	TypeMsgEditFeed   = "edit_feed"   // type for MsgEditFeed
+	MaxDeviationThreshold = 10000              // deviation threshold is measured in basis points
+	MaxHeartbeat          = 365 * 24 * 60 * 60 // heartbeat is measured in seconds and at most a year
+
+	TypeMsgCreateFeed        = "create_feed"         // type for MsgCreateFeed
+	TypeMsgStartFeed         = "start_feed"          // type for MsgStartFeed
+	TypeMsgPauseFeed         = "pause_feed"          // type for MsgPauseFeed
+	TypeMsgEditFeed          = "edit_feed"           // type for MsgEditFeed
+	TypeMsgDeleteFeed        = "delete_feed"         // type for MsgDeleteFeed
+	TypeMsgTransferFeedOwner = "transfer_feed_owner" // type for MsgTransferFeedOwner
+	TypeMsgAcceptFeedOwner   = "accept_feed_owner"   // type for MsgAcceptFeedOwner
 
 	DoNotModify = "do-not-modify"
 )
@@ -28,6 +35,9 @@ var (
 	_ sdk.Msg = &MsgStartFeed{}
 	_ sdk.Msg = &MsgPauseFeed{}
 	_ sdk.Msg = &MsgEditFeed{}
+	_ sdk.Msg = &MsgDeleteFeed{}
+	_ sdk.Msg = &MsgTransferFeedOwner{}
+	_ sdk.Msg = &MsgAcceptFeedOwner{}
 
 	// the feed/service name only accepts alphanumeric characters, _ and -
 	regPlainText = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
@@ -71,11 +81,19 @@ func (msg MsgCreateFeed) ValidateBasic() error {
 
 	}
 
-	if err := ValidateAggregateFunc(msg.AggregateFunc); err != nil {
+	if err := ValidateValueFields(msg.AggregateFunc, msg.ValueJsonPath, msg.ValueFields, msg.TrimPercentage); err != nil {
+		return err
+	}
+
+	if err := ValidatePrecision(msg.Precision); err != nil {
+		return err
+	}
+
+	if err := ValidateDeviationThreshold(msg.DeviationThreshold); err != nil {
 		return err
 	}
 
-	if err := ValidateValueJsonPath(msg.ValueJsonPath); err != nil {
+	if err := ValidateHeartbeat(msg.Heartbeat); err != nil {
 		return err
 	}
 
@@ -207,6 +225,20 @@ func (msg MsgEditFeed) ValidateBasic() error {
 		}
 	}
 
+	if err := ValidateDeviationThreshold(msg.DeviationThreshold); err != nil {
+		return err
+	}
+	if msg.DisableDeviationThreshold && msg.DeviationThreshold != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "deviation threshold can not be set while it is disabled")
+	}
+
+	if err := ValidateHeartbeat(msg.Heartbeat); err != nil {
+		return err
+	}
+	if msg.DisableHeartbeat && msg.Heartbeat != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "heartbeat can not be set while it is disabled")
+	}
+
 	return ValidateCreator(msg.Creator)
 }
 
@@ -226,6 +258,99 @@ func (msg MsgEditFeed) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Creator}
 }
 
+//______________________________________________________________________
+
+// Route implements Msg.
+func (msg MsgDeleteFeed) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (msg MsgDeleteFeed) Type() string {
+	return TypeMsgDeleteFeed
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgDeleteFeed) ValidateBasic() error {
+	if len(msg.Sender) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "sender missing")
+	}
+	return ValidateFeedName(msg.FeedName)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgDeleteFeed) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgDeleteFeed) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+//______________________________________________________________________
+
+// Route implements Msg.
+func (msg MsgTransferFeedOwner) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (msg MsgTransferFeedOwner) Type() string {
+	return TypeMsgTransferFeedOwner
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgTransferFeedOwner) ValidateBasic() error {
+	if len(msg.Sender) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "sender missing")
+	}
+	if len(msg.NewOwner) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "new owner missing")
+	}
+	return ValidateFeedName(msg.FeedName)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgTransferFeedOwner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgTransferFeedOwner) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+//______________________________________________________________________
+
+// Route implements Msg.
+func (msg MsgAcceptFeedOwner) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (msg MsgAcceptFeedOwner) Type() string {
+	return TypeMsgAcceptFeedOwner
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgAcceptFeedOwner) ValidateBasic() error {
+	if len(msg.NewOwner) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "new owner missing")
+	}
+	return ValidateFeedName(msg.FeedName)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgAcceptFeedOwner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgAcceptFeedOwner) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.NewOwner}
+}
+
 func ValidateFeedName(feedName string) error {
 	feedName = strings.TrimSpace(feedName)
 	if len(feedName) == 0 || len(feedName) > MaxNameLen {
@@ -256,6 +381,40 @@ func ValidateAggregateFunc(aggregateFunc string) error {
 	return nil
 }
 
+func ValidateTrimPercentage(aggregateFunc string, trimPercentage uint32) error {
+	if strings.TrimSpace(aggregateFunc) != AggregateFuncTrimmedMean {
+		if trimPercentage != 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "trim percentage is only applicable to %s", AggregateFuncTrimmedMean)
+		}
+		return nil
+	}
+	if trimPercentage < 1 || trimPercentage > MaxTrimPercentage {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "trim percentage is invalid, should be between 1 and %d", MaxTrimPercentage)
+	}
+	return nil
+}
+
+func ValidatePrecision(precision uint32) error {
+	if precision > MaxPrecision {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "precision is invalid, should be between 0 and %d", MaxPrecision)
+	}
+	return nil
+}
+
+func ValidateDeviationThreshold(deviationThreshold uint32) error {
+	if deviationThreshold > MaxDeviationThreshold {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "deviation threshold is invalid, should be between 0 and %d", MaxDeviationThreshold)
+	}
+	return nil
+}
+
+func ValidateHeartbeat(heartbeat uint64) error {
+	if heartbeat > MaxHeartbeat {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "heartbeat is invalid, should be between 0 and %d", MaxHeartbeat)
+	}
+	return nil
+}
+
 func ValidateValueJsonPath(valueJsonPath string) error {
 	valueJsonPath = strings.TrimSpace(valueJsonPath)
 	if len(valueJsonPath) == 0 || len(valueJsonPath) > MaxValueJsonPath {
@@ -264,6 +423,50 @@ func ValidateValueJsonPath(valueJsonPath string) error {
 	return nil
 }
 
+// ValidateValueFields validates the values retrieved by a feed, which are either a single value
+// defined by aggregateFunc and valueJsonPath, or a list of named value fields
+func ValidateValueFields(aggregateFunc, valueJsonPath string, valueFields []ValueField, trimPercentage uint32) error {
+	if len(valueFields) == 0 {
+		if err := ValidateAggregateFunc(aggregateFunc); err != nil {
+			return err
+		}
+		if err := ValidateValueJsonPath(valueJsonPath); err != nil {
+			return err
+		}
+		return ValidateTrimPercentage(aggregateFunc, trimPercentage)
+	}
+
+	if len(strings.TrimSpace(aggregateFunc)) > 0 || len(strings.TrimSpace(valueJsonPath)) > 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "aggregate func and value json path must be empty when value fields are specified")
+	}
+	if len(valueFields) > MaxValueFields {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the number of value fields must be no more than %d, got: %d", MaxValueFields, len(valueFields))
+	}
+
+	trimFunc := ""
+	names := make(map[string]bool, len(valueFields))
+	for _, field := range valueFields {
+		if len(field.Name) == 0 || len(field.Name) > MaxNameLen || !regPlainText.MatchString(field.Name) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid value field name: %s", field.Name)
+		}
+		if names[field.Name] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate value field name: %s", field.Name)
+		}
+		names[field.Name] = true
+
+		if err := ValidateAggregateFunc(field.AggregateFunc); err != nil {
+			return err
+		}
+		if err := ValidateValueJsonPath(field.ValueJsonPath); err != nil {
+			return err
+		}
+		if strings.TrimSpace(field.AggregateFunc) == AggregateFuncTrimmedMean {
+			trimFunc = AggregateFuncTrimmedMean
+		}
+	}
+	return ValidateTrimPercentage(trimFunc, trimPercentage)
+}
+
 func ValidateLatestHistory(latestHistory uint64) error {
 	if latestHistory < 1 || latestHistory > MaxLatestHistory {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "latest history is invalid, should be between 1 and %d", MaxLatestHistory)
@@ -91,6 +91,260 @@ func TestMsgCreateFeed_ValidateBasic(t *testing.T) {
 			Creator:           addr1,
 		},
 		false,
+	}, {
+		"good AggregateFunc,trimmed_mean",
+		MsgCreateFeed{
+			FeedName:          "feedEthPrice",
+			AggregateFunc:     "trimmed_mean",
+			TrimPercentage:    20,
+			ValueJsonPath:     "data.price",
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		true,
+	}, {
+		"wrong TrimPercentage,out of range",
+		MsgCreateFeed{
+			FeedName:          "feedEthPrice",
+			AggregateFunc:     "trimmed_mean",
+			TrimPercentage:    50,
+			ValueJsonPath:     "data.price",
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		false,
+	}, {
+		"wrong TrimPercentage,not applicable",
+		MsgCreateFeed{
+			FeedName:          "feedEthPrice",
+			AggregateFunc:     "median",
+			TrimPercentage:    10,
+			ValueJsonPath:     "data.price",
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		false,
+	}, {
+		"good ValueFields",
+		MsgCreateFeed{
+			FeedName: "feedEthPrice",
+			ValueFields: []ValueField{
+				{Name: "bid", ValueJsonPath: "data.bid", AggregateFunc: "median"},
+				{Name: "ask", ValueJsonPath: "data.ask", AggregateFunc: "median"},
+				{Name: "volume", ValueJsonPath: "data.volume", AggregateFunc: "avg"},
+			},
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		true,
+	}, {
+		"good ValueFields,trimmed_mean",
+		MsgCreateFeed{
+			FeedName:       "feedEthPrice",
+			TrimPercentage: 10,
+			ValueFields: []ValueField{
+				{Name: "bid", ValueJsonPath: "data.bid", AggregateFunc: "trimmed_mean"},
+				{Name: "ask", ValueJsonPath: "data.ask", AggregateFunc: "median"},
+			},
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		true,
+	}, {
+		"wrong ValueFields,missing TrimPercentage",
+		MsgCreateFeed{
+			FeedName: "feedEthPrice",
+			ValueFields: []ValueField{
+				{Name: "bid", ValueJsonPath: "data.bid", AggregateFunc: "trimmed_mean"},
+			},
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		false,
+	}, {
+		"wrong ValueFields,duplicate name",
+		MsgCreateFeed{
+			FeedName: "feedEthPrice",
+			ValueFields: []ValueField{
+				{Name: "bid", ValueJsonPath: "data.bid", AggregateFunc: "median"},
+				{Name: "bid", ValueJsonPath: "data.ask", AggregateFunc: "median"},
+			},
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		false,
+	}, {
+		"wrong ValueFields,invalid name",
+		MsgCreateFeed{
+			FeedName: "feedEthPrice",
+			ValueFields: []ValueField{
+				{Name: "$bid", ValueJsonPath: "data.bid", AggregateFunc: "median"},
+			},
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		false,
+	}, {
+		"wrong ValueFields,invalid AggregateFunc",
+		MsgCreateFeed{
+			FeedName: "feedEthPrice",
+			ValueFields: []ValueField{
+				{Name: "bid", ValueJsonPath: "data.bid", AggregateFunc: "unknown"},
+			},
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		false,
+	}, {
+		"wrong ValueFields,with ValueJsonPath",
+		MsgCreateFeed{
+			FeedName:      "feedEthPrice",
+			AggregateFunc: "avg",
+			ValueJsonPath: "data.price",
+			ValueFields: []ValueField{
+				{Name: "bid", ValueJsonPath: "data.bid", AggregateFunc: "median"},
+			},
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		false,
+	}, {
+		"wrong Precision",
+		MsgCreateFeed{
+			FeedName:          "feedEthPrice",
+			AggregateFunc:     "avg",
+			Precision:         19,
+			ValueJsonPath:     "data.price",
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		false,
+	}, {
+		"wrong DeviationThreshold",
+		MsgCreateFeed{
+			FeedName:           "feedEthPrice",
+			AggregateFunc:      "avg",
+			DeviationThreshold: 10001,
+			ValueJsonPath:      "data.price",
+			LatestHistory:      10,
+			Description:        "feed eth price",
+			ServiceName:        "GetEthPrice",
+			Providers:          []sdk.AccAddress{addr1, addr2},
+			Input:              "eth",
+			Timeout:            5,
+			ServiceFeeCap:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency:  5,
+			ResponseThreshold:  1,
+			Creator:            addr1,
+		},
+		false,
+	}, {
+		"wrong Heartbeat",
+		MsgCreateFeed{
+			FeedName:          "feedEthPrice",
+			AggregateFunc:     "avg",
+			Heartbeat:         MaxHeartbeat + 1,
+			ValueJsonPath:     "data.price",
+			LatestHistory:     10,
+			Description:       "feed eth price",
+			ServiceName:       "GetEthPrice",
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			Input:             "eth",
+			Timeout:           5,
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Creator:           addr1,
+		},
+		false,
 	}, {
 		"wrong ValueJsonPath",
 		MsgCreateFeed{
@@ -367,6 +621,74 @@ func TestMsgEditFeed_ValidateBasic(t *testing.T) {
 			Creator:           addr1,
 		},
 		true,
+	}, {
+		"disabled DeviationThreshold and Heartbeat",
+		MsgEditFeed{
+			FeedName:                  "feedEthPrice",
+			LatestHistory:             10,
+			Providers:                 []sdk.AccAddress{addr1, addr2},
+			ServiceFeeCap:             sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency:         5,
+			ResponseThreshold:         1,
+			DisableDeviationThreshold: true,
+			DisableHeartbeat:          true,
+			Creator:                   addr1,
+		},
+		true,
+	}, {
+		"DeviationThreshold set while disabled",
+		MsgEditFeed{
+			FeedName:                  "feedEthPrice",
+			LatestHistory:             10,
+			Providers:                 []sdk.AccAddress{addr1, addr2},
+			ServiceFeeCap:             sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency:         5,
+			ResponseThreshold:         1,
+			DeviationThreshold:        100,
+			DisableDeviationThreshold: true,
+			Creator:                   addr1,
+		},
+		false,
+	}, {
+		"Heartbeat set while disabled",
+		MsgEditFeed{
+			FeedName:          "feedEthPrice",
+			LatestHistory:     10,
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Heartbeat:         60,
+			DisableHeartbeat:  true,
+			Creator:           addr1,
+		},
+		false,
+	}, {
+		"wrong Heartbeat",
+		MsgEditFeed{
+			FeedName:          "feedEthPrice",
+			LatestHistory:     10,
+			Providers:         []sdk.AccAddress{addr1, addr2},
+			ServiceFeeCap:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency: 5,
+			ResponseThreshold: 1,
+			Heartbeat:         MaxHeartbeat + 1,
+			Creator:           addr1,
+		},
+		false,
+	}, {
+		"wrong DeviationThreshold",
+		MsgEditFeed{
+			FeedName:           "feedEthPrice",
+			LatestHistory:      10,
+			Providers:          []sdk.AccAddress{addr1, addr2},
+			ServiceFeeCap:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			RepeatedFrequency:  5,
+			ResponseThreshold:  1,
+			DeviationThreshold: 10001,
+			Creator:            addr1,
+		},
+		false,
 	}, {
 		"wrong FeedName, invalid char",
 		MsgEditFeed{
@@ -438,3 +760,125 @@ func TestMsgEditFeed_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgDeleteFeed_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		testCase string
+		MsgDeleteFeed
+		expectPass bool
+	}{{
+		"basic good",
+		MsgDeleteFeed{
+			FeedName: "feedEthPrice",
+			Sender:   addr1,
+		},
+		true,
+	}, {
+		"wrong FeedName,invalid char",
+		MsgDeleteFeed{
+			FeedName: "$feedEthPrice",
+			Sender:   addr1,
+		},
+		false,
+	}, {
+		"empty Sender",
+		MsgDeleteFeed{
+			FeedName: "feedEthPrice",
+			Sender:   emptyAddr,
+		},
+		false,
+	}}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.Nil(t, tc.MsgDeleteFeed.ValidateBasic(), "test: %v", tc.testCase)
+		} else {
+			require.NotNil(t, tc.MsgDeleteFeed.ValidateBasic(), "test: %v", tc.testCase)
+		}
+	}
+}
+
+func TestMsgTransferFeedOwner_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		testCase string
+		MsgTransferFeedOwner
+		expectPass bool
+	}{{
+		"basic good",
+		MsgTransferFeedOwner{
+			FeedName: "feedEthPrice",
+			Sender:   addr1,
+			NewOwner: addr2,
+		},
+		true,
+	}, {
+		"wrong FeedName,invalid char",
+		MsgTransferFeedOwner{
+			FeedName: "$feedEthPrice",
+			Sender:   addr1,
+			NewOwner: addr2,
+		},
+		false,
+	}, {
+		"empty Sender",
+		MsgTransferFeedOwner{
+			FeedName: "feedEthPrice",
+			Sender:   emptyAddr,
+			NewOwner: addr2,
+		},
+		false,
+	}, {
+		"empty NewOwner",
+		MsgTransferFeedOwner{
+			FeedName: "feedEthPrice",
+			Sender:   addr1,
+			NewOwner: emptyAddr,
+		},
+		false,
+	}}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.Nil(t, tc.MsgTransferFeedOwner.ValidateBasic(), "test: %v", tc.testCase)
+		} else {
+			require.NotNil(t, tc.MsgTransferFeedOwner.ValidateBasic(), "test: %v", tc.testCase)
+		}
+	}
+}
+
+func TestMsgAcceptFeedOwner_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		testCase string
+		MsgAcceptFeedOwner
+		expectPass bool
+	}{{
+		"basic good",
+		MsgAcceptFeedOwner{
+			FeedName: "feedEthPrice",
+			NewOwner: addr2,
+		},
+		true,
+	}, {
+		"wrong FeedName,invalid char",
+		MsgAcceptFeedOwner{
+			FeedName: "$feedEthPrice",
+			NewOwner: addr2,
+		},
+		false,
+	}, {
+		"empty NewOwner",
+		MsgAcceptFeedOwner{
+			FeedName: "feedEthPrice",
+			NewOwner: emptyAddr,
+		},
+		false,
+	}}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.Nil(t, tc.MsgAcceptFeedOwner.ValidateBasic(), "test: %v", tc.testCase)
+		} else {
+			require.NotNil(t, tc.MsgAcceptFeedOwner.ValidateBasic(), "test: %v", tc.testCase)
+		}
+	}
+}
@@ -33,19 +33,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgCreateFeed defines an sdk.Msg type that supports creating a feed
 type MsgCreateFeed struct {
-	FeedName          string                                          `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
-	LatestHistory     uint64                                          `protobuf:"varint,2,opt,name=latest_history,json=latestHistory,proto3" json:"latest_history,omitempty" yaml:"latest_history"`
-	Description       string                                          `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Creator           github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,4,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
-	ServiceName       string                                          `protobuf:"bytes,5,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty" yaml:"service_name"`
-	Providers         []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,rep,name=providers,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"providers,omitempty"`
-	Input             string                                          `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
-	Timeout           int64                                           `protobuf:"varint,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	ServiceFeeCap     github_com_cosmos_cosmos_sdk_types.Coins        `protobuf:"bytes,9,rep,name=service_fee_cap,json=serviceFeeCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"service_fee_cap" yaml:"service_fee_cap"`
-	RepeatedFrequency uint64                                          `protobuf:"varint,10,opt,name=repeated_frequency,json=repeatedFrequency,proto3" json:"repeated_frequency,omitempty" yaml:"repeated_frequency"`
-	AggregateFunc     string                                          `protobuf:"bytes,11,opt,name=aggregate_func,json=aggregateFunc,proto3" json:"aggregate_func,omitempty" yaml:"aggregate_func"`
-	ValueJsonPath     string                                          `protobuf:"bytes,12,opt,name=value_json_path,json=valueJsonPath,proto3" json:"value_json_path,omitempty" yaml:"value_json_path"`
-	ResponseThreshold uint32                                          `protobuf:"varint,13,opt,name=response_threshold,json=responseThreshold,proto3" json:"response_threshold,omitempty" yaml:"response_threshold"`
+	FeedName           string                                          `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
+	LatestHistory      uint64                                          `protobuf:"varint,2,opt,name=latest_history,json=latestHistory,proto3" json:"latest_history,omitempty" yaml:"latest_history"`
+	Description        string                                          `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Creator            github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,4,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
+	ServiceName        string                                          `protobuf:"bytes,5,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty" yaml:"service_name"`
+	Providers          []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,rep,name=providers,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"providers,omitempty"`
+	Input              string                                          `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Timeout            int64                                           `protobuf:"varint,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	ServiceFeeCap      github_com_cosmos_cosmos_sdk_types.Coins        `protobuf:"bytes,9,rep,name=service_fee_cap,json=serviceFeeCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"service_fee_cap" yaml:"service_fee_cap"`
+	RepeatedFrequency  uint64                                          `protobuf:"varint,10,opt,name=repeated_frequency,json=repeatedFrequency,proto3" json:"repeated_frequency,omitempty" yaml:"repeated_frequency"`
+	AggregateFunc      string                                          `protobuf:"bytes,11,opt,name=aggregate_func,json=aggregateFunc,proto3" json:"aggregate_func,omitempty" yaml:"aggregate_func"`
+	ValueJsonPath      string                                          `protobuf:"bytes,12,opt,name=value_json_path,json=valueJsonPath,proto3" json:"value_json_path,omitempty" yaml:"value_json_path"`
+	ResponseThreshold  uint32                                          `protobuf:"varint,13,opt,name=response_threshold,json=responseThreshold,proto3" json:"response_threshold,omitempty" yaml:"response_threshold"`
+	TrimPercentage     uint32                                          `protobuf:"varint,14,opt,name=trim_percentage,json=trimPercentage,proto3" json:"trim_percentage,omitempty" yaml:"trim_percentage"`
+	Precision          uint32                                          `protobuf:"varint,15,opt,name=precision,proto3" json:"precision,omitempty"`
+	DeviationThreshold uint32                                          `protobuf:"varint,16,opt,name=deviation_threshold,json=deviationThreshold,proto3" json:"deviation_threshold,omitempty" yaml:"deviation_threshold"`
+	Heartbeat          uint64                                          `protobuf:"varint,17,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	ValueFields        []ValueField                                    `protobuf:"bytes,18,rep,name=value_fields,json=valueFields,proto3" json:"value_fields" yaml:"value_fields"`
 }
 
 func (m *MsgCreateFeed) Reset()         { *m = MsgCreateFeed{} }
@@ -172,6 +177,41 @@ func (m *MsgCreateFeed) GetResponseThreshold() uint32 {
 	return 0
 }
 
+func (m *MsgCreateFeed) GetTrimPercentage() uint32 {
+	if m != nil {
+		return m.TrimPercentage
+	}
+	return 0
+}
+
+func (m *MsgCreateFeed) GetPrecision() uint32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *MsgCreateFeed) GetDeviationThreshold() uint32 {
+	if m != nil {
+		return m.DeviationThreshold
+	}
+	return 0
+}
+
+func (m *MsgCreateFeed) GetHeartbeat() uint64 {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return 0
+}
+
+func (m *MsgCreateFeed) GetValueFields() []ValueField {
+	if m != nil {
+		return m.ValueFields
+	}
+	return nil
+}
+
 // MsgPauseFeed defines an sdk.Msg type that supports stating a feed
 type MsgStartFeed struct {
 	FeedName string                                        `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
@@ -280,15 +320,21 @@ func (m *MsgPauseFeed) GetCreator() github_com_cosmos_cosmos_sdk_types.AccAddres
 
 // MsgEditFeed defines an sdk.Msg type that supports editing a feed
 type MsgEditFeed struct {
-	FeedName          string                                          `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
-	Description       string                                          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	LatestHistory     uint64                                          `protobuf:"varint,3,opt,name=latest_history,json=latestHistory,proto3" json:"latest_history,omitempty" yaml:"latest_history"`
-	Providers         []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=providers,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"providers,omitempty"`
-	Timeout           int64                                           `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	ServiceFeeCap     github_com_cosmos_cosmos_sdk_types.Coins        `protobuf:"bytes,6,rep,name=service_fee_cap,json=serviceFeeCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"service_fee_cap" yaml:"service_fee_cap"`
-	RepeatedFrequency uint64                                          `protobuf:"varint,7,opt,name=repeated_frequency,json=repeatedFrequency,proto3" json:"repeated_frequency,omitempty" yaml:"repeated_frequency"`
-	ResponseThreshold uint32                                          `protobuf:"varint,8,opt,name=response_threshold,json=responseThreshold,proto3" json:"response_threshold,omitempty" yaml:"response_threshold"`
-	Creator           github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,9,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
+	FeedName           string                                          `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
+	Description        string                                          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	LatestHistory      uint64                                          `protobuf:"varint,3,opt,name=latest_history,json=latestHistory,proto3" json:"latest_history,omitempty" yaml:"latest_history"`
+	Providers          []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=providers,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"providers,omitempty"`
+	Timeout            int64                                           `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	ServiceFeeCap      github_com_cosmos_cosmos_sdk_types.Coins        `protobuf:"bytes,6,rep,name=service_fee_cap,json=serviceFeeCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"service_fee_cap" yaml:"service_fee_cap"`
+	RepeatedFrequency  uint64                                          `protobuf:"varint,7,opt,name=repeated_frequency,json=repeatedFrequency,proto3" json:"repeated_frequency,omitempty" yaml:"repeated_frequency"`
+	ResponseThreshold  uint32                                          `protobuf:"varint,8,opt,name=response_threshold,json=responseThreshold,proto3" json:"response_threshold,omitempty" yaml:"response_threshold"`
+	Creator            github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,9,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
+	DeviationThreshold uint32                                          `protobuf:"varint,10,opt,name=deviation_threshold,json=deviationThreshold,proto3" json:"deviation_threshold,omitempty" yaml:"deviation_threshold"`
+	Heartbeat          uint64                                          `protobuf:"varint,11,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	// switches off the deviation threshold if true, which must not be set along with it
+	DisableDeviationThreshold bool `protobuf:"varint,12,opt,name=disable_deviation_threshold,json=disableDeviationThreshold,proto3" json:"disable_deviation_threshold,omitempty" yaml:"disable_deviation_threshold"`
+	// switches off the heartbeat if true, which must not be set along with it
+	DisableHeartbeat bool `protobuf:"varint,13,opt,name=disable_heartbeat,json=disableHeartbeat,proto3" json:"disable_heartbeat,omitempty" yaml:"disable_heartbeat"`
 }
 
 func (m *MsgEditFeed) Reset()         { *m = MsgEditFeed{} }
@@ -387,22 +433,223 @@ func (m *MsgEditFeed) GetCreator() github_com_cosmos_cosmos_sdk_types.AccAddress
 	return nil
 }
 
+func (m *MsgEditFeed) GetDeviationThreshold() uint32 {
+	if m != nil {
+		return m.DeviationThreshold
+	}
+	return 0
+}
+
+func (m *MsgEditFeed) GetHeartbeat() uint64 {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return 0
+}
+
+func (m *MsgEditFeed) GetDisableDeviationThreshold() bool {
+	if m != nil {
+		return m.DisableDeviationThreshold
+	}
+	return false
+}
+
+func (m *MsgEditFeed) GetDisableHeartbeat() bool {
+	if m != nil {
+		return m.DisableHeartbeat
+	}
+	return false
+}
+
+// MsgDeleteFeed defines an sdk.Msg type that supports deleting a feed
+type MsgDeleteFeed struct {
+	FeedName string                                        `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
+	Sender   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+}
+
+func (m *MsgDeleteFeed) Reset()         { *m = MsgDeleteFeed{} }
+func (m *MsgDeleteFeed) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteFeed) ProtoMessage()    {}
+func (*MsgDeleteFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{4}
+}
+func (m *MsgDeleteFeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteFeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteFeed.Merge(m, src)
+}
+func (m *MsgDeleteFeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteFeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteFeed proto.InternalMessageInfo
+
+func (m *MsgDeleteFeed) GetFeedName() string {
+	if m != nil {
+		return m.FeedName
+	}
+	return ""
+}
+
+func (m *MsgDeleteFeed) GetSender() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+// MsgTransferFeedOwner defines an sdk.Msg type that supports transferring the ownership of a feed
+type MsgTransferFeedOwner struct {
+	FeedName string                                        `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
+	Sender   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	NewOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"new_owner,omitempty" yaml:"new_owner"`
+}
+
+func (m *MsgTransferFeedOwner) Reset()         { *m = MsgTransferFeedOwner{} }
+func (m *MsgTransferFeedOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferFeedOwner) ProtoMessage()    {}
+func (*MsgTransferFeedOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{5}
+}
+func (m *MsgTransferFeedOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferFeedOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferFeedOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferFeedOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferFeedOwner.Merge(m, src)
+}
+func (m *MsgTransferFeedOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferFeedOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferFeedOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferFeedOwner proto.InternalMessageInfo
+
+func (m *MsgTransferFeedOwner) GetFeedName() string {
+	if m != nil {
+		return m.FeedName
+	}
+	return ""
+}
+
+func (m *MsgTransferFeedOwner) GetSender() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+func (m *MsgTransferFeedOwner) GetNewOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NewOwner
+	}
+	return nil
+}
+
+// MsgAcceptFeedOwner defines an sdk.Msg type that accepts the ownership of a feed transferred to the new owner
+type MsgAcceptFeedOwner struct {
+	FeedName string                                        `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
+	NewOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"new_owner,omitempty" yaml:"new_owner"`
+}
+
+func (m *MsgAcceptFeedOwner) Reset()         { *m = MsgAcceptFeedOwner{} }
+func (m *MsgAcceptFeedOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptFeedOwner) ProtoMessage()    {}
+func (*MsgAcceptFeedOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{6}
+}
+func (m *MsgAcceptFeedOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptFeedOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptFeedOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptFeedOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptFeedOwner.Merge(m, src)
+}
+func (m *MsgAcceptFeedOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptFeedOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptFeedOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptFeedOwner proto.InternalMessageInfo
+
+func (m *MsgAcceptFeedOwner) GetFeedName() string {
+	if m != nil {
+		return m.FeedName
+	}
+	return ""
+}
+
+func (m *MsgAcceptFeedOwner) GetNewOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NewOwner
+	}
+	return nil
+}
+
 // Feed defines the feed standard
 type Feed struct {
-	FeedName         string                                               `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
-	Description      string                                               `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AggregateFunc    string                                               `protobuf:"bytes,3,opt,name=aggregate_func,json=aggregateFunc,proto3" json:"aggregate_func,omitempty" yaml:"aggregate_func"`
-	ValueJsonPath    string                                               `protobuf:"bytes,4,opt,name=value_json_path,json=valueJsonPath,proto3" json:"value_json_path,omitempty" yaml:"value_json_path"`
-	LatestHistory    uint64                                               `protobuf:"varint,5,opt,name=latest_history,json=latestHistory,proto3" json:"latest_history,omitempty" yaml:"latest_history"`
-	RequestContextID github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=request_context_id,json=requestContextId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"request_context_id,omitempty" yaml:"request_context_id"`
-	Creator          github_com_cosmos_cosmos_sdk_types.AccAddress        `protobuf:"bytes,7,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
+	FeedName           string                                               `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
+	Description        string                                               `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AggregateFunc      string                                               `protobuf:"bytes,3,opt,name=aggregate_func,json=aggregateFunc,proto3" json:"aggregate_func,omitempty" yaml:"aggregate_func"`
+	ValueJsonPath      string                                               `protobuf:"bytes,4,opt,name=value_json_path,json=valueJsonPath,proto3" json:"value_json_path,omitempty" yaml:"value_json_path"`
+	LatestHistory      uint64                                               `protobuf:"varint,5,opt,name=latest_history,json=latestHistory,proto3" json:"latest_history,omitempty" yaml:"latest_history"`
+	RequestContextID   github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=request_context_id,json=requestContextId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"request_context_id,omitempty" yaml:"request_context_id"`
+	Creator            github_com_cosmos_cosmos_sdk_types.AccAddress        `protobuf:"bytes,7,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
+	TrimPercentage     uint32                                               `protobuf:"varint,8,opt,name=trim_percentage,json=trimPercentage,proto3" json:"trim_percentage,omitempty" yaml:"trim_percentage"`
+	Precision          uint32                                               `protobuf:"varint,9,opt,name=precision,proto3" json:"precision,omitempty"`
+	DeviationThreshold uint32                                               `protobuf:"varint,10,opt,name=deviation_threshold,json=deviationThreshold,proto3" json:"deviation_threshold,omitempty" yaml:"deviation_threshold"`
+	Heartbeat          uint64                                               `protobuf:"varint,11,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	ValueFields        []ValueField                                         `protobuf:"bytes,12,rep,name=value_fields,json=valueFields,proto3" json:"value_fields" yaml:"value_fields"`
+	PendingOwner       github_com_cosmos_cosmos_sdk_types.AccAddress        `protobuf:"bytes,13,opt,name=pending_owner,json=pendingOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"pending_owner,omitempty" yaml:"pending_owner"`
 }
 
 func (m *Feed) Reset()         { *m = Feed{} }
 func (m *Feed) String() string { return proto.CompactTextString(m) }
 func (*Feed) ProtoMessage()    {}
 func (*Feed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{4}
+	return fileDescriptor_dc470b50b143d488, []int{7}
 }
 func (m *Feed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,17 +727,122 @@ func (m *Feed) GetCreator() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	return nil
 }
 
+func (m *Feed) GetTrimPercentage() uint32 {
+	if m != nil {
+		return m.TrimPercentage
+	}
+	return 0
+}
+
+func (m *Feed) GetPrecision() uint32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *Feed) GetDeviationThreshold() uint32 {
+	if m != nil {
+		return m.DeviationThreshold
+	}
+	return 0
+}
+
+func (m *Feed) GetHeartbeat() uint64 {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return 0
+}
+
+func (m *Feed) GetValueFields() []ValueField {
+	if m != nil {
+		return m.ValueFields
+	}
+	return nil
+}
+
+func (m *Feed) GetPendingOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return nil
+}
+
+// ValueField defines a named value retrieved from the service responses and the function to aggregate it
+type ValueField struct {
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ValueJsonPath string `protobuf:"bytes,2,opt,name=value_json_path,json=valueJsonPath,proto3" json:"value_json_path,omitempty" yaml:"value_json_path"`
+	AggregateFunc string `protobuf:"bytes,3,opt,name=aggregate_func,json=aggregateFunc,proto3" json:"aggregate_func,omitempty" yaml:"aggregate_func"`
+}
+
+func (m *ValueField) Reset()         { *m = ValueField{} }
+func (m *ValueField) String() string { return proto.CompactTextString(m) }
+func (*ValueField) ProtoMessage()    {}
+func (*ValueField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{8}
+}
+func (m *ValueField) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValueField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValueField.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValueField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueField.Merge(m, src)
+}
+func (m *ValueField) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValueField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueField proto.InternalMessageInfo
+
+func (m *ValueField) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ValueField) GetValueJsonPath() string {
+	if m != nil {
+		return m.ValueJsonPath
+	}
+	return ""
+}
+
+func (m *ValueField) GetAggregateFunc() string {
+	if m != nil {
+		return m.AggregateFunc
+	}
+	return ""
+}
+
 // FeedValue defines the feed result standard
 type FeedValue struct {
-	Data      string    `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Timestamp time.Time `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Data         string       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	BatchCounter uint64       `protobuf:"varint,2,opt,name=batch_counter,json=batchCounter,proto3" json:"batch_counter,omitempty" yaml:"batch_counter"`
+	Fields       []FieldValue `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields"`
+	Timestamp    time.Time    `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 }
 
 func (m *FeedValue) Reset()         { *m = FeedValue{} }
 func (m *FeedValue) String() string { return proto.CompactTextString(m) }
 func (*FeedValue) ProtoMessage()    {}
 func (*FeedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{5}
+	return fileDescriptor_dc470b50b143d488, []int{9}
 }
 func (m *FeedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,102 +878,395 @@ func (m *FeedValue) GetData() string {
 	return ""
 }
 
-func (m *FeedValue) GetTimestamp() time.Time {
+func (m *FeedValue) GetBatchCounter() uint64 {
 	if m != nil {
-		return m.Timestamp
+		return m.BatchCounter
 	}
-	return time.Time{}
-}
-
-func init() {
-	proto.RegisterType((*MsgCreateFeed)(nil), "irishub.oracle.MsgCreateFeed")
-	proto.RegisterType((*MsgStartFeed)(nil), "irishub.oracle.MsgStartFeed")
-	proto.RegisterType((*MsgPauseFeed)(nil), "irishub.oracle.MsgPauseFeed")
-	proto.RegisterType((*MsgEditFeed)(nil), "irishub.oracle.MsgEditFeed")
-	proto.RegisterType((*Feed)(nil), "irishub.oracle.Feed")
-	proto.RegisterType((*FeedValue)(nil), "irishub.oracle.FeedValue")
+	return 0
 }
 
-func init() { proto.RegisterFile("oracle/oracle.proto", fileDescriptor_dc470b50b143d488) }
-
-var fileDescriptor_dc470b50b143d488 = []byte{
-	// 859 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
-	0x14, 0x8f, 0xea, 0x7f, 0x31, 0x1d, 0xb7, 0x99, 0x9a, 0xad, 0x4a, 0x80, 0x59, 0x86, 0x4e, 0xbe,
-	0x54, 0x42, 0xba, 0x9d, 0x7a, 0x5a, 0x95, 0x2d, 0x68, 0xbb, 0x65, 0x2b, 0xb4, 0x62, 0x87, 0x5d,
-	0x04, 0x5a, 0x7a, 0x96, 0xb9, 0x59, 0xa2, 0x4a, 0x52, 0x41, 0xfd, 0x0d, 0x86, 0x01, 0x1b, 0x7a,
-	0xdb, 0x77, 0xd8, 0x27, 0xe9, 0xb1, 0xc7, 0x9d, 0xd4, 0xc1, 0xf9, 0x06, 0x3e, 0xf6, 0x34, 0x50,
-	0x94, 0x12, 0xd9, 0x19, 0xb0, 0xc5, 0x69, 0x81, 0x9c, 0xc4, 0xf7, 0x87, 0xe2, 0xfb, 0x91, 0xbf,
-	0xdf, 0xc3, 0x43, 0x77, 0x29, 0xc3, 0xc1, 0x0c, 0x1c, 0xf5, 0xb1, 0x53, 0x46, 0x05, 0xd5, 0x6f,
-	0x13, 0x46, 0xf8, 0x34, 0x1b, 0xdb, 0xca, 0x7b, 0xb0, 0x17, 0xd1, 0x88, 0x16, 0x21, 0x47, 0xae,
-	0x54, 0xd6, 0xc1, 0x7e, 0x40, 0x79, 0x4c, 0xb9, 0xaf, 0x02, 0xca, 0x28, 0x43, 0xf7, 0xd6, 0x42,
-	0x24, 0x29, 0x03, 0x66, 0x44, 0x69, 0x34, 0x03, 0xa7, 0xb0, 0xc6, 0xd9, 0xc4, 0x11, 0x24, 0x06,
-	0x2e, 0x70, 0x9c, 0xaa, 0x04, 0x6b, 0xd1, 0x46, 0xfd, 0x13, 0x1e, 0x1d, 0x31, 0xc0, 0x02, 0x8e,
-	0x01, 0x42, 0xfd, 0x10, 0x75, 0x27, 0x00, 0xa1, 0x9f, 0xe0, 0x18, 0x0c, 0x6d, 0xa8, 0x8d, 0xba,
-	0xee, 0xde, 0x32, 0x37, 0x77, 0xe7, 0x38, 0x9e, 0x3d, 0xb4, 0xce, 0x43, 0x96, 0xb7, 0x2d, 0xd7,
-	0xdf, 0xe2, 0x18, 0xf4, 0x2f, 0xd0, 0xed, 0x19, 0x16, 0xc0, 0x85, 0x3f, 0x25, 0x5c, 0x50, 0x36,
-	0x37, 0x6e, 0x0d, 0xb5, 0x51, 0xd3, 0xdd, 0x5f, 0xe6, 0xe6, 0xc7, 0x6a, 0xdf, 0x6a, 0xdc, 0xf2,
-	0xfa, 0xca, 0xf1, 0x58, 0xd9, 0xfa, 0x10, 0xf5, 0x42, 0xe0, 0x01, 0x23, 0xa9, 0x20, 0x34, 0x31,
-	0x1a, 0xf2, 0x58, 0xaf, 0xee, 0xd2, 0xbf, 0x46, 0x9d, 0x40, 0x16, 0x49, 0x99, 0xd1, 0x1c, 0x6a,
-	0xa3, 0x1d, 0xf7, 0xf0, 0x5d, 0x6e, 0xde, 0x8f, 0x88, 0x90, 0xf7, 0x16, 0xd0, 0xb8, 0xbc, 0x90,
-	0xf2, 0x73, 0x9f, 0x87, 0x3f, 0x3b, 0x62, 0x9e, 0x02, 0xb7, 0x1f, 0x05, 0xc1, 0xa3, 0x30, 0x64,
-	0xc0, 0xb9, 0x57, 0xfd, 0x41, 0x7f, 0x88, 0x76, 0x38, 0xb0, 0x53, 0x12, 0x80, 0x82, 0xd9, 0x2a,
-	0x60, 0xde, 0x5b, 0xe6, 0xe6, 0x5d, 0x55, 0x6e, 0x3d, 0x6a, 0x79, 0xbd, 0xd2, 0x2c, 0xc0, 0x7e,
-	0x87, 0xba, 0x29, 0xa3, 0xa7, 0x24, 0x04, 0xc6, 0x8d, 0xf6, 0xb0, 0xb1, 0x59, 0x29, 0x17, 0xff,
-	0xd0, 0xf7, 0x50, 0x8b, 0x24, 0x69, 0x26, 0x8c, 0x4e, 0x81, 0x5a, 0x19, 0xba, 0x81, 0x3a, 0xf2,
-	0xad, 0x68, 0x26, 0x8c, 0xed, 0xa1, 0x36, 0x6a, 0x78, 0x95, 0xa9, 0xff, 0xa6, 0xa1, 0x3b, 0x55,
-	0x7d, 0x13, 0x00, 0x3f, 0xc0, 0xa9, 0xd1, 0x1d, 0x36, 0x46, 0xbd, 0x07, 0xfb, 0x76, 0xc9, 0x8a,
-	0x31, 0xe6, 0x60, 0x9f, 0x1e, 0x8e, 0x41, 0xe0, 0x43, 0xfb, 0x88, 0x92, 0xc4, 0x7d, 0xfa, 0x3a,
-	0x37, 0xb7, 0x96, 0xb9, 0xf9, 0xc9, 0x2a, 0xbe, 0x72, 0xbf, 0xf5, 0xe7, 0x5b, 0x73, 0xf4, 0x3f,
-	0x00, 0xc8, 0x5f, 0x71, 0xaf, 0x5f, 0xee, 0x3e, 0x06, 0x38, 0xc2, 0xa9, 0xfe, 0x0d, 0xd2, 0x19,
-	0xa4, 0x92, 0x3f, 0xa1, 0x3f, 0x61, 0xf0, 0x22, 0x83, 0x24, 0x98, 0x1b, 0xa8, 0x60, 0xc0, 0xa7,
-	0xcb, 0xdc, 0xdc, 0x57, 0x47, 0x5e, 0xce, 0xb1, 0xbc, 0x8f, 0x2a, 0xe7, 0x71, 0xe5, 0x93, 0x5c,
-	0xc2, 0x51, 0xc4, 0x20, 0xc2, 0x02, 0xfc, 0x49, 0x96, 0x04, 0x46, 0xaf, 0x78, 0x9c, 0x1a, 0x97,
-	0x56, 0xe3, 0x96, 0xd7, 0x3f, 0x77, 0x1c, 0x67, 0x49, 0xa0, 0xbb, 0xe8, 0xce, 0x29, 0x9e, 0x65,
-	0xe0, 0xff, 0xc4, 0x69, 0xe2, 0xa7, 0x58, 0x4c, 0x8d, 0x9d, 0xe2, 0x17, 0x07, 0x17, 0xf8, 0xd7,
-	0x12, 0x2c, 0xaf, 0x5f, 0x78, 0x9e, 0x72, 0x9a, 0x3c, 0xc3, 0x62, 0xaa, 0x30, 0xf1, 0x94, 0x26,
-	0x1c, 0x7c, 0x31, 0x65, 0xc0, 0xa7, 0x74, 0x16, 0x1a, 0xfd, 0xa1, 0x36, 0xea, 0xaf, 0x62, 0x5a,
-	0xcf, 0x29, 0x30, 0x29, 0xe7, 0xf3, 0x73, 0xdf, 0xef, 0x1a, 0xda, 0x39, 0xe1, 0xd1, 0xf7, 0x02,
-	0x33, 0xb1, 0xa9, 0xc6, 0xde, 0x27, 0xff, 0xab, 0x82, 0x9e, 0xe1, 0x8c, 0xc3, 0x8d, 0x28, 0xe8,
-	0x97, 0x16, 0xea, 0x9d, 0xf0, 0xe8, 0xab, 0x90, 0x6c, 0x7c, 0x41, 0x6b, 0x2d, 0xe4, 0xd6, 0xe5,
-	0x16, 0x72, 0xb9, 0x4d, 0x35, 0xae, 0xd8, 0xa6, 0x56, 0xb4, 0xdf, 0x7c, 0x0f, 0xda, 0xaf, 0xa9,
-	0xbc, 0xf5, 0xdf, 0x2a, 0x6f, 0xdf, 0x38, 0x95, 0x77, 0x36, 0x54, 0xf9, 0xbf, 0xeb, 0x6b, 0x7b,
-	0x33, 0x7d, 0xd5, 0xa9, 0xd8, 0xbd, 0x36, 0x15, 0x7f, 0x6d, 0xa2, 0xe6, 0x07, 0xe5, 0xe0, 0x5a,
-	0x7b, 0x6b, 0x5c, 0xbf, 0xbd, 0x35, 0xaf, 0xda, 0xde, 0x2e, 0x2b, 0xa1, 0x75, 0x45, 0x25, 0xfc,
-	0xa1, 0xc9, 0x17, 0x7c, 0x91, 0xc9, 0x9c, 0x80, 0x26, 0x02, 0x5e, 0x0a, 0x9f, 0x84, 0x46, 0xbb,
-	0xb8, 0x7e, 0xb2, 0xc8, 0xcd, 0x5d, 0x4f, 0x45, 0x8f, 0x54, 0xf0, 0xc9, 0x97, 0xf5, 0x57, 0x5d,
-	0xdf, 0x67, 0xbd, 0xcb, 0xcd, 0xcf, 0x6b, 0xef, 0x25, 0x20, 0x09, 0x81, 0xc5, 0x24, 0x11, 0xf5,
-	0xe5, 0x8c, 0x8c, 0xb9, 0x33, 0x9e, 0x0b, 0xe0, 0xf6, 0x63, 0x78, 0xe9, 0xca, 0x85, 0xb7, 0xcb,
-	0x56, 0x8f, 0x59, 0x21, 0x43, 0xe7, 0xda, 0x64, 0x08, 0x50, 0x57, 0x72, 0xe1, 0x07, 0x79, 0x7b,
-	0xba, 0x8e, 0x9a, 0x21, 0x16, 0x58, 0x71, 0xc1, 0x2b, 0xd6, 0xba, 0x8b, 0xba, 0xe7, 0x23, 0x55,
-	0x81, 0xbe, 0xf7, 0xe0, 0xc0, 0x56, 0x43, 0x97, 0x5d, 0x0d, 0x5d, 0xf6, 0xf3, 0x2a, 0xc3, 0xdd,
-	0x96, 0x02, 0x7d, 0xf5, 0xd6, 0xd4, 0xbc, 0x8b, 0x6d, 0xee, 0x93, 0xd7, 0x8b, 0x81, 0xf6, 0x66,
-	0x31, 0xd0, 0xfe, 0x5e, 0x0c, 0xb4, 0x57, 0x67, 0x83, 0xad, 0x37, 0x67, 0x83, 0xad, 0xbf, 0xce,
-	0x06, 0x5b, 0x3f, 0x3a, 0xb5, 0xb2, 0xe5, 0x8c, 0x98, 0x80, 0x70, 0xca, 0x59, 0xd1, 0x89, 0x69,
-	0x98, 0xcd, 0x80, 0x97, 0x93, 0xa4, 0xc2, 0x30, 0x6e, 0x17, 0x67, 0x7e, 0xf6, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x9e, 0x8e, 0xa1, 0x9f, 0x67, 0x0a, 0x00, 0x00,
+func (m *FeedValue) GetFields() []FieldValue {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
 }
 
-func (m *MsgCreateFeed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeedValue) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MsgCreateFeed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FieldValue defines the aggregated result of a value field
+type FieldValue struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *MsgCreateFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
+func (m *FieldValue) Reset()         { *m = FieldValue{} }
+func (m *FieldValue) String() string { return proto.CompactTextString(m) }
+func (*FieldValue) ProtoMessage()    {}
+func (*FieldValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{10}
+}
+func (m *FieldValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldValue.Merge(m, src)
+}
+func (m *FieldValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldValue proto.InternalMessageInfo
+
+func (m *FieldValue) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FieldValue) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// ProviderResponse defines the value responded by a provider in a feed batch
+type ProviderResponse struct {
+	Provider     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"provider,omitempty"`
+	BatchCounter uint64                                        `protobuf:"varint,2,opt,name=batch_counter,json=batchCounter,proto3" json:"batch_counter,omitempty" yaml:"batch_counter"`
+	Value        string                                        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Latency      int64                                         `protobuf:"varint,4,opt,name=latency,proto3" json:"latency,omitempty"`
+	Deviation    github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,5,opt,name=deviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deviation"`
+}
+
+func (m *ProviderResponse) Reset()         { *m = ProviderResponse{} }
+func (m *ProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*ProviderResponse) ProtoMessage()    {}
+func (*ProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{11}
+}
+func (m *ProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderResponse.Merge(m, src)
+}
+func (m *ProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderResponse proto.InternalMessageInfo
+
+func (m *ProviderResponse) GetProvider() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+func (m *ProviderResponse) GetBatchCounter() uint64 {
+	if m != nil {
+		return m.BatchCounter
+	}
+	return 0
+}
+
+func (m *ProviderResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ProviderResponse) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+// ProviderStats defines the accumulated response statistics of a feed provider
+type ProviderStats struct {
+	Provider       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"provider,omitempty"`
+	ResponseCount  uint64                                        `protobuf:"varint,2,opt,name=response_count,json=responseCount,proto3" json:"response_count,omitempty" yaml:"response_count"`
+	MissedCount    uint64                                        `protobuf:"varint,3,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty" yaml:"missed_count"`
+	TotalLatency   uint64                                        `protobuf:"varint,4,opt,name=total_latency,json=totalLatency,proto3" json:"total_latency,omitempty" yaml:"total_latency"`
+	TotalDeviation github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,5,opt,name=total_deviation,json=totalDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_deviation" yaml:"total_deviation"`
+	MaxDeviation   github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,6,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation" yaml:"max_deviation"`
+}
+
+func (m *ProviderStats) Reset()         { *m = ProviderStats{} }
+func (m *ProviderStats) String() string { return proto.CompactTextString(m) }
+func (*ProviderStats) ProtoMessage()    {}
+func (*ProviderStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{12}
+}
+func (m *ProviderStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderStats.Merge(m, src)
+}
+func (m *ProviderStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderStats proto.InternalMessageInfo
+
+func (m *ProviderStats) GetProvider() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+func (m *ProviderStats) GetResponseCount() uint64 {
+	if m != nil {
+		return m.ResponseCount
+	}
+	return 0
+}
+
+func (m *ProviderStats) GetMissedCount() uint64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
+func (m *ProviderStats) GetTotalLatency() uint64 {
+	if m != nil {
+		return m.TotalLatency
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateFeed)(nil), "irishub.oracle.MsgCreateFeed")
+	proto.RegisterType((*MsgStartFeed)(nil), "irishub.oracle.MsgStartFeed")
+	proto.RegisterType((*MsgPauseFeed)(nil), "irishub.oracle.MsgPauseFeed")
+	proto.RegisterType((*MsgEditFeed)(nil), "irishub.oracle.MsgEditFeed")
+	proto.RegisterType((*MsgDeleteFeed)(nil), "irishub.oracle.MsgDeleteFeed")
+	proto.RegisterType((*MsgTransferFeedOwner)(nil), "irishub.oracle.MsgTransferFeedOwner")
+	proto.RegisterType((*MsgAcceptFeedOwner)(nil), "irishub.oracle.MsgAcceptFeedOwner")
+	proto.RegisterType((*Feed)(nil), "irishub.oracle.Feed")
+	proto.RegisterType((*ValueField)(nil), "irishub.oracle.ValueField")
+	proto.RegisterType((*FeedValue)(nil), "irishub.oracle.FeedValue")
+	proto.RegisterType((*FieldValue)(nil), "irishub.oracle.FieldValue")
+	proto.RegisterType((*ProviderResponse)(nil), "irishub.oracle.ProviderResponse")
+	proto.RegisterType((*ProviderStats)(nil), "irishub.oracle.ProviderStats")
+}
+
+func init() { proto.RegisterFile("oracle/oracle.proto", fileDescriptor_dc470b50b143d488) }
+
+var fileDescriptor_dc470b50b143d488 = []byte{
+	// 1465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x13, 0x47,
+	0x1b, 0xcf, 0xc6, 0x4e, 0x62, 0x8f, 0xbd, 0x49, 0x98, 0xe4, 0x7d, 0xd9, 0x04, 0xde, 0xac, 0xb5,
+	0x07, 0x94, 0x0b, 0xb6, 0xf2, 0x96, 0x43, 0x85, 0x84, 0x04, 0x4e, 0x88, 0x12, 0x4a, 0x4a, 0x34,
+	0xa0, 0x1e, 0xb8, 0xac, 0xc6, 0xbb, 0x8f, 0xd7, 0x5b, 0xec, 0x5d, 0xb3, 0x33, 0x0e, 0xc9, 0x87,
+	0xa0, 0xe2, 0xd6, 0x5b, 0x8f, 0x55, 0x45, 0xbf, 0x08, 0x47, 0x8e, 0x55, 0x55, 0x99, 0x2a, 0x7c,
+	0x03, 0x9f, 0xaa, 0xf6, 0x52, 0xcd, 0xcc, 0xae, 0xbd, 0xeb, 0x04, 0x95, 0xd8, 0x41, 0xe5, 0x94,
+	0x9d, 0xe7, 0xff, 0x9f, 0x79, 0x7e, 0x7e, 0x32, 0x68, 0x25, 0x8c, 0xa8, 0xd3, 0x86, 0x9a, 0xfa,
+	0x53, 0xed, 0x46, 0x21, 0x0f, 0xf1, 0xa2, 0x1f, 0xf9, 0xac, 0xd5, 0x6b, 0x54, 0x15, 0x75, 0x7d,
+	0xd5, 0x0b, 0xbd, 0x50, 0xb2, 0x6a, 0xe2, 0x4b, 0x49, 0xad, 0xaf, 0x39, 0x21, 0xeb, 0x84, 0xcc,
+	0x56, 0x0c, 0x75, 0x88, 0x59, 0x57, 0xc7, 0x58, 0x7e, 0x10, 0x33, 0x4c, 0x2f, 0x0c, 0xbd, 0x36,
+	0xd4, 0xe4, 0xa9, 0xd1, 0x6b, 0xd6, 0xb8, 0xdf, 0x01, 0xc6, 0x69, 0xa7, 0xab, 0x04, 0xac, 0x57,
+	0x45, 0xa4, 0x1f, 0x30, 0x6f, 0x3b, 0x02, 0xca, 0x61, 0x17, 0xc0, 0xc5, 0x5b, 0xa8, 0xd8, 0x04,
+	0x70, 0xed, 0x80, 0x76, 0xc0, 0xd0, 0x2a, 0xda, 0x66, 0xb1, 0xbe, 0x3a, 0xe8, 0x9b, 0xcb, 0x27,
+	0xb4, 0xd3, 0xbe, 0x6d, 0x0d, 0x59, 0x16, 0x29, 0x88, 0xef, 0xaf, 0x69, 0x07, 0xf0, 0x5d, 0xb4,
+	0xd8, 0xa6, 0x1c, 0x18, 0xb7, 0x5b, 0x3e, 0xe3, 0x61, 0x74, 0x62, 0xcc, 0x56, 0xb4, 0xcd, 0x7c,
+	0x7d, 0x6d, 0xd0, 0x37, 0xff, 0xa3, 0xf4, 0xb2, 0x7c, 0x8b, 0xe8, 0x8a, 0xb0, 0xa7, 0xce, 0xb8,
+	0x82, 0x4a, 0x2e, 0x30, 0x27, 0xf2, 0xbb, 0xdc, 0x0f, 0x03, 0x23, 0x27, 0xdc, 0x92, 0x34, 0x09,
+	0x7f, 0x85, 0x16, 0x1c, 0x11, 0x64, 0x18, 0x19, 0xf9, 0x8a, 0xb6, 0x59, 0xae, 0x6f, 0xfd, 0xd9,
+	0x37, 0x6f, 0x7a, 0x3e, 0x17, 0x75, 0x73, 0xc2, 0x4e, 0x5c, 0x90, 0xf8, 0xcf, 0x4d, 0xe6, 0x3e,
+	0xab, 0xf1, 0x93, 0x2e, 0xb0, 0xea, 0x3d, 0xc7, 0xb9, 0xe7, 0xba, 0x11, 0x30, 0x46, 0x12, 0x0b,
+	0xf8, 0x36, 0x2a, 0x33, 0x88, 0x8e, 0x7c, 0x07, 0x54, 0x9a, 0x73, 0x32, 0xcd, 0xab, 0x83, 0xbe,
+	0xb9, 0xa2, 0xc2, 0x4d, 0x73, 0x2d, 0x52, 0x8a, 0x8f, 0x32, 0xd9, 0x47, 0xa8, 0xd8, 0x8d, 0xc2,
+	0x23, 0xdf, 0x85, 0x88, 0x19, 0xf3, 0x95, 0xdc, 0x64, 0xa1, 0x8c, 0x6c, 0xe0, 0x55, 0x34, 0xe7,
+	0x07, 0xdd, 0x1e, 0x37, 0x16, 0x64, 0xd6, 0xea, 0x80, 0x0d, 0xb4, 0x20, 0x7a, 0x15, 0xf6, 0xb8,
+	0x51, 0xa8, 0x68, 0x9b, 0x39, 0x92, 0x1c, 0xf1, 0x4b, 0x0d, 0x2d, 0x25, 0xf1, 0x35, 0x01, 0x6c,
+	0x87, 0x76, 0x8d, 0x62, 0x25, 0xb7, 0x59, 0xfa, 0xff, 0x5a, 0x35, 0xbe, 0x15, 0x0d, 0xca, 0xa0,
+	0x7a, 0xb4, 0xd5, 0x00, 0x4e, 0xb7, 0xaa, 0xdb, 0xa1, 0x1f, 0xd4, 0x1f, 0xbc, 0xe9, 0x9b, 0x33,
+	0x83, 0xbe, 0xf9, 0xdf, 0x6c, 0x7e, 0xb1, 0xbe, 0xf5, 0xfa, 0x9d, 0xb9, 0xf9, 0x11, 0x09, 0x08,
+	0x53, 0x8c, 0xe8, 0xb1, 0xf6, 0x2e, 0xc0, 0x36, 0xed, 0xe2, 0x87, 0x08, 0x47, 0xd0, 0x15, 0xf7,
+	0xc7, 0xb5, 0x9b, 0x11, 0x3c, 0xef, 0x41, 0xe0, 0x9c, 0x18, 0x48, 0xde, 0x80, 0xff, 0x0d, 0xfa,
+	0xe6, 0x9a, 0x72, 0x79, 0x56, 0xc6, 0x22, 0x57, 0x12, 0xe2, 0x6e, 0x42, 0x13, 0x77, 0x89, 0x7a,
+	0x5e, 0x04, 0x1e, 0xe5, 0x60, 0x37, 0x7b, 0x81, 0x63, 0x94, 0x64, 0x73, 0x52, 0x77, 0x29, 0xcb,
+	0xb7, 0x88, 0x3e, 0x24, 0xec, 0xf6, 0x02, 0x07, 0xd7, 0xd1, 0xd2, 0x11, 0x6d, 0xf7, 0xc0, 0xfe,
+	0x96, 0x85, 0x81, 0xdd, 0xa5, 0xbc, 0x65, 0x94, 0xa5, 0x89, 0xf5, 0x51, 0xfe, 0x63, 0x02, 0x16,
+	0xd1, 0x25, 0xe5, 0x01, 0x0b, 0x83, 0x43, 0xca, 0x5b, 0x2a, 0x27, 0xd6, 0x0d, 0x03, 0x06, 0x36,
+	0x6f, 0x45, 0xc0, 0x5a, 0x61, 0xdb, 0x35, 0xf4, 0x8a, 0xb6, 0xa9, 0x67, 0x73, 0x1a, 0x97, 0x91,
+	0x39, 0x29, 0xe2, 0x93, 0x84, 0x86, 0xb7, 0xd1, 0x12, 0x8f, 0xfc, 0x8e, 0xdd, 0x85, 0xc8, 0x81,
+	0x80, 0x53, 0x0f, 0x8c, 0x45, 0x69, 0x2a, 0x15, 0xd1, 0x98, 0x80, 0x45, 0x16, 0x05, 0xe5, 0x70,
+	0x48, 0xc0, 0xd7, 0xc5, 0xbd, 0x03, 0xc7, 0x67, 0x62, 0x40, 0x96, 0x84, 0x3a, 0x19, 0x11, 0xf0,
+	0x23, 0xb4, 0xe2, 0xc2, 0x91, 0x4f, 0xc5, 0xac, 0xa4, 0x22, 0x5e, 0x96, 0x6e, 0x36, 0x06, 0x7d,
+	0x73, 0x5d, 0xb9, 0x39, 0x47, 0xc8, 0x22, 0x78, 0x48, 0x1d, 0xc5, 0x7c, 0x1d, 0x15, 0x5b, 0x40,
+	0x23, 0xde, 0x00, 0xca, 0x8d, 0x2b, 0xa2, 0x99, 0x64, 0x44, 0xc0, 0x4f, 0x51, 0x59, 0x95, 0xb0,
+	0xe9, 0x43, 0xdb, 0x65, 0x06, 0x96, 0xf7, 0x6f, 0xbd, 0x9a, 0x05, 0xb2, 0xea, 0x37, 0x42, 0x66,
+	0x57, 0x88, 0xd4, 0xaf, 0xc5, 0x17, 0x70, 0x25, 0xdd, 0x00, 0xa5, 0x6d, 0x91, 0xd2, 0xd1, 0x50,
+	0x90, 0x59, 0xdf, 0x69, 0xa8, 0x7c, 0xc0, 0xbc, 0xc7, 0x9c, 0x46, 0x7c, 0x52, 0x44, 0xba, 0x4c,
+	0xb4, 0x48, 0x02, 0x3a, 0xa4, 0x3d, 0x06, 0x9f, 0x45, 0x40, 0xaf, 0x17, 0x50, 0xe9, 0x80, 0x79,
+	0xf7, 0x5d, 0x7f, 0xe2, 0x02, 0x8d, 0x01, 0xee, 0xec, 0x59, 0xc0, 0x3d, 0x0b, 0xea, 0xb9, 0x0b,
+	0x82, 0x7a, 0x06, 0x29, 0xf3, 0x97, 0x80, 0x94, 0x29, 0x4c, 0x9c, 0xfb, 0x67, 0x4c, 0x9c, 0xff,
+	0xec, 0x30, 0x71, 0x61, 0x42, 0x4c, 0x3c, 0x1f, 0x8d, 0x0a, 0x13, 0xa2, 0x51, 0xea, 0x2a, 0x16,
+	0xa7, 0xfe, 0x25, 0xfd, 0x00, 0xee, 0xa0, 0xcb, 0xc1, 0x9d, 0xd2, 0x38, 0xee, 0x34, 0xd1, 0x35,
+	0xd7, 0x67, 0xb4, 0xd1, 0x06, 0xfb, 0x3c, 0xb7, 0x02, 0xe7, 0x0b, 0xf5, 0x1b, 0x83, 0xbe, 0x69,
+	0xc5, 0x6e, 0x3f, 0x2c, 0x6c, 0x91, 0xb5, 0x98, 0xbb, 0x73, 0x36, 0x8a, 0x7d, 0x74, 0x25, 0x51,
+	0x1d, 0x45, 0xa3, 0x4b, 0xeb, 0xd7, 0x07, 0x7d, 0xd3, 0xc8, 0x5a, 0x1f, 0x8a, 0x58, 0x64, 0x39,
+	0xa6, 0xed, 0x0d, 0x49, 0x2f, 0x35, 0xb9, 0x61, 0xed, 0x40, 0x1b, 0x26, 0xdf, 0xb0, 0xf6, 0xd1,
+	0x3c, 0x83, 0xc0, 0x85, 0xc8, 0x98, 0x9d, 0xb4, 0x65, 0xb1, 0x01, 0xeb, 0x0f, 0x0d, 0xad, 0x1e,
+	0x30, 0xef, 0x49, 0x44, 0x03, 0xd6, 0x84, 0x48, 0x44, 0xf4, 0xe8, 0x45, 0x00, 0xd1, 0xbf, 0x1b,
+	0x16, 0x6e, 0xa0, 0x62, 0x00, 0x2f, 0xec, 0x50, 0x84, 0x22, 0x91, 0xa6, 0x5c, 0xbf, 0x3f, 0xf2,
+	0x3e, 0x64, 0x59, 0x17, 0xf7, 0x50, 0x08, 0xe0, 0x85, 0xcc, 0xd0, 0xfa, 0x59, 0x43, 0xf8, 0x80,
+	0x79, 0xf7, 0x1c, 0x07, 0xba, 0x7c, 0xaa, 0xc4, 0x33, 0xd1, 0xce, 0x7e, 0x9a, 0x68, 0x7f, 0x5a,
+	0x40, 0xf9, 0x4f, 0x0a, 0xef, 0x63, 0x7b, 0x56, 0x6e, 0xfa, 0x3d, 0x2b, 0x7f, 0xd1, 0x3d, 0xeb,
+	0xec, 0x8f, 0xcc, 0xdc, 0x05, 0x7f, 0x64, 0xbe, 0xd7, 0x04, 0x38, 0x3e, 0xef, 0x09, 0x19, 0x27,
+	0x0c, 0x38, 0x1c, 0x73, 0xdb, 0x77, 0x8d, 0x79, 0xd9, 0x13, 0xff, 0xb4, 0x6f, 0x2e, 0x13, 0xc5,
+	0xdd, 0x56, 0xcc, 0xfd, 0x9d, 0x34, 0x60, 0x8e, 0xeb, 0x89, 0x86, 0xdd, 0x4a, 0x35, 0x8c, 0xcb,
+	0xcb, 0xd9, 0xf1, 0x03, 0x9e, 0xfe, 0x6c, 0xfb, 0x0d, 0x56, 0x6b, 0x9c, 0x70, 0x60, 0xd5, 0x3d,
+	0x38, 0xae, 0x8b, 0x0f, 0xb2, 0x1c, 0x65, 0xdd, 0x64, 0x70, 0x76, 0x61, 0x6a, 0x9c, 0x3d, 0x67,
+	0x85, 0x2c, 0x4c, 0xb7, 0x42, 0x16, 0x3f, 0x72, 0x85, 0xfc, 0x54, 0x50, 0x3e, 0xbe, 0x42, 0x96,
+	0x2f, 0x6f, 0x85, 0xc4, 0x01, 0xd2, 0xbb, 0x10, 0xb8, 0x7e, 0xe0, 0xc5, 0x23, 0xaa, 0xcb, 0x06,
+	0xec, 0x0f, 0xfa, 0xe6, 0xaa, 0x52, 0xce, 0xb0, 0x27, 0x18, 0xd3, 0x72, 0x6c, 0x40, 0x8d, 0xea,
+	0x8f, 0x1a, 0x42, 0xa3, 0x40, 0x31, 0x46, 0xf9, 0xd1, 0xac, 0x12, 0xf9, 0x7d, 0xde, 0xb4, 0xcc,
+	0x4e, 0x30, 0x2d, 0xd3, 0xcd, 0xac, 0xf5, 0x9b, 0x86, 0x8a, 0x02, 0x53, 0x64, 0xb0, 0x22, 0x4e,
+	0x97, 0x72, 0x9a, 0xc4, 0x29, 0xbe, 0xf1, 0x1d, 0xa4, 0x37, 0x28, 0x77, 0x5a, 0xb6, 0x13, 0xf6,
+	0x02, 0x1e, 0xa3, 0x5b, 0xbe, 0x6e, 0x8c, 0x4a, 0x97, 0x61, 0x5b, 0xa4, 0x2c, 0xcf, 0xdb, 0xea,
+	0x88, 0xbf, 0x44, 0xf3, 0x71, 0x3f, 0x73, 0xe7, 0xf7, 0x53, 0x56, 0x48, 0xba, 0xaf, 0xe7, 0x45,
+	0x3f, 0x49, 0x2c, 0x8f, 0xeb, 0xa8, 0x38, 0x7c, 0x9c, 0x90, 0xe3, 0x2b, 0x94, 0xd5, 0xf3, 0x45,
+	0x35, 0x79, 0xbe, 0xa8, 0x3e, 0x49, 0x24, 0xea, 0x05, 0xa1, 0xfc, 0xea, 0x9d, 0xa9, 0x91, 0x91,
+	0x9a, 0x75, 0x0b, 0xa1, 0x91, 0xfd, 0x73, 0xdb, 0x90, 0xa4, 0x3c, 0x3b, 0x4a, 0xd9, 0xfa, 0x61,
+	0x16, 0x2d, 0x1f, 0xc6, 0x4b, 0x26, 0x89, 0xd7, 0x25, 0x7c, 0x80, 0x0a, 0xc9, 0xe2, 0x69, 0x68,
+	0x93, 0x8e, 0xef, 0xd0, 0xc4, 0xb4, 0x65, 0x5d, 0x45, 0x73, 0xf2, 0x2a, 0xc4, 0x2f, 0x23, 0xea,
+	0x20, 0xf6, 0x61, 0x01, 0x86, 0x62, 0xb5, 0xcc, 0xab, 0x7d, 0x38, 0x3e, 0xe2, 0x87, 0xa8, 0x38,
+	0x1c, 0xc8, 0xf8, 0x75, 0xa3, 0x2a, 0x0a, 0xf6, 0x6b, 0xdf, 0xbc, 0xf1, 0x11, 0x29, 0xec, 0x80,
+	0x43, 0x46, 0x06, 0xac, 0xbf, 0x72, 0x48, 0x4f, 0x0a, 0xf4, 0x98, 0x53, 0xce, 0x2e, 0xbb, 0x3a,
+	0x77, 0xd1, 0xe2, 0x70, 0x79, 0x95, 0x15, 0x38, 0xfb, 0x80, 0x94, 0xe5, 0x5b, 0x44, 0x4f, 0x08,
+	0xb2, 0x44, 0xe2, 0x45, 0xa7, 0xe3, 0x33, 0x06, 0x6e, 0xac, 0xaf, 0xfe, 0x57, 0x49, 0xbd, 0xe8,
+	0xa4, 0xb9, 0x16, 0x29, 0xa9, 0xa3, 0xd2, 0xbd, 0x83, 0x74, 0x1e, 0x72, 0xda, 0xb6, 0xd3, 0xc5,
+	0xcc, 0xf4, 0x26, 0xc3, 0xb6, 0x48, 0x59, 0x9e, 0x1f, 0xc6, 0xb5, 0x7e, 0x8e, 0x96, 0x14, 0x7f,
+	0xbc, 0xe2, 0x7b, 0x17, 0xab, 0x78, 0x0a, 0xc8, 0xb3, 0xe6, 0x04, 0x90, 0x0b, 0xca, 0x70, 0x4f,
+	0xc5, 0xcf, 0x90, 0xde, 0xa1, 0xc7, 0x29, 0x87, 0xf3, 0xd2, 0xe1, 0xee, 0x85, 0x1d, 0xc6, 0xf9,
+	0x65, 0x8c, 0x59, 0xa4, 0xdc, 0xa1, 0xc7, 0x43, 0x67, 0xf5, 0xfd, 0x37, 0xa7, 0x1b, 0xda, 0xdb,
+	0xd3, 0x0d, 0xed, 0xf7, 0xd3, 0x0d, 0xed, 0xd5, 0xfb, 0x8d, 0x99, 0xb7, 0xef, 0x37, 0x66, 0x7e,
+	0x79, 0xbf, 0x31, 0xf3, 0xb4, 0x96, 0xf2, 0x23, 0xc6, 0x3c, 0x00, 0x5e, 0x8b, 0xc7, 0xbd, 0xd6,
+	0x09, 0xdd, 0x5e, 0x1b, 0x58, 0xfc, 0xd0, 0xa9, 0x9c, 0x36, 0xe6, 0xe5, 0x20, 0x7f, 0xf1, 0xf7,
+	0x00, 0x9c, 0x64, 0x4d, 0xf4, 0x06, 0x15, 0x00, 0x00,
+}
+
+func (m *MsgCreateFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
+	if len(m.ValueFields) > 0 {
+		for iNdEx := len(m.ValueFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValueFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.Heartbeat != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Heartbeat))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.DeviationThreshold != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DeviationThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Precision != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Precision))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.TrimPercentage != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TrimPercentage))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.ResponseThreshold != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.ResponseThreshold))
 		i--
@@ -811,6 +1456,36 @@ func (m *MsgEditFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DisableHeartbeat {
+		i--
+		if m.DisableHeartbeat {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DisableDeviationThreshold {
+		i--
+		if m.DisableDeviationThreshold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Heartbeat != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Heartbeat))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.DeviationThreshold != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DeviationThreshold))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -878,7 +1553,7 @@ func (m *MsgEditFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Feed) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteFeed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -888,53 +1563,64 @@ func (m *Feed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Feed) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteFeed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Feed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.RequestContextID) > 0 {
-		i -= len(m.RequestContextID)
-		copy(dAtA[i:], m.RequestContextID)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.RequestContextID)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	if m.LatestHistory != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.LatestHistory))
+	if len(m.FeedName) > 0 {
+		i -= len(m.FeedName)
+		copy(dAtA[i:], m.FeedName)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeedName)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0xa
 	}
-	if len(m.ValueJsonPath) > 0 {
-		i -= len(m.ValueJsonPath)
-		copy(dAtA[i:], m.ValueJsonPath)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValueJsonPath)))
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferFeedOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AggregateFunc) > 0 {
-		i -= len(m.AggregateFunc)
-		copy(dAtA[i:], m.AggregateFunc)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.AggregateFunc)))
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferFeedOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferFeedOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.NewOwner)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -948,7 +1634,7 @@ func (m *Feed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FeedValue) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcceptFeedOwner) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -958,163 +1644,450 @@ func (m *FeedValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeedValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcceptFeedOwner) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcceptFeedOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintOracle(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x32
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Data)))
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.NewOwner)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.FeedName) > 0 {
+		i -= len(m.FeedName)
+		copy(dAtA[i:], m.FeedName)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeedName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return len(dAtA) - i, nil
 }
-func (m *MsgCreateFeed) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Feed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Feed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Feed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FeedName)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.LatestHistory != 0 {
-		n += 1 + sovOracle(uint64(m.LatestHistory))
+	if len(m.ValueFields) > 0 {
+		for iNdEx := len(m.ValueFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValueFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.Heartbeat != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Heartbeat))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.DeviationThreshold != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DeviationThreshold))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.ServiceName)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if m.Precision != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Precision))
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Providers) > 0 {
-		for _, b := range m.Providers {
-			l = len(b)
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.TrimPercentage != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TrimPercentage))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Input)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovOracle(uint64(m.Timeout))
+	if len(m.RequestContextID) > 0 {
+		i -= len(m.RequestContextID)
+		copy(dAtA[i:], m.RequestContextID)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.RequestContextID)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.ServiceFeeCap) > 0 {
-		for _, e := range m.ServiceFeeCap {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
-		}
+	if m.LatestHistory != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LatestHistory))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.RepeatedFrequency != 0 {
-		n += 1 + sovOracle(uint64(m.RepeatedFrequency))
+	if len(m.ValueJsonPath) > 0 {
+		i -= len(m.ValueJsonPath)
+		copy(dAtA[i:], m.ValueJsonPath)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValueJsonPath)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.AggregateFunc)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.AggregateFunc) > 0 {
+		i -= len(m.AggregateFunc)
+		copy(dAtA[i:], m.AggregateFunc)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.AggregateFunc)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ValueJsonPath)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ResponseThreshold != 0 {
-		n += 1 + sovOracle(uint64(m.ResponseThreshold))
+	if len(m.FeedName) > 0 {
+		i -= len(m.FeedName)
+		copy(dAtA[i:], m.FeedName)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.FeedName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStartFeed) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValueField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FeedName)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.AggregateFunc) > 0 {
+		i -= len(m.AggregateFunc)
+		copy(dAtA[i:], m.AggregateFunc)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.AggregateFunc)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.ValueJsonPath) > 0 {
+		i -= len(m.ValueJsonPath)
+		copy(dAtA[i:], m.ValueJsonPath)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValueJsonPath)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPauseFeed) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FeedName)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err1 != nil {
+		return 0, err1
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	if m.BatchCounter != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BatchCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditFeed) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FieldValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FeedName)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Description)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Deviation.Size()
+		i -= size
+		if _, err := m.Deviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Latency != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Latency))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BatchCounter != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BatchCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalDeviation.Size()
+		i -= size
+		if _, err := m.TotalDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TotalLatency != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TotalLatency))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ResponseCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ResponseCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedName)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
 	if m.LatestHistory != 0 {
 		n += 1 + sovOracle(uint64(m.LatestHistory))
 	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	if len(m.Providers) > 0 {
 		for _, b := range m.Providers {
 			l = len(b)
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	if m.Timeout != 0 {
 		n += 1 + sovOracle(uint64(m.Timeout))
 	}
@@ -1127,9 +2100,48 @@ func (m *MsgEditFeed) Size() (n int) {
 	if m.RepeatedFrequency != 0 {
 		n += 1 + sovOracle(uint64(m.RepeatedFrequency))
 	}
+	l = len(m.AggregateFunc)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.ValueJsonPath)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	if m.ResponseThreshold != 0 {
 		n += 1 + sovOracle(uint64(m.ResponseThreshold))
 	}
+	if m.TrimPercentage != 0 {
+		n += 1 + sovOracle(uint64(m.TrimPercentage))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovOracle(uint64(m.Precision))
+	}
+	if m.DeviationThreshold != 0 {
+		n += 2 + sovOracle(uint64(m.DeviationThreshold))
+	}
+	if m.Heartbeat != 0 {
+		n += 2 + sovOracle(uint64(m.Heartbeat))
+	}
+	if len(m.ValueFields) > 0 {
+		for _, e := range m.ValueFields {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStartFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedName)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
@@ -1137,7 +2149,7 @@ func (m *MsgEditFeed) Size() (n int) {
 	return n
 }
 
-func (m *Feed) Size() (n int) {
+func (m *MsgPauseFeed) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1147,54 +2159,1715 @@ func (m *Feed) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	l = len(m.Description)
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	l = len(m.AggregateFunc)
+	return n
+}
+
+func (m *MsgEditFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedName)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	l = len(m.ValueJsonPath)
+	l = len(m.Description)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
 	if m.LatestHistory != 0 {
 		n += 1 + sovOracle(uint64(m.LatestHistory))
 	}
-	l = len(m.RequestContextID)
+	if len(m.Providers) > 0 {
+		for _, b := range m.Providers {
+			l = len(b)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovOracle(uint64(m.Timeout))
+	}
+	if len(m.ServiceFeeCap) > 0 {
+		for _, e := range m.ServiceFeeCap {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.RepeatedFrequency != 0 {
+		n += 1 + sovOracle(uint64(m.RepeatedFrequency))
+	}
+	if m.ResponseThreshold != 0 {
+		n += 1 + sovOracle(uint64(m.ResponseThreshold))
+	}
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	l = len(m.Creator)
+	if m.DeviationThreshold != 0 {
+		n += 1 + sovOracle(uint64(m.DeviationThreshold))
+	}
+	if m.Heartbeat != 0 {
+		n += 1 + sovOracle(uint64(m.Heartbeat))
+	}
+	if m.DisableDeviationThreshold {
+		n += 2
+	}
+	if m.DisableHeartbeat {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDeleteFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedName)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
 	return n
 }
 
-func (m *FeedValue) Size() (n int) {
+func (m *MsgTransferFeedOwner) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
+	l = len(m.FeedName)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.NewOwner)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
-	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgAcceptFeedOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedName)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
 }
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Feed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedName)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.AggregateFunc)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.ValueJsonPath)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.LatestHistory != 0 {
+		n += 1 + sovOracle(uint64(m.LatestHistory))
+	}
+	l = len(m.RequestContextID)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.TrimPercentage != 0 {
+		n += 1 + sovOracle(uint64(m.TrimPercentage))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovOracle(uint64(m.Precision))
+	}
+	if m.DeviationThreshold != 0 {
+		n += 1 + sovOracle(uint64(m.DeviationThreshold))
+	}
+	if m.Heartbeat != 0 {
+		n += 1 + sovOracle(uint64(m.Heartbeat))
+	}
+	if len(m.ValueFields) > 0 {
+		for _, e := range m.ValueFields {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ValueField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.ValueJsonPath)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.AggregateFunc)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *FeedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.BatchCounter != 0 {
+		n += 1 + sovOracle(uint64(m.BatchCounter))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *FieldValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.BatchCounter != 0 {
+		n += 1 + sovOracle(uint64(m.BatchCounter))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Latency != 0 {
+		n += 1 + sovOracle(uint64(m.Latency))
+	}
+	l = m.Deviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ProviderStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ResponseCount != 0 {
+		n += 1 + sovOracle(uint64(m.ResponseCount))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovOracle(uint64(m.MissedCount))
+	}
+	if m.TotalLatency != 0 {
+		n += 1 + sovOracle(uint64(m.TotalLatency))
+	}
+	l = m.TotalDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHistory", wireType)
+			}
+			m.LatestHistory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestHistory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, make([]byte, postIndex-iNdEx))
+			copy(m.Providers[len(m.Providers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFeeCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceFeeCap = append(m.ServiceFeeCap, types.Coin{})
+			if err := m.ServiceFeeCap[len(m.ServiceFeeCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFrequency", wireType)
+			}
+			m.RepeatedFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatedFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateFunc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateFunc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueJsonPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueJsonPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseThreshold", wireType)
+			}
+			m.ResponseThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimPercentage", wireType)
+			}
+			m.TrimPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrimPercentage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThreshold", wireType)
+			}
+			m.DeviationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeat", wireType)
+			}
+			m.Heartbeat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Heartbeat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueFields = append(m.ValueFields, ValueField{})
+			if err := m.ValueFields[len(m.ValueFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHistory", wireType)
+			}
+			m.LatestHistory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestHistory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, make([]byte, postIndex-iNdEx))
+			copy(m.Providers[len(m.Providers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFeeCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceFeeCap = append(m.ServiceFeeCap, types.Coin{})
+			if err := m.ServiceFeeCap[len(m.ServiceFeeCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFrequency", wireType)
+			}
+			m.RepeatedFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatedFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseThreshold", wireType)
+			}
+			m.ResponseThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThreshold", wireType)
+			}
+			m.DeviationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeat", wireType)
+			}
+			m.Heartbeat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Heartbeat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableDeviationThreshold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableDeviationThreshold = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableHeartbeat", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableHeartbeat = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferFeedOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferFeedOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferFeedOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = append(m.NewOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewOwner == nil {
+				m.NewOwner = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptFeedOwner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1217,10 +3890,10 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFeed: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptFeedOwner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptFeedOwner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1256,29 +3929,10 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 			m.FeedName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHistory", wireType)
-			}
-			m.LatestHistory = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LatestHistory |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1288,29 +3942,84 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = append(m.NewOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewOwner == nil {
+				m.NewOwner = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Feed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Feed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Feed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1320,29 +4029,27 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Creator == nil {
-				m.Creator = []byte{}
-			}
+			m.FeedName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1370,13 +4077,13 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateFunc", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1386,27 +4093,27 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, make([]byte, postIndex-iNdEx))
-			copy(m.Providers[len(m.Providers)-1], dAtA[iNdEx:postIndex])
+			m.AggregateFunc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueJsonPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1434,13 +4141,13 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Input = string(dAtA[iNdEx:postIndex])
+			m.ValueJsonPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHistory", wireType)
 			}
-			m.Timeout = 0
+			m.LatestHistory = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1450,16 +4157,16 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= int64(b&0x7F) << shift
+				m.LatestHistory |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFeeCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestContextID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1469,50 +4176,31 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceFeeCap = append(m.ServiceFeeCap, types.Coin{})
-			if err := m.ServiceFeeCap[len(m.ServiceFeeCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RequestContextID = append(m.RequestContextID[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestContextID == nil {
+				m.RequestContextID = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFrequency", wireType)
-			}
-			m.RepeatedFrequency = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RepeatedFrequency |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateFunc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1522,29 +4210,31 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateFunc = string(dAtA[iNdEx:postIndex])
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueJsonPath", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimPercentage", wireType)
 			}
-			var stringLen uint64
+			m.TrimPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1554,29 +4244,16 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TrimPercentage |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValueJsonPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
 			}
-			m.ResponseThreshold = 0
+			m.Precision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1586,69 +4263,54 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResponseThreshold |= uint32(b&0x7F) << shift
+				m.Precision |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThreshold", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgStartFeed) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			m.DeviationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeat", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Heartbeat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Heartbeat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartFeed: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartFeed: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1658,27 +4320,29 @@ func (m *MsgStartFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedName = string(dAtA[iNdEx:postIndex])
+			m.ValueFields = append(m.ValueFields, ValueField{})
+			if err := m.ValueFields[len(m.ValueFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1705,9 +4369,9 @@ func (m *MsgStartFeed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Creator == nil {
-				m.Creator = []byte{}
+			m.PendingOwner = append(m.PendingOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.PendingOwner == nil {
+				m.PendingOwner = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1734,7 +4398,7 @@ func (m *MsgStartFeed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPauseFeed) Unmarshal(dAtA []byte) error {
+func (m *ValueField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1757,15 +4421,15 @@ func (m *MsgPauseFeed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseFeed: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValueField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValueField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1793,13 +4457,13 @@ func (m *MsgPauseFeed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueJsonPath", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1809,25 +4473,55 @@ func (m *MsgPauseFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Creator == nil {
-				m.Creator = []byte{}
+			m.ValueJsonPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateFunc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateFunc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1853,7 +4547,7 @@ func (m *MsgPauseFeed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFeed) Unmarshal(dAtA []byte) error {
+func (m *FeedValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1876,15 +4570,15 @@ func (m *MsgEditFeed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFeed: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1912,96 +4606,13 @@ func (m *MsgEditFeed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedName = string(dAtA[iNdEx:postIndex])
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHistory", wireType)
-			}
-			m.LatestHistory = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LatestHistory |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Providers = append(m.Providers, make([]byte, postIndex-iNdEx))
-			copy(m.Providers[len(m.Providers)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchCounter", wireType)
 			}
-			m.Timeout = 0
+			m.BatchCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2011,14 +4622,14 @@ func (m *MsgEditFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= int64(b&0x7F) << shift
+				m.BatchCounter |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFeeCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2045,54 +4656,16 @@ func (m *MsgEditFeed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceFeeCap = append(m.ServiceFeeCap, types.Coin{})
-			if err := m.ServiceFeeCap[len(m.ServiceFeeCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fields = append(m.Fields, FieldValue{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFrequency", wireType)
-			}
-			m.RepeatedFrequency = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RepeatedFrequency |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseThreshold", wireType)
-			}
-			m.ResponseThreshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ResponseThreshold |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2102,24 +4675,23 @@ func (m *MsgEditFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Creator == nil {
-				m.Creator = []byte{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2146,7 +4718,7 @@ func (m *MsgEditFeed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Feed) Unmarshal(dAtA []byte) error {
+func (m *FieldValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2169,15 +4741,15 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Feed: wiretype end group for non-group")
+			return fmt.Errorf("proto: FieldValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Feed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FieldValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2205,11 +4777,11 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2237,45 +4809,66 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateFunc", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateFunc = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueJsonPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2285,29 +4878,31 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueJsonPath = string(dAtA[iNdEx:postIndex])
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchCounter", wireType)
 			}
-			m.LatestHistory = 0
+			m.BatchCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2317,16 +4912,16 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestHistory |= uint64(b&0x7F) << shift
+				m.BatchCounter |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestContextID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2336,31 +4931,48 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestContextID = append(m.RequestContextID[:0], dAtA[iNdEx:postIndex]...)
-			if m.RequestContextID == nil {
-				m.RequestContextID = []byte{}
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			m.Latency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Latency |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2370,24 +4982,24 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Creator == nil {
-				m.Creator = []byte{}
+			if err := m.Deviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2414,7 +5026,7 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeedValue) Unmarshal(dAtA []byte) error {
+func (m *ProviderStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2437,15 +5049,106 @@ func (m *FeedValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeedValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeedValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseCount", wireType)
+			}
+			m.ResponseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
+			}
+			m.MissedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLatency", wireType)
+			}
+			m.TotalLatency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalLatency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2473,13 +5176,15 @@ func (m *FeedValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2489,22 +5194,23 @@ func (m *FeedValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
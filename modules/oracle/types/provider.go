@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewProviderStats constructs a new ProviderStats instance
+func NewProviderStats(provider sdk.AccAddress) ProviderStats {
+	return ProviderStats{
+		Provider:       provider,
+		TotalDeviation: sdk.ZeroDec(),
+		MaxDeviation:   sdk.ZeroDec(),
+	}
+}
+
+// AddResponse accumulates a response of the provider
+func (s *ProviderStats) AddResponse(latency int64, deviation sdk.Dec) {
+	s.ResponseCount++
+	if latency > 0 {
+		s.TotalLatency += uint64(latency)
+	}
+	s.TotalDeviation = s.TotalDeviation.Add(deviation)
+	if deviation.GT(s.MaxDeviation) {
+		s.MaxDeviation = deviation
+	}
+}
+
+// Accuracy returns the accuracy statistics derived from the accumulated statistics
+func (s ProviderStats) Accuracy() ProviderAccuracy {
+	accuracy := ProviderAccuracy{
+		Provider:         s.Provider,
+		ResponseCount:    s.ResponseCount,
+		MissedCount:      s.MissedCount,
+		AverageLatency:   sdk.ZeroDec(),
+		AverageDeviation: sdk.ZeroDec(),
+		MaxDeviation:     s.MaxDeviation,
+	}
+	if s.ResponseCount > 0 {
+		accuracy.AverageLatency = sdk.NewDec(int64(s.TotalLatency)).QuoInt64(int64(s.ResponseCount))
+		accuracy.AverageDeviation = s.TotalDeviation.QuoInt64(int64(s.ResponseCount))
+	}
+	return accuracy
+}
+
+// Deviation returns the relative deviation of the value from the aggregated value,
+// or the absolute deviation if the aggregated value is zero
+func Deviation(value, aggregated sdk.Dec) sdk.Dec {
+	diff := value.Sub(aggregated).Abs()
+	if aggregated.IsZero() {
+		return diff
+	}
+	return diff.Quo(aggregated.Abs())
+}
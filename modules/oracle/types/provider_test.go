@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestProviderStats_Accuracy(t *testing.T) {
+	stats := NewProviderStats(addr1)
+	accuracy := stats.Accuracy()
+	require.Equal(t, sdk.ZeroDec(), accuracy.AverageLatency)
+	require.Equal(t, sdk.ZeroDec(), accuracy.AverageDeviation)
+
+	stats.AddResponse(2, sdk.NewDecWithPrec(1, 2))
+	stats.AddResponse(3, sdk.NewDecWithPrec(5, 2))
+	stats.MissedCount++
+
+	accuracy = stats.Accuracy()
+	require.Equal(t, uint64(2), accuracy.ResponseCount)
+	require.Equal(t, uint64(1), accuracy.MissedCount)
+	require.Equal(t, sdk.NewDecWithPrec(25, 1), accuracy.AverageLatency)
+	require.Equal(t, sdk.NewDecWithPrec(3, 2), accuracy.AverageDeviation)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), accuracy.MaxDeviation)
+}
+
+func TestDeviation(t *testing.T) {
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), Deviation(sdk.NewDec(110), sdk.NewDec(100)))
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), Deviation(sdk.NewDec(90), sdk.NewDec(100)))
+	require.Equal(t, sdk.NewDec(5), Deviation(sdk.NewDec(-5), sdk.ZeroDec()))
+}
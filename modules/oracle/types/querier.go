@@ -1,9 +1,17 @@
 package types
 
+import (
+	"time"
+)
+
+// DefaultFeedValuesLimit is the default number of values in a page of the feed values
+const DefaultFeedValuesLimit = MaxLatestHistory
+
 const (
-	QueryFeed      = "feed"      // QueryFeed
-	QueryFeeds     = "feeds"     // QueryFeeds
-	QueryFeedValue = "feedValue" // QueryFeedValue
+	QueryFeed          = "feed"          // QueryFeed
+	QueryFeeds         = "feeds"         // QueryFeeds
+	QueryFeedValue     = "feedValue"     // QueryFeedValue
+	QueryProviderStats = "providerStats" // QueryProviderStats
 )
 
 // QueryFeedParams defines the params to query a feed definition
@@ -18,5 +26,16 @@ type QueryFeedsParams struct {
 
 // QueryFeedValueParams defines the params to query a feed result
 type QueryFeedValueParams struct {
+	FeedName  string
+	FromTime  *time.Time
+	ToTime    *time.Time
+	FromBatch uint64
+	ToBatch   uint64
+	Page      uint64
+	Limit     uint64
+}
+
+// QueryProviderStatsParams defines the params to query the provider statistics of a feed
+type QueryProviderStatsParams struct {
 	FeedName string
 }
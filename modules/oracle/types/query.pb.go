@@ -8,9 +8,12 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	types1 "github.com/irismod/service/types"
 	_ "github.com/regen-network/cosmos-proto"
 	grpc "google.golang.org/grpc"
@@ -19,12 +22,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -215,6 +220,15 @@ func (m *QueryFeedsResponse) GetFeeds() []FeedContext {
 // QueryFeedValueRequest is request type for the Query/FeedValue RPC method
 type QueryFeedValueRequest struct {
 	FeedName string `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty"`
+	// only the values saved no earlier than from_time are returned if set
+	FromTime *time.Time `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3,stdtime" json:"from_time,omitempty"`
+	// only the values saved no later than to_time are returned if set
+	ToTime *time.Time `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3,stdtime" json:"to_time,omitempty"`
+	// only the values of batches no less than from_batch are returned if set
+	FromBatch uint64 `protobuf:"varint,4,opt,name=from_batch,json=fromBatch,proto3" json:"from_batch,omitempty"`
+	// only the values of batches no greater than to_batch are returned if set
+	ToBatch    uint64             `protobuf:"varint,5,opt,name=to_batch,json=toBatch,proto3" json:"to_batch,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryFeedValueRequest) Reset()         { *m = QueryFeedValueRequest{} }
@@ -257,9 +271,45 @@ func (m *QueryFeedValueRequest) GetFeedName() string {
 	return ""
 }
 
+func (m *QueryFeedValueRequest) GetFromTime() *time.Time {
+	if m != nil {
+		return m.FromTime
+	}
+	return nil
+}
+
+func (m *QueryFeedValueRequest) GetToTime() *time.Time {
+	if m != nil {
+		return m.ToTime
+	}
+	return nil
+}
+
+func (m *QueryFeedValueRequest) GetFromBatch() uint64 {
+	if m != nil {
+		return m.FromBatch
+	}
+	return 0
+}
+
+func (m *QueryFeedValueRequest) GetToBatch() uint64 {
+	if m != nil {
+		return m.ToBatch
+	}
+	return 0
+}
+
+func (m *QueryFeedValueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryFeedValueResponse is response type for the Query/FeedValue RPC method
 type QueryFeedValueResponse struct {
-	FeedValues []FeedValue `protobuf:"bytes,1,rep,name=feed_values,json=feedValues,proto3" json:"feed_values"`
+	FeedValues []FeedValue         `protobuf:"bytes,1,rep,name=feed_values,json=feedValues,proto3" json:"feed_values"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryFeedValueResponse) Reset()         { *m = QueryFeedValueResponse{} }
@@ -302,6 +352,167 @@ func (m *QueryFeedValueResponse) GetFeedValues() []FeedValue {
 	return nil
 }
 
+func (m *QueryFeedValueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProviderStatsRequest is request type for the Query/ProviderStats RPC method
+type QueryProviderStatsRequest struct {
+	FeedName string `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty"`
+}
+
+func (m *QueryProviderStatsRequest) Reset()         { *m = QueryProviderStatsRequest{} }
+func (m *QueryProviderStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderStatsRequest) ProtoMessage()    {}
+func (*QueryProviderStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{6}
+}
+func (m *QueryProviderStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderStatsRequest.Merge(m, src)
+}
+func (m *QueryProviderStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderStatsRequest proto.InternalMessageInfo
+
+func (m *QueryProviderStatsRequest) GetFeedName() string {
+	if m != nil {
+		return m.FeedName
+	}
+	return ""
+}
+
+// QueryProviderStatsResponse is response type for the Query/ProviderStats RPC method
+type QueryProviderStatsResponse struct {
+	Stats []ProviderAccuracy `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryProviderStatsResponse) Reset()         { *m = QueryProviderStatsResponse{} }
+func (m *QueryProviderStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderStatsResponse) ProtoMessage()    {}
+func (*QueryProviderStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{7}
+}
+func (m *QueryProviderStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderStatsResponse.Merge(m, src)
+}
+func (m *QueryProviderStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderStatsResponse proto.InternalMessageInfo
+
+func (m *QueryProviderStatsResponse) GetStats() []ProviderAccuracy {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// ProviderAccuracy defines the accuracy statistics of a feed provider
+type ProviderAccuracy struct {
+	Provider         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=provider,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"provider,omitempty"`
+	ResponseCount    uint64                                        `protobuf:"varint,2,opt,name=response_count,json=responseCount,proto3" json:"response_count,omitempty" yaml:"response_count"`
+	MissedCount      uint64                                        `protobuf:"varint,3,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty" yaml:"missed_count"`
+	AverageLatency   github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,4,opt,name=average_latency,json=averageLatency,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_latency" yaml:"average_latency"`
+	AverageDeviation github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,5,opt,name=average_deviation,json=averageDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_deviation" yaml:"average_deviation"`
+	MaxDeviation     github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,6,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation" yaml:"max_deviation"`
+}
+
+func (m *ProviderAccuracy) Reset()         { *m = ProviderAccuracy{} }
+func (m *ProviderAccuracy) String() string { return proto.CompactTextString(m) }
+func (*ProviderAccuracy) ProtoMessage()    {}
+func (*ProviderAccuracy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{8}
+}
+func (m *ProviderAccuracy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderAccuracy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderAccuracy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderAccuracy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderAccuracy.Merge(m, src)
+}
+func (m *ProviderAccuracy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderAccuracy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderAccuracy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderAccuracy proto.InternalMessageInfo
+
+func (m *ProviderAccuracy) GetProvider() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+func (m *ProviderAccuracy) GetResponseCount() uint64 {
+	if m != nil {
+		return m.ResponseCount
+	}
+	return 0
+}
+
+func (m *ProviderAccuracy) GetMissedCount() uint64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
 // FeedContext defines the feed context struct
 type FeedContext struct {
 	Feed              *Feed                                           `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
@@ -318,7 +529,7 @@ type FeedContext struct {
 func (m *FeedContext) Reset()      { *m = FeedContext{} }
 func (*FeedContext) ProtoMessage() {}
 func (*FeedContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{6}
+	return fileDescriptor_562b782cb9ac197e, []int{9}
 }
 func (m *FeedContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,57 +628,83 @@ func init() {
 	proto.RegisterType((*QueryFeedsResponse)(nil), "irishub.oracle.QueryFeedsResponse")
 	proto.RegisterType((*QueryFeedValueRequest)(nil), "irishub.oracle.QueryFeedValueRequest")
 	proto.RegisterType((*QueryFeedValueResponse)(nil), "irishub.oracle.QueryFeedValueResponse")
+	proto.RegisterType((*QueryProviderStatsRequest)(nil), "irishub.oracle.QueryProviderStatsRequest")
+	proto.RegisterType((*QueryProviderStatsResponse)(nil), "irishub.oracle.QueryProviderStatsResponse")
+	proto.RegisterType((*ProviderAccuracy)(nil), "irishub.oracle.ProviderAccuracy")
 	proto.RegisterType((*FeedContext)(nil), "irishub.oracle.FeedContext")
 }
 
 func init() { proto.RegisterFile("oracle/query.proto", fileDescriptor_562b782cb9ac197e) }
 
 var fileDescriptor_562b782cb9ac197e = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0x49, 0x02, 0x64, 0x02, 0xbc, 0xc7, 0xf0, 0x65, 0x82, 0x5e, 0xe2, 0x67, 0xbd, 0x57,
-	0xb9, 0x0b, 0x6c, 0x41, 0x5b, 0x55, 0xca, 0xaa, 0x04, 0x09, 0xa9, 0xa8, 0xf4, 0xc3, 0xad, 0xba,
-	0x40, 0x95, 0x22, 0xc7, 0xbe, 0x01, 0xab, 0xb1, 0xc7, 0x78, 0x26, 0x51, 0xf3, 0x07, 0xba, 0xab,
-	0xd4, 0x65, 0x97, 0x5d, 0xf7, 0x97, 0xb0, 0x64, 0xd9, 0x55, 0x5a, 0x85, 0x7f, 0xc0, 0xb2, 0xab,
-	0x6a, 0x3c, 0x33, 0x34, 0x49, 0x81, 0xb2, 0xb2, 0xe7, 0xde, 0x73, 0xcf, 0x3d, 0x33, 0x73, 0xee,
-	0x20, 0x4c, 0x52, 0xcf, 0xef, 0x80, 0x73, 0xd2, 0x85, 0xb4, 0x6f, 0x27, 0x29, 0x61, 0x04, 0x2f,
-	0x84, 0x69, 0x48, 0x8f, 0xbb, 0x2d, 0x5b, 0xe4, 0x2a, 0x4b, 0x12, 0x23, 0x3e, 0x02, 0x54, 0x59,
-	0xe1, 0xa0, 0x88, 0x04, 0x0e, 0x85, 0xb4, 0x17, 0xfa, 0x2a, 0xbc, 0x7c, 0x44, 0x8e, 0x48, 0xf6,
-	0xeb, 0xf0, 0x3f, 0x19, 0x5d, 0xf7, 0x09, 0x8d, 0x08, 0x6d, 0x8a, 0x84, 0x58, 0xc8, 0xd4, 0xda,
-	0x44, 0x2a, 0x8c, 0x45, 0xc2, 0x74, 0xd0, 0xdf, 0x2f, 0xb8, 0xa8, 0x3d, 0x80, 0xc0, 0x85, 0x93,
-	0x2e, 0x50, 0x86, 0x37, 0x50, 0xa9, 0x0d, 0x10, 0x34, 0x63, 0x2f, 0x02, 0x5d, 0x33, 0x34, 0xab,
-	0xe4, 0xce, 0xf2, 0xc0, 0x53, 0x2f, 0x02, 0x73, 0x1f, 0x2d, 0x8e, 0x14, 0xd0, 0x84, 0xc4, 0x14,
-	0xf0, 0x03, 0x54, 0xe0, 0x80, 0x0c, 0x5c, 0xde, 0xde, 0xb0, 0xc7, 0xb7, 0x66, 0x73, 0xec, 0x2e,
-	0x89, 0x19, 0xbc, 0x63, 0x8d, 0xc2, 0xe9, 0xa0, 0x96, 0x73, 0x33, 0xb8, 0x79, 0x77, 0x84, 0x8b,
-	0xaa, 0xee, 0xcb, 0xa8, 0x48, 0x99, 0xc7, 0x54, 0x67, 0xb1, 0x30, 0x0f, 0x10, 0x1e, 0x85, 0xca,
-	0xbe, 0x0f, 0x51, 0x91, 0x13, 0x51, 0x5d, 0x33, 0xf2, 0xb7, 0x6b, 0x2c, 0xf0, 0xe6, 0x7d, 0xb4,
-	0x72, 0x49, 0xf7, 0xda, 0xeb, 0x74, 0xe1, 0x56, 0x7b, 0x3f, 0x44, 0xab, 0x93, 0x55, 0x52, 0xc8,
-	0x23, 0x54, 0xce, 0xca, 0x7a, 0x3c, 0xaa, 0xe4, 0xac, 0x5f, 0x25, 0x27, 0xab, 0x93, 0x62, 0x50,
-	0x5b, 0x05, 0xa8, 0x39, 0x2c, 0xa0, 0xf2, 0x88, 0x5c, 0x6c, 0x8d, 0x1d, 0xe9, 0xf2, 0x55, 0x54,
-	0xe2, 0x14, 0x71, 0x1d, 0xcd, 0x49, 0x77, 0x08, 0xd5, 0x53, 0x5c, 0x75, 0x63, 0xed, 0x62, 0x50,
-	0x5b, 0xea, 0x7b, 0x51, 0xa7, 0x6e, 0x8e, 0x66, 0x4d, 0xb7, 0x2c, 0x97, 0x7c, 0x47, 0xf8, 0x19,
-	0x2a, 0x25, 0x29, 0xe9, 0x85, 0x01, 0xa4, 0x54, 0xcf, 0x1b, 0x79, 0x6b, 0xae, 0xb1, 0xf5, 0x63,
-	0x50, 0xdb, 0x3c, 0x0a, 0x19, 0x6f, 0xe6, 0x93, 0x48, 0xfa, 0x48, 0x7e, 0x36, 0x69, 0xf0, 0xd6,
-	0x61, 0xfd, 0x04, 0xa8, 0xbd, 0xe3, 0xfb, 0x3b, 0x41, 0x90, 0x02, 0xa5, 0xee, 0x2f, 0x0e, 0x7e,
-	0x7b, 0x61, 0x9c, 0x74, 0x99, 0x5e, 0x10, 0xb7, 0x97, 0x2d, 0xb0, 0x8e, 0x66, 0x58, 0x18, 0x01,
-	0xe9, 0x32, 0xbd, 0x68, 0x68, 0x56, 0xde, 0x55, 0x4b, 0xfc, 0x41, 0x43, 0x7f, 0x29, 0x7d, 0x6d,
-	0x80, 0xa6, 0xef, 0x25, 0xfa, 0xb4, 0x3c, 0x3d, 0xe9, 0xe0, 0x96, 0x47, 0xc1, 0xee, 0x6d, 0xb5,
-	0x80, 0x79, 0x5b, 0xf6, 0x2e, 0x09, 0xe3, 0xc6, 0x3e, 0x3f, 0xbd, 0x8b, 0x41, 0x6d, 0x75, 0x7c,
-	0x7f, 0xb2, 0xde, 0xfc, 0xf2, 0xad, 0x66, 0xdd, 0x62, 0x03, 0x9c, 0x8a, 0xba, 0xf3, 0xb2, 0x7a,
-	0x0f, 0x60, 0xd7, 0x4b, 0xf0, 0x13, 0x84, 0x53, 0x48, 0xc0, 0x63, 0x10, 0x34, 0xdb, 0x29, 0x37,
-	0x45, 0xec, 0xf7, 0xf5, 0x19, 0x43, 0xb3, 0x0a, 0x8d, 0x7f, 0x2e, 0x06, 0xb5, 0x75, 0xd1, 0xf2,
-	0x77, 0x8c, 0xe9, 0x2e, 0xaa, 0xe0, 0x9e, 0x8a, 0x09, 0x36, 0x61, 0x91, 0x26, 0x3b, 0x4e, 0x81,
-	0x1e, 0x93, 0x4e, 0xa0, 0xcf, 0x1a, 0x9a, 0x35, 0x3f, 0xce, 0x36, 0x89, 0xc9, 0xd8, 0x44, 0xf0,
-	0x95, 0x8a, 0xe1, 0xba, 0x9a, 0x8c, 0x92, 0xa1, 0x59, 0x0b, 0xdb, 0xff, 0xd9, 0xf2, 0x71, 0xb0,
-	0xd5, 0xe3, 0x20, 0x4d, 0x2c, 0x2d, 0xf4, 0x92, 0x63, 0xe5, 0xfc, 0xd4, 0x0b, 0x9f, 0x3e, 0xd7,
-	0x72, 0xdb, 0xef, 0xa7, 0x50, 0x31, 0x73, 0x30, 0x3e, 0x40, 0x05, 0x6e, 0x21, 0x6c, 0x4c, 0x1a,
-	0x6b, 0xf2, 0x35, 0xa8, 0xfc, 0x7b, 0x03, 0x42, 0x28, 0x34, 0x73, 0xf8, 0x39, 0x2a, 0x66, 0x93,
-	0x89, 0xaf, 0x47, 0xab, 0x01, 0xaf, 0x98, 0x37, 0x41, 0x2e, 0x19, 0xdf, 0xa0, 0xd2, 0xe5, 0xb8,
-	0xe0, 0xff, 0xaf, 0x2d, 0x19, 0x1d, 0xde, 0xca, 0x9d, 0x3f, 0xc1, 0x14, 0x7b, 0xe3, 0xf1, 0xe9,
-	0xb0, 0xaa, 0x9d, 0x0d, 0xab, 0xda, 0xf7, 0x61, 0x55, 0xfb, 0x78, 0x5e, 0xcd, 0x9d, 0x9d, 0x57,
-	0x73, 0x5f, 0xcf, 0xab, 0xb9, 0x43, 0x67, 0xc4, 0x39, 0x9c, 0x2d, 0x06, 0xe6, 0x48, 0x56, 0x27,
-	0x22, 0x41, 0xb7, 0x03, 0x54, 0x3e, 0xd1, 0xc2, 0x46, 0xad, 0xe9, 0xec, 0x21, 0xbd, 0xf7, 0x33,
-	0x00, 0x00, 0xff, 0xff, 0x8f, 0x41, 0x57, 0x20, 0xe4, 0x05, 0x00, 0x00,
+	// 1072 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
+	0x13, 0x16, 0x2d, 0xc9, 0xb6, 0xd6, 0x7f, 0x62, 0x6f, 0xec, 0x84, 0x52, 0x60, 0x51, 0x3f, 0xe2,
+	0xd7, 0x40, 0x29, 0x10, 0x12, 0x76, 0x51, 0xb4, 0x31, 0x5a, 0x20, 0x96, 0x03, 0xa3, 0x0d, 0xe2,
+	0xd6, 0x65, 0x83, 0x1e, 0x82, 0x02, 0xc2, 0x8a, 0x5c, 0xc9, 0x44, 0x44, 0x2e, 0xcd, 0x5d, 0xaa,
+	0xd6, 0x43, 0x14, 0xc8, 0xa9, 0xe8, 0xb1, 0x40, 0x6f, 0x7d, 0x82, 0x3e, 0x42, 0x8e, 0x39, 0x16,
+	0x3d, 0x28, 0x85, 0xfd, 0x02, 0x85, 0x8f, 0x3d, 0x15, 0xfb, 0x4f, 0xa6, 0x54, 0x25, 0x55, 0x7a,
+	0x92, 0x76, 0xe6, 0xfb, 0x66, 0x66, 0x87, 0xdf, 0xce, 0x2e, 0x80, 0x24, 0x45, 0x7e, 0x1f, 0xbb,
+	0x67, 0x19, 0x4e, 0x87, 0x4e, 0x92, 0x12, 0x46, 0xe0, 0x7a, 0x98, 0x86, 0xf4, 0x34, 0xeb, 0x38,
+	0xd2, 0x57, 0xbb, 0xa9, 0x30, 0xf2, 0x47, 0x82, 0x6a, 0xdb, 0x1c, 0x14, 0x91, 0xc0, 0xa5, 0x38,
+	0x1d, 0x84, 0xbe, 0x36, 0x6f, 0xf5, 0x48, 0x8f, 0x88, 0xbf, 0x2e, 0xff, 0xa7, 0xac, 0x55, 0x9f,
+	0xd0, 0x88, 0xd0, 0xb6, 0x74, 0xc8, 0x85, 0x72, 0xdd, 0x9e, 0x72, 0x85, 0xb1, 0x72, 0xec, 0x48,
+	0x87, 0xac, 0xcc, 0x4d, 0x50, 0x2f, 0x8c, 0x11, 0x0b, 0x89, 0x76, 0x5b, 0x3d, 0x42, 0x7a, 0x7d,
+	0xec, 0x8a, 0x55, 0x27, 0xeb, 0xba, 0x2c, 0x8c, 0x30, 0x65, 0x28, 0x4a, 0x24, 0xc0, 0x76, 0xc1,
+	0xc6, 0x57, 0x9c, 0x7a, 0x84, 0x71, 0xe0, 0xe1, 0xb3, 0x0c, 0x53, 0x06, 0xef, 0x80, 0x4a, 0x17,
+	0xe3, 0xa0, 0x1d, 0xa3, 0x08, 0x9b, 0x46, 0xc3, 0x68, 0x56, 0xbc, 0x65, 0x6e, 0xf8, 0x02, 0x45,
+	0xd8, 0x7e, 0x0c, 0x36, 0x73, 0x04, 0x9a, 0x90, 0x98, 0x62, 0xf8, 0x21, 0x28, 0x71, 0x80, 0x00,
+	0xaf, 0xec, 0xdd, 0x71, 0x26, 0x5b, 0xe3, 0x70, 0xec, 0x21, 0x89, 0x19, 0x3e, 0x67, 0xad, 0xd2,
+	0xcb, 0x91, 0x55, 0xf0, 0x04, 0xdc, 0xbe, 0x97, 0x8b, 0x45, 0x75, 0xf6, 0x2d, 0x50, 0xa6, 0x0c,
+	0x31, 0x9d, 0x59, 0x2e, 0xec, 0x63, 0x00, 0xf3, 0x50, 0x95, 0xf7, 0x23, 0x50, 0xe6, 0x81, 0xa8,
+	0x69, 0x34, 0x8a, 0xf3, 0x25, 0x96, 0x78, 0xfb, 0xe7, 0x05, 0xb0, 0x3d, 0x8e, 0xf7, 0x0d, 0xea,
+	0x67, 0x78, 0x9e, 0xcd, 0xc3, 0x4f, 0x41, 0xa5, 0x9b, 0x92, 0xa8, 0xcd, 0xbb, 0x68, 0x2e, 0x88,
+	0xcd, 0xd6, 0x1c, 0xd9, 0x62, 0x47, 0xb7, 0xd8, 0x79, 0xaa, 0x5b, 0xdc, 0x2a, 0xbd, 0x78, 0x6d,
+	0x19, 0xde, 0x32, 0xa7, 0x70, 0x23, 0x7c, 0x00, 0x96, 0x18, 0x91, 0xe4, 0xe2, 0x9c, 0xe4, 0x45,
+	0x46, 0x04, 0x75, 0x07, 0x00, 0x91, 0xb9, 0x83, 0x98, 0x7f, 0x6a, 0x96, 0x1a, 0x46, 0xb3, 0xe4,
+	0x89, 0x5a, 0x5a, 0xdc, 0x00, 0xab, 0x60, 0x99, 0x11, 0xe5, 0x2c, 0x0b, 0xe7, 0x12, 0x23, 0xd2,
+	0xf5, 0x00, 0x80, 0x6b, 0x59, 0x98, 0x8b, 0x22, 0x6f, 0xd5, 0x51, 0xea, 0x92, 0x82, 0x3e, 0x41,
+	0x3d, 0xbd, 0x7f, 0x2f, 0x07, 0xb6, 0x7f, 0x30, 0xc0, 0xad, 0xe9, 0x2e, 0xa9, 0xce, 0x3f, 0x04,
+	0x2b, 0xa2, 0x4d, 0x03, 0x6e, 0xd5, 0xfd, 0xaf, 0xce, 0xea, 0xbf, 0xe0, 0xa9, 0xee, 0x83, 0xae,
+	0x36, 0x50, 0xb8, 0x3f, 0x51, 0x97, 0x6e, 0xe6, 0x8c, 0xba, 0x64, 0xc6, 0x89, 0xc2, 0x3e, 0x06,
+	0x55, 0x51, 0xd7, 0x49, 0x4a, 0x06, 0x61, 0x80, 0xd3, 0xaf, 0x19, 0x62, 0x74, 0x2e, 0xf9, 0x3e,
+	0x03, 0xb5, 0x59, 0x4c, 0xb5, 0xab, 0x4f, 0xa4, 0xf6, 0xf4, 0x7e, 0x1a, 0xd3, 0xfb, 0xd1, 0xac,
+	0x03, 0xdf, 0xcf, 0x52, 0xe4, 0x0f, 0xb5, 0xa8, 0x04, 0xc9, 0xfe, 0xb5, 0x04, 0x36, 0xa6, 0x11,
+	0xf0, 0x18, 0x2c, 0x27, 0xca, 0x26, 0x8a, 0x59, 0x6d, 0xed, 0xfe, 0x35, 0xb2, 0xee, 0xf7, 0x42,
+	0xc6, 0xe3, 0xfa, 0x24, 0x52, 0x07, 0x5d, 0xfd, 0xdc, 0xa7, 0xc1, 0x73, 0x97, 0x0d, 0x13, 0x4c,
+	0x9d, 0x03, 0xdf, 0x3f, 0x08, 0x82, 0x14, 0x53, 0xea, 0x8d, 0x43, 0xc0, 0x87, 0x60, 0x3d, 0x55,
+	0xd5, 0xb6, 0x7d, 0x92, 0xc5, 0x4c, 0x74, 0xae, 0xd4, 0xaa, 0x5e, 0x8d, 0xac, 0xed, 0x21, 0x8a,
+	0xfa, 0xfb, 0xf6, 0xa4, 0xdf, 0xf6, 0xd6, 0xb4, 0xe1, 0x90, 0xaf, 0xe1, 0x3e, 0x58, 0x8d, 0x42,
+	0x4a, 0x71, 0xa0, 0xf8, 0x45, 0xc1, 0xbf, 0x7d, 0x35, 0xb2, 0x6e, 0x4a, 0x7e, 0xde, 0x6b, 0x7b,
+	0x2b, 0x72, 0x29, 0xb9, 0x67, 0xe0, 0x06, 0x1a, 0xe0, 0x14, 0xf5, 0x70, 0xbb, 0x8f, 0x18, 0x8e,
+	0xfd, 0xa1, 0x90, 0x62, 0xa5, 0xf5, 0x19, 0xef, 0xc3, 0xef, 0x23, 0xeb, 0xee, 0x1c, 0xfb, 0x7a,
+	0x84, 0xfd, 0xab, 0x91, 0x75, 0x4b, 0x26, 0x9b, 0x0a, 0x67, 0x7b, 0xeb, 0xca, 0xf2, 0x44, 0x1a,
+	0xe0, 0x77, 0x60, 0x53, 0x63, 0x02, 0x3c, 0x08, 0xa5, 0x5a, 0xca, 0x22, 0xe9, 0xe3, 0x77, 0x4e,
+	0x6a, 0x4e, 0x26, 0x1d, 0x07, 0xb4, 0xbd, 0x0d, 0x65, 0x7b, 0xa4, 0x4d, 0xf0, 0x39, 0x58, 0x8b,
+	0xd0, 0x79, 0x2e, 0xe9, 0xa2, 0x48, 0x7a, 0xf4, 0xce, 0x49, 0xb7, 0x54, 0x5b, 0xf3, 0xc1, 0x6c,
+	0x6f, 0x35, 0x42, 0xe7, 0xe3, 0x64, 0xf6, 0x45, 0x09, 0xac, 0xe4, 0x86, 0x15, 0x6c, 0x4e, 0x0c,
+	0xd4, 0xad, 0x59, 0xe7, 0x4a, 0xce, 0x50, 0xfe, 0x39, 0xd5, 0xdd, 0x22, 0x05, 0xbf, 0x20, 0xaa,
+	0xcc, 0x7d, 0xce, 0xbc, 0xd7, 0xf6, 0x56, 0xd4, 0x52, 0x8c, 0xb3, 0x2f, 0x41, 0x45, 0x0b, 0x8b,
+	0x9a, 0xc5, 0x46, 0xf1, 0xbf, 0x89, 0xf3, 0x3a, 0x06, 0x9f, 0xdd, 0x61, 0x9c, 0x64, 0x4c, 0xaa,
+	0xc2, 0x93, 0x0b, 0x68, 0x82, 0x25, 0x3e, 0xf3, 0x48, 0xc6, 0xc4, 0x87, 0x2b, 0x7a, 0x7a, 0x09,
+	0xbf, 0x37, 0xc0, 0x0d, 0x5d, 0x5f, 0x17, 0xe3, 0xb6, 0x8f, 0x12, 0x73, 0x51, 0x8d, 0x12, 0x35,
+	0x09, 0x3a, 0x88, 0x62, 0x67, 0xb0, 0xdb, 0xc1, 0x0c, 0xed, 0x3a, 0x87, 0x24, 0x8c, 0xe5, 0x67,
+	0xbf, 0x56, 0xd0, 0x14, 0xdf, 0xfe, 0xe5, 0xb5, 0xd5, 0x9c, 0x63, 0x03, 0x3c, 0x14, 0xf5, 0xd6,
+	0x14, 0xfb, 0x08, 0xe3, 0x43, 0x94, 0xc0, 0x27, 0x00, 0xa6, 0x38, 0xc1, 0x88, 0xe1, 0xa0, 0xdd,
+	0x4d, 0xf9, 0x3c, 0xe1, 0x12, 0x5f, 0x12, 0x27, 0x64, 0xe7, 0x6a, 0x64, 0x55, 0xf5, 0x09, 0x9b,
+	0xc6, 0xd8, 0xde, 0xa6, 0x36, 0x1e, 0x69, 0x9b, 0x8c, 0xa6, 0xce, 0x22, 0x3b, 0x4d, 0x31, 0x3d,
+	0x25, 0xfd, 0xc0, 0x5c, 0x6e, 0x18, 0xcd, 0xb5, 0xc9, 0x68, 0xd3, 0x18, 0x11, 0x4d, 0x1a, 0x9f,
+	0x6a, 0x1b, 0xdc, 0xd7, 0xf7, 0x62, 0xa5, 0x61, 0x34, 0xd7, 0xf7, 0xfe, 0xef, 0xa8, 0xa7, 0x85,
+	0xa3, 0x9f, 0x16, 0x6a, 0xfe, 0x29, 0x09, 0xf1, 0xc1, 0x86, 0xd5, 0xed, 0xb9, 0x5f, 0xfa, 0xf1,
+	0x27, 0xab, 0xb0, 0xf7, 0xe7, 0x02, 0x28, 0x8b, 0xe1, 0x07, 0x8f, 0x41, 0x89, 0x4b, 0x08, 0xfe,
+	0x63, 0xc0, 0x4d, 0xbf, 0x05, 0x6a, 0xff, 0x7b, 0x0b, 0x42, 0x56, 0x68, 0x17, 0xe0, 0x09, 0x28,
+	0x73, 0x0b, 0x85, 0x6f, 0x46, 0xeb, 0xe9, 0x5c, 0xb3, 0xdf, 0x06, 0x19, 0x47, 0xfc, 0x16, 0x54,
+	0xc6, 0x77, 0x07, 0x7c, 0xef, 0x8d, 0x94, 0xfc, 0xcd, 0x5d, 0xbb, 0xfb, 0x6f, 0xb0, 0x71, 0xf4,
+	0x53, 0xb0, 0x36, 0x31, 0xff, 0xe1, 0xbd, 0x99, 0xd4, 0x59, 0xb7, 0x4b, 0xed, 0xfd, 0x79, 0xa0,
+	0x3a, 0x53, 0xeb, 0xf3, 0x97, 0x17, 0x75, 0xe3, 0xd5, 0x45, 0xdd, 0xf8, 0xe3, 0xa2, 0x6e, 0xbc,
+	0xb8, 0xac, 0x17, 0x5e, 0x5d, 0xd6, 0x0b, 0xbf, 0x5d, 0xd6, 0x0b, 0xcf, 0xdc, 0x9c, 0x46, 0x79,
+	0xc4, 0x18, 0x33, 0x57, 0x45, 0x76, 0x23, 0x12, 0x64, 0x7d, 0x4c, 0xd5, 0x53, 0x52, 0x0a, 0xb6,
+	0xb3, 0x28, 0xde, 0x08, 0x1f, 0xfc, 0x3d, 0x00, 0x7a, 0xf9, 0xf8, 0xd2, 0x8c, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -488,6 +725,8 @@ type QueryClient interface {
 	Feeds(ctx context.Context, in *QueryFeedsRequest, opts ...grpc.CallOption) (*QueryFeedsResponse, error)
 	// FeedValue queries the feed value
 	FeedValue(ctx context.Context, in *QueryFeedValueRequest, opts ...grpc.CallOption) (*QueryFeedValueResponse, error)
+	// ProviderStats queries the response statistics of the feed providers
+	ProviderStats(ctx context.Context, in *QueryProviderStatsRequest, opts ...grpc.CallOption) (*QueryProviderStatsResponse, error)
 }
 
 type queryClient struct {
@@ -525,6 +764,15 @@ func (c *queryClient) FeedValue(ctx context.Context, in *QueryFeedValueRequest,
 	return out, nil
 }
 
+func (c *queryClient) ProviderStats(ctx context.Context, in *QueryProviderStatsRequest, opts ...grpc.CallOption) (*QueryProviderStatsResponse, error) {
+	out := new(QueryProviderStatsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.oracle.Query/ProviderStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Feed queries the feed
@@ -533,6 +781,8 @@ type QueryServer interface {
 	Feeds(context.Context, *QueryFeedsRequest) (*QueryFeedsResponse, error)
 	// FeedValue queries the feed value
 	FeedValue(context.Context, *QueryFeedValueRequest) (*QueryFeedValueResponse, error)
+	// ProviderStats queries the response statistics of the feed providers
+	ProviderStats(context.Context, *QueryProviderStatsRequest) (*QueryProviderStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -548,6 +798,9 @@ func (*UnimplementedQueryServer) Feeds(ctx context.Context, req *QueryFeedsReque
 func (*UnimplementedQueryServer) FeedValue(ctx context.Context, req *QueryFeedValueRequest) (*QueryFeedValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeedValue not implemented")
 }
+func (*UnimplementedQueryServer) ProviderStats(ctx context.Context, req *QueryProviderStatsRequest) (*QueryProviderStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -607,6 +860,24 @@ func _Query_FeedValue_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProviderStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProviderStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.oracle.Query/ProviderStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProviderStats(ctx, req.(*QueryProviderStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "irishub.oracle.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -623,6 +894,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FeedValue",
 			Handler:    _Query_FeedValue_Handler,
 		},
+		{
+			MethodName: "ProviderStats",
+			Handler:    _Query_ProviderStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oracle/query.proto",
@@ -778,6 +1053,48 @@ func (m *QueryFeedValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ToBatch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToBatch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FromBatch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromBatch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ToTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ToTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ToTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FromTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FromTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FromTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintQuery(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.FeedName) > 0 {
 		i -= len(m.FeedName)
 		copy(dAtA[i:], m.FeedName)
@@ -808,6 +1125,18 @@ func (m *QueryFeedValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.FeedValues) > 0 {
 		for iNdEx := len(m.FeedValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -825,7 +1154,7 @@ func (m *QueryFeedValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *FeedContext) Marshal() (dAtA []byte, err error) {
+func (m *QueryProviderStatsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -835,35 +1164,50 @@ func (m *FeedContext) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeedContext) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProviderStatsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeedContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProviderStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.State != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.ResponseThreshold != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseThreshold))
+	if len(m.FeedName) > 0 {
+		i -= len(m.FeedName)
+		copy(dAtA[i:], m.FeedName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedName)))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0xa
 	}
-	if m.RepeatedFrequency != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RepeatedFrequency))
-		i--
-		dAtA[i] = 0x38
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ServiceFeeCap) > 0 {
-		for iNdEx := len(m.ServiceFeeCap) - 1; iNdEx >= 0; iNdEx-- {
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ServiceFeeCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -871,24 +1215,146 @@ func (m *FeedContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Timeout != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x28
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderAccuracy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Input) > 0 {
-		i -= len(m.Input)
-		copy(dAtA[i:], m.Input)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Input)))
-		i--
-		dAtA[i] = 0x22
+	return dAtA[:n], nil
+}
+
+func (m *ProviderAccuracy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderAccuracy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.Providers) > 0 {
-		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Providers[iNdEx])
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AverageDeviation.Size()
+		i -= size
+		if _, err := m.AverageDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AverageLatency.Size()
+		i -= size
+		if _, err := m.AverageLatency.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MissedCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ResponseCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedContext) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedContext) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ResponseThreshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResponseThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RepeatedFrequency != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RepeatedFrequency))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ServiceFeeCap) > 0 {
+		for iNdEx := len(m.ServiceFeeCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServiceFeeCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Providers[iNdEx])
 			copy(dAtA[i:], m.Providers[iNdEx])
 			i = encodeVarintQuery(dAtA, i, uint64(len(m.Providers[iNdEx])))
 			i--
@@ -990,6 +1456,24 @@ func (m *QueryFeedValueRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.FromTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FromTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ToTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ToTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromBatch != 0 {
+		n += 1 + sovQuery(uint64(m.FromBatch))
+	}
+	if m.ToBatch != 0 {
+		n += 1 + sovQuery(uint64(m.ToBatch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1005,6 +1489,63 @@ func (m *QueryFeedValueResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderAccuracy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ResponseCount != 0 {
+		n += 1 + sovQuery(uint64(m.ResponseCount))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovQuery(uint64(m.MissedCount))
+	}
+	l = m.AverageLatency.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AverageDeviation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1463,62 +2004,45 @@ func (m *QueryFeedValueRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.FeedName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFeedValueResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.FromTime == nil {
+				m.FromTime = new(time.Time)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FromTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeedValueResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeedValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1545,8 +2069,606 @@ func (m *QueryFeedValueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedValues = append(m.FeedValues, FeedValue{})
-			if err := m.FeedValues[len(m.FeedValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ToTime == nil {
+				m.ToTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ToTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromBatch", wireType)
+			}
+			m.FromBatch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromBatch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBatch", wireType)
+			}
+			m.ToBatch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToBatch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeedValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeedValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeedValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedValues = append(m.FeedValues, FeedValue{})
+			if err := m.FeedValues[len(m.FeedValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, ProviderAccuracy{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderAccuracy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderAccuracy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderAccuracy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = append(m.Provider[:0], dAtA[iNdEx:postIndex]...)
+			if m.Provider == nil {
+				m.Provider = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseCount", wireType)
+			}
+			m.ResponseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
+			}
+			m.MissedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageLatency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageLatency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
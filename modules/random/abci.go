@@ -16,6 +16,9 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	lastBlockHeight := ctx.BlockHeight() - 1
 	lastBlockHash := ctx.BlockHeader().LastBlockId.Hash
 
+	// prune the random numbers whose retention window has passed
+	k.PruneRandoms(ctx)
+
 	// get pending random number requests for lastBlockHeight
 	rqIterator := k.IterateRandomRequestQueueByHeight(ctx, lastBlockHeight)
 	defer rqIterator.Close()
@@ -44,16 +47,72 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 				)
 			} else {
 				ctx.Logger().Info(fmt.Sprintf("start service error : %s", err.Error()))
+				k.FailOracleRandRequest(ctx, request, err.Error())
+			}
+
+			k.DequeueRandomRequest(ctx, lastBlockHeight, reqID)
+		} else if request.VRF {
+			// get the request id
+			reqID := types.GenerateRequestID(request)
+
+			// remove the request, it is enqueued again to expire at the end of the proof timeout
+			k.DequeueRandomRequest(ctx, lastBlockHeight, reqID)
+
+			if request.VRFExpirationHeight == 0 {
+				// fix the VRF input, the random number is generated when the proof is submitted
+				request = k.OpenVRFRequest(ctx, reqID, request, lastBlockHash)
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeRequestVRFProof,
+						sdk.NewAttribute(types.AttributeKeyRequestID, hex.EncodeToString(reqID)),
+						sdk.NewAttribute(types.AttributeKeyVRFInput, request.VRFInput.String()),
+						sdk.NewAttribute(types.AttributeKeyExpirationHeight, fmt.Sprintf("%d", request.VRFExpirationHeight)),
+					),
+				)
+			} else if _, err := k.GetVRFRandomRequest(ctx, reqID); err == nil {
+				k.ExpireVRFRequest(ctx, reqID, request)
 			}
+		} else if request.Beacon {
+			// get the request id
+			reqID := types.GenerateRequestID(request)
 
+			// remove the request, it is enqueued again at the end of the reveal window
 			k.DequeueRandomRequest(ctx, lastBlockHeight, reqID)
+
+			if request.RevealEndHeight == 0 {
+				request = k.OpenBeaconRound(ctx, reqID, request)
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeOpenBeacon,
+						sdk.NewAttribute(types.AttributeKeyRequestID, hex.EncodeToString(reqID)),
+						sdk.NewAttribute(types.AttributeKeyCommitEndHeight, fmt.Sprintf("%d", request.CommitEndHeight)),
+						sdk.NewAttribute(types.AttributeKeyRevealEndHeight, fmt.Sprintf("%d", request.RevealEndHeight)),
+					),
+				)
+			} else {
+				random := k.FinalizeBeaconRound(ctx, reqID, request, lastBlockHash)
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeGenerateRandom,
+						sdk.NewAttribute(types.AttributeKeyRequestID, hex.EncodeToString(reqID)),
+						sdk.NewAttribute(types.AttributeKeyRandom, random.Value),
+					),
+				)
+
+				k.AfterRandomGenerated(ctx, reqID, request, random)
+			}
 		} else {
 			// get the request id
 			reqID := types.GenerateRequestID(request)
 
 			// generate a random number
-			random := types.MakePRNG(lastBlockHash, currentTimestamp, request.Consumer, nil, false).GetRand()
-			k.SetRandom(ctx, reqID, types.NewRandom(request.TxHash, lastBlockHeight, random.FloatString(types.RandPrec)))
+			prng := types.MakePRNG(lastBlockHash, currentTimestamp, request.Consumer, reqID, nil, false)
+			random := prng.GetRand()
+			result := types.NewRandom(request.TxHash, lastBlockHeight, random.FloatString(types.RandPrec))
+			result = k.StoreRandom(ctx, reqID, request, result, prng.GetSeed())
 
 			// remove the request
 			k.DequeueRandomRequest(ctx, lastBlockHeight, reqID)
@@ -65,6 +124,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 					sdk.NewAttribute(types.AttributeKeyRandom, random.String()),
 				),
 			)
+
+			k.AfterRandomGenerated(ctx, reqID, request, result)
 			handledNormalRandReqNum++
 		}
 	}
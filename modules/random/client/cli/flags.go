@@ -3,6 +3,8 @@ package cli
 import (
 	flag "github.com/spf13/pflag"
 
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+
 	randomtypes "github.com/irisnet/irishub/modules/random/types"
 )
 
@@ -10,16 +12,45 @@ const (
 	FlagReqID         = "request-id"
 	FlagBlockInterval = "block-interval"
 	FlagOracle        = "oracle"
+	FlagVRF           = "vrf"
+	FlagBeacon        = "beacon"
 	FlagServiceFeeCap = "service-fee-cap"
 	FlagQueueHeight   = "queue-height"
+	FlagOutputType    = "output-type"
+	FlagCount         = "count"
+	FlagRangeMin      = "range-min"
+	FlagRangeMax      = "range-max"
+	FlagPageKey       = "page-key"
+	FlagOffset        = "offset"
+	FlagLimit         = "limit"
+	FlagCountTotal    = "count-total"
+	FlagVRFPublicKey  = "vrf-public-key"
 )
 
 var (
-	FsRequestRand = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRequestRand           = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryConsumerRequests = flag.NewFlagSet("", flag.ContinueOnError)
+	FsUpdateVRFKeyProposal  = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsRequestRand.Uint64(FlagBlockInterval, randomtypes.DefaultBlockInterval, "the block interval")
 	FsRequestRand.Bool(FlagOracle, false, "woth oracle method")
+	FsRequestRand.Bool(FlagVRF, false, "with VRF method, the random number is generated when the proof of the designated VRF key is submitted")
+	FsRequestRand.Bool(FlagBeacon, false, "with beacon method, the random number mixes the secrets revealed by the beacon participants")
 	FsRequestRand.String(FlagServiceFeeCap, "", "maximal fee to pay for a service request")
+	FsRequestRand.String(FlagOutputType, "decimal", "type of the random values, decimal in [0,1), integer in [range-min,range-max) or raw 32 bytes")
+	FsRequestRand.Uint32(FlagCount, 1, "number of the random values")
+	FsRequestRand.Uint64(FlagRangeMin, 0, "inclusive lower bound of the integer values")
+	FsRequestRand.Uint64(FlagRangeMax, 0, "exclusive upper bound of the integer values")
+
+	FsQueryConsumerRequests.String(FlagPageKey, "", "The base64 encoded key of the page to query, returned as next_key by the previous query")
+	FsQueryConsumerRequests.Uint64(FlagOffset, 0, "The number of requests to skip, can not be used together with page-key")
+	FsQueryConsumerRequests.Uint64(FlagLimit, 0, "The maximum number of requests to return, default to 100")
+	FsQueryConsumerRequests.Bool(FlagCountTotal, false, "Count the total number of requests of the consumer")
+
+	FsUpdateVRFKeyProposal.String(govcli.FlagTitle, "", "title of proposal")
+	FsUpdateVRFKeyProposal.String(govcli.FlagDescription, "", "description of proposal")
+	FsUpdateVRFKeyProposal.String(govcli.FlagDeposit, "", "deposit of proposal")
+	FsUpdateVRFKeyProposal.String(FlagVRFPublicKey, "", "hex encoded DER (PKIX) RSA public key designated to prove the VRF requests")
 }
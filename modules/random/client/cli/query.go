@@ -2,13 +2,17 @@ package cli
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strconv"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/irisnet/irishub/modules/random/types"
@@ -26,7 +30,12 @@ func GetQueryCmd() *cobra.Command {
 	}
 	randQueryCmd.AddCommand(
 		GetCmdQueryRandom(),
+		GetCmdQueryRandomValue(),
 		GetCmdQueryRandomRequestQueue(),
+		GetCmdQueryConsumerRequests(),
+		GetCmdQueryVerifyRandom(),
+		GetCmdQueryPrunedCommitment(),
+		GetCmdQueryParams(),
 	)
 	return randQueryCmd
 }
@@ -63,6 +72,46 @@ func GetCmdQueryRandom() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryRandomValue implements the query value command.
+func GetCmdQueryRandomValue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "value [request-id] [index]",
+		Short:   "Query a random value by the request id and the index",
+		Example: fmt.Sprintf("%s query random value <request id> <index>", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := types.CheckReqID(args[0]); err != nil {
+				return err
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RandomValue(
+				context.Background(),
+				&types.QueryRandomValueRequest{ReqId: args[0], Index: uint32(index)},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryRandomRequestQueue implements the query queue command.
 func GetCmdQueryRandomRequestQueue() *cobra.Command {
 	cmd := &cobra.Command{
@@ -101,3 +150,149 @@ func GetCmdQueryRandomRequestQueue() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryConsumerRequests implements the query consumer-requests command.
+func GetCmdQueryConsumerRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consumer-requests [consumer]",
+		Short:   "Query the pending and fulfilled random requests of a consumer with their status",
+		Example: fmt.Sprintf("%s query random consumer-requests <consumer> [--offset=<offset>] [--limit=<limit>]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerRequestsRequest{
+				Consumer: args[0],
+				Pagination: &query.PageRequest{
+					Offset:     viper.GetUint64(FlagOffset),
+					Limit:      viper.GetUint64(FlagLimit),
+					CountTotal: viper.GetBool(FlagCountTotal),
+				},
+			}
+
+			if str := viper.GetString(FlagPageKey); len(str) > 0 {
+				key, err := base64.StdEncoding.DecodeString(str)
+				if err != nil {
+					return fmt.Errorf("invalid page key: %s", err)
+				}
+				req.Pagination.Key = key
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ConsumerRequests(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryConsumerRequests)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVerifyRandom implements the query verify command.
+func GetCmdQueryVerifyRandom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "verify [request-id]",
+		Short:   "Verify the VRF proof of a random number by the request id",
+		Example: fmt.Sprintf("%s query random verify <request id>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if err := types.CheckReqID(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VerifyRandom(context.Background(), &types.QueryVerifyRandomRequest{ReqId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPrunedCommitment implements the query pruned-commitment command.
+func GetCmdQueryPrunedCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pruned-commitment [height]",
+		Short:   "Query the Merkle commitment of the random numbers pruned at the height",
+		Example: fmt.Sprintf("%s query random pruned-commitment <height>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PrunedRandomsCommitment(
+				context.Background(),
+				&types.QueryPrunedRandomsCommitmentRequest{Height: height},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Commitment)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current random parameters",
+		Example: fmt.Sprintf("%s query random params", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -11,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/irisnet/irishub/modules/random/types"
 )
@@ -26,6 +29,11 @@ func GetTxCmd() *cobra.Command {
 	}
 	randTxCmd.AddCommand(
 		GetCmdRequestRandom(),
+		GetCmdSubmitVRFProof(),
+		GetCmdRegisterBeaconParticipant(),
+		GetCmdUnregisterBeaconParticipant(),
+		GetCmdCommitBeacon(),
+		GetCmdRevealBeacon(),
 	)
 	return randTxCmd
 }
@@ -36,7 +44,8 @@ func GetCmdRequestRandom() *cobra.Command {
 		Use:   "request-random",
 		Short: "Request a random number with an optional block interval",
 		Example: fmt.Sprintf(
-			"%s tx random request-random [--block-interval=10] [--oracle=true --service-fee-cap=1iris]",
+			"%s tx random request-random [--block-interval=10] [--oracle=true --service-fee-cap=1iris | --vrf=true | --beacon=true] "+
+				"[--output-type=integer --count=5 --range-min=1 --range-max=50]",
 			version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -57,7 +66,20 @@ func GetCmdRequestRandom() *cobra.Command {
 				}
 			}
 
-			msg := types.NewMsgRequestRandom(consumer, uint64(viper.GetInt64(FlagBlockInterval)), oracle, serviceFeeCap)
+			outputType, err := types.OutputTypeFromString(viper.GetString(FlagOutputType))
+			if err != nil {
+				return err
+			}
+
+			output := types.NewOutputSpec(
+				outputType, viper.GetUint32(FlagCount),
+				viper.GetUint64(FlagRangeMin), viper.GetUint64(FlagRangeMax),
+			)
+
+			msg := types.NewMsgRequestRandom(
+				consumer, uint64(viper.GetInt64(FlagBlockInterval)),
+				oracle, viper.GetBool(FlagVRF), viper.GetBool(FlagBeacon), serviceFeeCap, output,
+			)
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -70,3 +92,225 @@ func GetCmdRequestRandom() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdSubmitVRFProof implements the submit-vrf-proof command.
+func GetCmdSubmitVRFProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-vrf-proof [request-id] [proof]",
+		Short: "Submit the hex encoded VRF proof of a random number request",
+		Example: fmt.Sprintf(
+			"%s tx random submit-vrf-proof <request id> <proof>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proof, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitVRFProof(args[0], proof, clientCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRegisterBeaconParticipant implements the register-beacon-participant command.
+func GetCmdRegisterBeaconParticipant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-beacon-participant [deposit]",
+		Short: "Register as a beacon participant with a deposit",
+		Example: fmt.Sprintf(
+			"%s tx random register-beacon-participant <deposit>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterBeaconParticipant(clientCtx.GetFromAddress(), deposit)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUnregisterBeaconParticipant implements the unregister-beacon-participant command.
+func GetCmdUnregisterBeaconParticipant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unregister-beacon-participant",
+		Short: "Unregister the beacon participant and refund the deposit",
+		Example: fmt.Sprintf(
+			"%s tx random unregister-beacon-participant",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnregisterBeaconParticipant(clientCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCommitBeacon implements the commit-beacon command.
+func GetCmdCommitBeacon() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-beacon [request-id] [secret]",
+		Short: "Commit the hash of a hex encoded secret to a beacon round, the secret itself is not sent",
+		Example: fmt.Sprintf(
+			"%s tx random commit-beacon <request id> <secret>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			secret, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			participant := clientCtx.GetFromAddress()
+			msg := types.NewMsgCommitBeacon(args[0], types.GenerateBeaconCommitment(secret, participant), participant)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRevealBeacon implements the reveal-beacon command.
+func GetCmdRevealBeacon() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-beacon [request-id] [secret]",
+		Short: "Reveal the hex encoded secret committed to a beacon round",
+		Example: fmt.Sprintf(
+			"%s tx random reveal-beacon <request id> <secret>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			secret, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealBeacon(args[0], secret, clientCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSubmitUpdateVRFPublicKeyProposal implements the command to submit an update-vrf-public-key proposal
+func GetCmdSubmitUpdateVRFPublicKeyProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-vrf-public-key",
+		Short: "Submit a proposal to designate a new VRF public key",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal update-vrf-public-key --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --title=<title> "+
+				"--description=<description> --deposit=<deposit> --vrf-public-key=<hex encoded public key>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			pubKey, err := hex.DecodeString(viper.GetString(FlagVRFPublicKey))
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateVRFPublicKeyProposal(
+				viper.GetString(govcli.FlagTitle), viper.GetString(govcli.FlagDescription), pubKey,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsUpdateVRFKeyProposal)
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+	_ = cmd.MarkFlagRequired(FlagVRFPublicKey)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/irisnet/irishub/modules/random/client/cli"
+	"github.com/irisnet/irishub/modules/random/client/rest"
+)
+
+// proposal handlers of the random module
+var (
+	UpdateVRFPublicKeyProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateVRFPublicKeyProposal, rest.UpdateVRFPublicKeyProposalRESTHandler)
+)
@@ -8,6 +8,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	"github.com/irisnet/irishub/modules/random/types"
@@ -16,8 +17,18 @@ import (
 func registerQueryRoutes(cliCtx client.Context, r *mux.Router) {
 	// query random by the request id
 	r.HandleFunc(fmt.Sprintf("/random/randoms/{%s}", RestRequestID), queryRandomHandlerFn(cliCtx)).Methods("GET")
+	// query a random value by the request id and the index
+	r.HandleFunc(fmt.Sprintf("/random/randoms/{%s}/values/{%s}", RestRequestID, RestIndex), queryRandomValueHandlerFn(cliCtx)).Methods("GET")
+	// verify the VRF proof of a random by the request id
+	r.HandleFunc(fmt.Sprintf("/random/randoms/{%s}/verify", RestRequestID), queryVerifyRandomHandlerFn(cliCtx)).Methods("GET")
 	// query random request queue by an optional heigth
 	r.HandleFunc("/random/queue", queryQueueHandlerFn(cliCtx)).Methods("GET")
+	// query the random requests of a consumer with their status
+	r.HandleFunc(fmt.Sprintf("/random/consumers/{%s}/requests", RestConsumer), queryConsumerRequestsHandlerFn(cliCtx)).Methods("GET")
+	// query the Merkle commitment of the random numbers pruned at the height
+	r.HandleFunc(fmt.Sprintf("/random/pruned-commitments/{%s}", RestHeight), queryPrunedCommitmentHandlerFn(cliCtx)).Methods("GET")
+	// query the current random parameters
+	r.HandleFunc("/random/params", queryParamsHandlerFn(cliCtx)).Methods("GET")
 }
 
 // HTTP request handler to query random by the request id.
@@ -65,6 +76,91 @@ func queryRandomHandlerFn(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// HTTP request handler to verify the VRF proof of a random by the request id.
+func queryVerifyRandomHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		reqID := vars[RestRequestID]
+		if err := types.CheckReqID(reqID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.QueryRandomParams{
+			ReqID: reqID,
+		}
+
+		bz, err := cliCtx.JSONMarshaler.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryVerifyRandom)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query a random value by the request id and the index.
+func queryRandomValueHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		reqID := vars[RestRequestID]
+		if err := types.CheckReqID(reqID); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		index, err := strconv.ParseUint(vars[RestIndex], 10, 32)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.QueryRandomValueParams{
+			ReqID: reqID,
+			Index: uint32(index),
+		}
+
+		bz, err := cliCtx.JSONMarshaler.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRandomValue)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // HTTP request handler to query request queue by an optional heigth.
 func queryQueueHandlerFn(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -108,3 +204,111 @@ func queryQueueHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query the random requests of a consumer with their status.
+func queryConsumerRequestsHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		consumer, err := sdk.AccAddressFromBech32(mux.Vars(r)[RestConsumer])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.QueryConsumerRequestsParams{
+			Consumer: consumer,
+		}
+
+		if str := r.FormValue("page"); len(str) > 0 {
+			if params.Page, err = strconv.ParseUint(str, 10, 64); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid page: %s", err))
+				return
+			}
+		}
+		if str := r.FormValue("limit"); len(str) > 0 {
+			if params.Limit, err = strconv.ParseUint(str, 10, 64); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid limit: %s", err))
+				return
+			}
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.JSONMarshaler.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryConsumerRequests)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the Merkle commitment of the random numbers pruned at the height.
+func queryPrunedCommitmentHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		height, err := strconv.ParseInt(mux.Vars(r)[RestHeight], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.QueryPrunedCommitmentParams{
+			Height: height,
+		}
+
+		bz, err := cliCtx.JSONMarshaler.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPrunedCommitment)
+		res, queryHeight, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(queryHeight)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the current random parameters.
+func queryParamsHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -3,6 +3,8 @@ package rest
 import (
 	"github.com/gorilla/mux"
 
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -11,7 +13,11 @@ import (
 // Rest variable names
 // nolint
 const (
-	RestRequestID = "request-id"
+	RestRequestID   = "request-id"
+	RestParticipant = "participant"
+	RestIndex       = "index"
+	RestHeight      = "height"
+	RestConsumer    = "consumer"
 )
 
 // RegisterHandlers defines routes that get registered by the main application
@@ -26,5 +32,54 @@ type RequestRandomReq struct {
 	Consumer      sdk.AccAddress `json:"consumer"`                 // request address
 	BlockInterval uint64         `json:"block_interval"`           // block interval
 	Oracle        bool           `json:"oracle"`                   // oracle method
+	VRF           bool           `json:"vrf"`                      // VRF method
+	Beacon        bool           `json:"beacon"`                   // beacon method
 	ServiceFeeCap sdk.Coins      `json:"service_fee_cap"`          // service fee cap
+	OutputType    string         `json:"output_type"`              // type of the random values, decimal, integer or raw
+	Count         uint32         `json:"count"`                    // number of the random values
+	RangeMin      uint64         `json:"range_min"`                // inclusive lower bound of the integers
+	RangeMax      uint64         `json:"range_max"`                // exclusive upper bound of the integers
+}
+
+// SubmitVRFProofReq defines the properties of a submit VRF proof request's body
+type SubmitVRFProofReq struct {
+	BaseReq rest.BaseReq     `json:"base_req" yaml:"base_req"` // base req
+	Sender  sdk.AccAddress   `json:"sender"`                   // sender address
+	Proof   tmbytes.HexBytes `json:"proof"`                    // hex encoded VRF proof
+}
+
+// RegisterBeaconParticipantReq defines the properties of a register beacon participant request's body
+type RegisterBeaconParticipantReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"` // base req
+	Participant sdk.AccAddress `json:"participant"`              // participant address
+	Deposit     sdk.Coins      `json:"deposit"`                  // deposit
+}
+
+// UnregisterBeaconParticipantReq defines the properties of an unregister beacon participant request's body
+type UnregisterBeaconParticipantReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"` // base req
+}
+
+// CommitBeaconReq defines the properties of a commit beacon request's body
+type CommitBeaconReq struct {
+	BaseReq     rest.BaseReq     `json:"base_req" yaml:"base_req"` // base req
+	Participant sdk.AccAddress   `json:"participant"`              // participant address
+	Commitment  tmbytes.HexBytes `json:"commitment"`               // hex encoded hash of the secret
+}
+
+// RevealBeaconReq defines the properties of a reveal beacon request's body
+type RevealBeaconReq struct {
+	BaseReq     rest.BaseReq     `json:"base_req" yaml:"base_req"` // base req
+	Participant sdk.AccAddress   `json:"participant"`              // participant address
+	Secret      tmbytes.HexBytes `json:"secret"`                   // hex encoded secret
+}
+
+// UpdateVRFPublicKeyProposalReq defines the properties of an update VRF public key proposal request's body
+type UpdateVRFPublicKeyProposalReq struct {
+	BaseReq     rest.BaseReq     `json:"base_req" yaml:"base_req"` // base req
+	Title       string           `json:"title"`                    // title of the proposal
+	Description string           `json:"description"`              // description of the proposal
+	PublicKey   tmbytes.HexBytes `json:"public_key"`               // hex encoded DER (PKIX) RSA public key
+	Proposer    sdk.AccAddress   `json:"proposer"`                 // proposer address
+	Deposit     sdk.Coins        `json:"deposit"`                  // initial deposit
 }
@@ -1,12 +1,14 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	"github.com/irisnet/irishub/modules/random/types"
@@ -15,6 +17,16 @@ import (
 func registerTxRoutes(cliCtx client.Context, r *mux.Router) {
 	// request rands
 	r.HandleFunc("/random/randoms", requestRandomHandlerFn(cliCtx)).Methods("POST")
+	// submit the VRF proof of a random request
+	r.HandleFunc(fmt.Sprintf("/random/randoms/{%s}/proof", RestRequestID), submitVRFProofHandlerFn(cliCtx)).Methods("POST")
+	// register a beacon participant
+	r.HandleFunc("/random/participants", registerBeaconParticipantHandlerFn(cliCtx)).Methods("POST")
+	// unregister a beacon participant
+	r.HandleFunc(fmt.Sprintf("/random/participants/{%s}/unregister", RestParticipant), unregisterBeaconParticipantHandlerFn(cliCtx)).Methods("POST")
+	// commit to a beacon round
+	r.HandleFunc(fmt.Sprintf("/random/randoms/{%s}/commit", RestRequestID), commitBeaconHandlerFn(cliCtx)).Methods("POST")
+	// reveal the secret of a beacon round
+	r.HandleFunc(fmt.Sprintf("/random/randoms/{%s}/reveal", RestRequestID), revealBeaconHandlerFn(cliCtx)).Methods("POST")
 }
 
 // HTTP request handler to request random
@@ -30,8 +42,156 @@ func requestRandomHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
+		outputType := types.Decimal
+		if len(req.OutputType) > 0 {
+			var err error
+			if outputType, err = types.OutputTypeFromString(req.OutputType); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		output := types.NewOutputSpec(outputType, req.Count, req.RangeMin, req.RangeMax)
+
 		// create the MsgRequestRandom message
-		msg := types.NewMsgRequestRandom(req.Consumer, req.BlockInterval, req.Oracle, req.ServiceFeeCap)
+		msg := types.NewMsgRequestRandom(req.Consumer, req.BlockInterval, req.Oracle, req.VRF, req.Beacon, req.ServiceFeeCap, output)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// HTTP request handler to submit the VRF proof of a random request
+func submitVRFProofHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		reqID := vars[RestRequestID]
+
+		var req SubmitVRFProofReq
+		if !rest.ReadRESTReq(w, r, cliCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgSubmitVRFProof message
+		msg := types.NewMsgSubmitVRFProof(reqID, req.Proof, req.Sender)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// HTTP request handler to register a beacon participant
+func registerBeaconParticipantHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RegisterBeaconParticipantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgRegisterBeaconParticipant message
+		msg := types.NewMsgRegisterBeaconParticipant(req.Participant, req.Deposit)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// HTTP request handler to unregister a beacon participant
+func unregisterBeaconParticipantHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		participant, err := sdk.AccAddressFromBech32(vars[RestParticipant])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req UnregisterBeaconParticipantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgUnregisterBeaconParticipant message
+		msg := types.NewMsgUnregisterBeaconParticipant(participant)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// HTTP request handler to commit to a beacon round
+func commitBeaconHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		reqID := vars[RestRequestID]
+
+		var req CommitBeaconReq
+		if !rest.ReadRESTReq(w, r, cliCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgCommitBeacon message
+		msg := types.NewMsgCommitBeacon(reqID, req.Commitment, req.Participant)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
+// HTTP request handler to reveal the secret of a beacon round
+func revealBeaconHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		reqID := vars[RestRequestID]
+
+		var req RevealBeaconReq
+		if !rest.ReadRESTReq(w, r, cliCtx.JSONMarshaler, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgRevealBeacon message
+		msg := types.NewMsgRevealBeacon(reqID, req.Secret, req.Participant)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -15,12 +15,73 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	if err := types.ValidateGenesis(data); err != nil {
 		panic(fmt.Errorf("failed to initialize random genesis state: %s", err.Error()))
 	}
+
+	k.SetParamSet(ctx, data.Params)
+
+	if len(data.VRFPublicKey) > 0 {
+		k.SetVRFPublicKey(ctx, data.VRFPublicKey)
+	}
+
+	for _, random := range data.Randoms {
+		k.SetRandom(ctx, random.ReqId, random.Random)
+		for i, value := range random.Values {
+			k.SetRandomValue(ctx, random.ReqId, uint32(i), value)
+		}
+	}
+
+	for _, expiry := range data.RandomExpiryQueue {
+		k.EnqueueRandomExpiry(ctx, expiry.Height, expiry.ReqId, expiry.Consumer)
+	}
+
+	for _, commitment := range data.PrunedRandomsCommitments {
+		k.SetPrunedRandomsCommitment(ctx, commitment)
+	}
+
+	// the index is overwritten below by the pending requests whose heights are rebased
+	for _, consumerRequest := range data.ConsumerRequests {
+		k.SetConsumerRequest(ctx, consumerRequest.ReqId, consumerRequest.Request)
+	}
+
+	for _, request := range data.PendingVRFRequests {
+		reqID := types.GenerateRequestID(request)
+		k.SetConsumerRequest(ctx, reqID, request)
+
+		if request.VRFExpirationHeight == 0 {
+			// the request exported before the VRF proof timeout was introduced
+			k.ScheduleVRFExpiry(ctx, reqID, request)
+		} else {
+			k.SetVRFRandomRequest(ctx, reqID, request)
+		}
+	}
+
 	for height, requests := range data.PendingRandomRequests {
 		for _, request := range requests.Requests {
 			h, _ := strconv.ParseInt(height, 10, 64)
-			k.EnqueueRandomRequest(ctx, h, types.GenerateRequestID(request), request)
+			reqID := types.GenerateRequestID(request)
+
+			if request.Beacon && request.RevealEndHeight > 0 {
+				// rebase the windows of the open beacon round onto the queue height
+				request.CommitEndHeight += h - request.RevealEndHeight
+				request.RevealEndHeight = h
+				k.SetBeaconRequest(ctx, reqID, request)
+			} else if request.VRF && request.VRFExpirationHeight > 0 {
+				// rebase the expiry of the pending VRF request onto the queue height
+				request.VRFExpirationHeight = h
+				k.SetVRFRandomRequest(ctx, reqID, request)
+			}
+
+			k.EnqueueRandomRequest(ctx, h, reqID, request)
+			k.SetConsumerRequest(ctx, reqID, request)
 		}
 	}
+
+	for _, participant := range data.BeaconParticipants {
+		k.SetBeaconParticipant(ctx, participant)
+	}
+
+	for _, commit := range data.BeaconCommits {
+		k.SetBeaconCommit(ctx, commit)
+	}
 }
 
 // ExportGenesis outputs genesis data
@@ -41,5 +102,59 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
-	return &types.GenesisState{PendingRandomRequests: pendingRequests}
+	var pendingVRFRequests []types.Request
+	k.IterateVRFRandomRequests(ctx, func(request types.Request) bool {
+		pendingVRFRequests = append(pendingVRFRequests, request)
+		return false
+	})
+
+	var beaconParticipants []types.BeaconParticipant
+	k.IterateBeaconParticipants(ctx, func(participant types.BeaconParticipant) bool {
+		beaconParticipants = append(beaconParticipants, participant)
+		return false
+	})
+
+	var beaconCommits []types.BeaconCommit
+	k.IterateAllBeaconCommits(ctx, func(commit types.BeaconCommit) bool {
+		beaconCommits = append(beaconCommits, commit)
+		return false
+	})
+
+	var randoms []types.GenesisRandom
+	k.IterateRandoms(ctx, func(reqID []byte, random types.Random) bool {
+		randoms = append(randoms, types.NewGenesisRandom(reqID, random, k.GetRandomValues(ctx, reqID, random)))
+		return false
+	})
+
+	var randomExpiryQueue []types.RandomExpiry
+	k.IterateRandomExpiryQueue(ctx, func(height int64, reqID []byte, consumer sdk.AccAddress) bool {
+		leftHeight := height - ctx.BlockHeight() + 1
+		randomExpiryQueue = append(randomExpiryQueue, types.NewRandomExpiry(leftHeight, reqID, consumer))
+		return false
+	})
+
+	var prunedCommitments []types.PrunedRandomsCommitment
+	k.IteratePrunedRandomsCommitments(ctx, func(commitment types.PrunedRandomsCommitment) bool {
+		prunedCommitments = append(prunedCommitments, commitment)
+		return false
+	})
+
+	var consumerRequests []types.ConsumerRequest
+	k.IterateConsumerRequests(ctx, func(reqID []byte, request types.Request) bool {
+		consumerRequests = append(consumerRequests, types.NewConsumerRequest(reqID, request, k.GetRequestStatus(ctx, reqID)))
+		return false
+	})
+
+	return &types.GenesisState{
+		PendingRandomRequests:    pendingRequests,
+		PendingVRFRequests:       pendingVRFRequests,
+		VRFPublicKey:             k.GetVRFPublicKey(ctx),
+		BeaconParticipants:       beaconParticipants,
+		BeaconCommits:            beaconCommits,
+		Params:                   k.GetParamSet(ctx),
+		Randoms:                  randoms,
+		RandomExpiryQueue:        randomExpiryQueue,
+		PrunedRandomsCommitments: prunedCommitments,
+		ConsumerRequests:         consumerRequests,
+	}
 }
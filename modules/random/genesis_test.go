@@ -52,9 +52,9 @@ func (suite *GenesisTestSuite) TestExportGenesis() {
 	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
 
 	// request rands
-	_, err := suite.keeper.RequestRandom(suite.ctx, testConsumer1, testBlockInterval1, false, sdk.NewCoins())
+	_, err := suite.keeper.RequestRandom(suite.ctx, testConsumer1, testBlockInterval1, false, false, false, sdk.NewCoins(), types.OutputSpec{})
 	suite.NoError(err)
-	_, err = suite.keeper.RequestRandom(suite.ctx, testConsumer2, testBlockInterval2, false, sdk.NewCoins())
+	_, err = suite.keeper.RequestRandom(suite.ctx, testConsumer2, testBlockInterval2, false, false, false, sdk.NewCoins(), types.OutputSpec{})
 	suite.NoError(err)
 
 	// precede to the new block
@@ -80,3 +80,87 @@ func (suite *GenesisTestSuite) TestExportGenesis() {
 		suite.Equal(storedRequests[storedHeight], requests.Requests)
 	}
 }
+
+func (suite *GenesisTestSuite) TestExportImportRandoms() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	retention := int64(50)
+	params := suite.keeper.GetParamSet(suite.ctx)
+	params.RandomRetentionBlocks = uint64(retention)
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	output := types.NewOutputSpec(types.Decimal, 2, 0, 0)
+	request1, err := suite.keeper.RequestRandom(suite.ctx, testConsumer1, testBlockInterval1, false, false, false, sdk.NewCoins(), output)
+	suite.NoError(err)
+	request2, err := suite.keeper.RequestRandom(suite.ctx, testConsumer2, testBlockInterval2, false, false, false, sdk.NewCoins(), output)
+	suite.NoError(err)
+
+	reqID1 := types.GenerateRequestID(request1)
+	reqID2 := types.GenerateRequestID(request2)
+
+	// the first random number is generated and then pruned, the second one is kept
+	genHeight1 := testHeight + int64(testBlockInterval1) + 1
+	pruneHeight1 := genHeight1 + retention
+	genHeight2 := testHeight + int64(testBlockInterval2) + 1
+	for _, height := range []int64{genHeight1, pruneHeight1, genHeight2} {
+		suite.ctx = suite.ctx.WithBlockHeader(tmproto.Header{
+			Height:      height,
+			LastBlockId: tmproto.BlockID{Hash: []byte("last_block_hash")},
+		})
+		random.BeginBlocker(suite.ctx, suite.keeper)
+	}
+
+	_, err = suite.keeper.GetRandom(suite.ctx, reqID1)
+	suite.Error(err)
+	storedRandom, err := suite.keeper.GetRandom(suite.ctx, reqID2)
+	suite.NoError(err)
+	values := suite.keeper.GetRandomValues(suite.ctx, reqID2, storedRandom)
+	suite.Len(values, 2)
+	commitment, err := suite.keeper.GetPrunedRandomsCommitment(suite.ctx, pruneHeight1)
+	suite.NoError(err)
+
+	exportHeight := genHeight2 + 10
+	suite.ctx = suite.ctx.WithBlockHeight(exportHeight)
+
+	genesis := random.ExportGenesis(suite.ctx, suite.keeper)
+	suite.NoError(types.ValidateGenesis(*genesis))
+
+	suite.Equal([]types.GenesisRandom{types.NewGenesisRandom(reqID2, storedRandom, values)}, genesis.Randoms)
+	suite.Equal([]types.PrunedRandomsCommitment{commitment}, genesis.PrunedRandomsCommitments)
+
+	leftHeight := genHeight2 + retention - exportHeight + 1
+	suite.Equal([]types.RandomExpiry{types.NewRandomExpiry(leftHeight, reqID2, testConsumer2)}, genesis.RandomExpiryQueue)
+
+	suite.Len(genesis.ConsumerRequests, 1)
+	suite.Equal(reqID2, []byte(genesis.ConsumerRequests[0].ReqId))
+	suite.Equal(types.StatusFulfilled, genesis.ConsumerRequests[0].Status)
+
+	// import the genesis into a new chain
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	random.InitGenesis(ctx, app.RandomKeeper, *genesis)
+
+	importedRandom, err := app.RandomKeeper.GetRandom(ctx, reqID2)
+	suite.NoError(err)
+	suite.Equal(storedRandom, importedRandom)
+	suite.Equal(values, app.RandomKeeper.GetRandomValues(ctx, reqID2, importedRandom))
+
+	importedCommitment, err := app.RandomKeeper.GetPrunedRandomsCommitment(ctx, pruneHeight1)
+	suite.NoError(err)
+	suite.Equal(commitment, importedCommitment)
+
+	consumerRequests, _, err := app.RandomKeeper.GetPaginatedConsumerRequests(ctx, testConsumer2, nil)
+	suite.NoError(err)
+	suite.Len(consumerRequests, 1)
+	suite.Equal(types.StatusFulfilled, consumerRequests[0].Status)
+
+	// the imported random number is still pruned after the retention window
+	ctx = ctx.WithBlockHeight(leftHeight)
+	random.BeginBlocker(ctx, app.RandomKeeper)
+
+	_, err = app.RandomKeeper.GetRandom(ctx, reqID2)
+	suite.Error(err)
+	consumerRequests, _, err = app.RandomKeeper.GetPaginatedConsumerRequests(ctx, testConsumer2, nil)
+	suite.NoError(err)
+	suite.Empty(consumerRequests)
+}
@@ -19,6 +19,16 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case *types.MsgRequestRandom:
 			return handleMsgRequestRandom(ctx, k, msg)
+		case *types.MsgSubmitVRFProof:
+			return handleMsgSubmitVRFProof(ctx, k, msg)
+		case *types.MsgRegisterBeaconParticipant:
+			return handleMsgRegisterBeaconParticipant(ctx, k, msg)
+		case *types.MsgUnregisterBeaconParticipant:
+			return handleMsgUnregisterBeaconParticipant(ctx, k, msg)
+		case *types.MsgCommitBeacon:
+			return handleMsgCommitBeacon(ctx, k, msg)
+		case *types.MsgRevealBeacon:
+			return handleMsgRevealBeacon(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -27,7 +37,7 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 // handleMsgRequestRandom handles MsgRequestRandom
 func handleMsgRequestRandom(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRequestRandom) (*sdk.Result, error) {
-	request, err := k.RequestRandom(ctx, msg.Consumer, msg.BlockInterval, msg.Oracle, msg.ServiceFeeCap)
+	request, err := k.RequestRandom(ctx, msg.Consumer, msg.BlockInterval, msg.Oracle, msg.VRF, msg.Beacon, msg.ServiceFeeCap, msg.Output)
 	if err != nil {
 		return nil, err
 	}
@@ -49,3 +59,125 @@ func handleMsgRequestRandom(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRequ
 
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
+
+// handleMsgSubmitVRFProof handles MsgSubmitVRFProof
+func handleMsgSubmitVRFProof(ctx sdk.Context, k keeper.Keeper, msg *types.MsgSubmitVRFProof) (*sdk.Result, error) {
+	reqID, err := hex.DecodeString(msg.ReqId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidReqID, msg.ReqId)
+	}
+
+	random, err := k.SubmitVRFProof(ctx, reqID, msg.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			),
+			sdk.NewEvent(
+				types.EventTypeGenerateRandom,
+				sdk.NewAttribute(types.AttributeKeyRequestID, msg.ReqId),
+				sdk.NewAttribute(types.AttributeKeyRandom, random.Value),
+			),
+		},
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgRegisterBeaconParticipant handles MsgRegisterBeaconParticipant
+func handleMsgRegisterBeaconParticipant(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRegisterBeaconParticipant) (*sdk.Result, error) {
+	if err := k.RegisterBeaconParticipant(ctx, msg.Participant, msg.Deposit); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Participant.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgUnregisterBeaconParticipant handles MsgUnregisterBeaconParticipant
+func handleMsgUnregisterBeaconParticipant(ctx sdk.Context, k keeper.Keeper, msg *types.MsgUnregisterBeaconParticipant) (*sdk.Result, error) {
+	if err := k.UnregisterBeaconParticipant(ctx, msg.Participant); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Participant.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgCommitBeacon handles MsgCommitBeacon
+func handleMsgCommitBeacon(ctx sdk.Context, k keeper.Keeper, msg *types.MsgCommitBeacon) (*sdk.Result, error) {
+	reqID, err := hex.DecodeString(msg.ReqId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidReqID, msg.ReqId)
+	}
+
+	if err := k.CommitBeacon(ctx, reqID, msg.Participant, msg.Commitment); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Participant.String()),
+			),
+			sdk.NewEvent(
+				types.EventTypeCommitBeacon,
+				sdk.NewAttribute(types.AttributeKeyRequestID, msg.ReqId),
+				sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant.String()),
+			),
+		},
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgRevealBeacon handles MsgRevealBeacon
+func handleMsgRevealBeacon(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRevealBeacon) (*sdk.Result, error) {
+	reqID, err := hex.DecodeString(msg.ReqId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidReqID, msg.ReqId)
+	}
+
+	if err := k.RevealBeacon(ctx, reqID, msg.Participant, msg.Secret); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Participant.String()),
+			),
+			sdk.NewEvent(
+				types.EventTypeRevealBeacon,
+				sdk.NewAttribute(types.AttributeKeyRequestID, msg.ReqId),
+				sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant.String()),
+			),
+		},
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
@@ -0,0 +1,330 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/random/types"
+)
+
+// RegisterBeaconParticipant registers a beacon participant and escrows the deposit,
+// which must be no less than the minimal deposit
+func (k Keeper) RegisterBeaconParticipant(ctx sdk.Context, address sdk.AccAddress, deposit sdk.Coins) error {
+	if _, found := k.GetBeaconParticipant(ctx, address); found {
+		return sdkerrors.Wrap(types.ErrBeaconParticipantExists, address.String())
+	}
+
+	if minDeposit := k.GetParamSet(ctx).BeaconMinDeposit; !deposit.IsAllGTE(minDeposit) {
+		return sdkerrors.Wrapf(types.ErrInsufficientDeposit, "deposit %s is less than the minimal deposit %s", deposit, minDeposit)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, deposit); err != nil {
+		return err
+	}
+
+	k.SetBeaconParticipant(ctx, types.NewBeaconParticipant(address, deposit))
+	return nil
+}
+
+// UnregisterBeaconParticipant unregisters a beacon participant and refunds the deposit
+func (k Keeper) UnregisterBeaconParticipant(ctx sdk.Context, address sdk.AccAddress) error {
+	participant, found := k.GetBeaconParticipant(ctx, address)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownParticipant, address.String())
+	}
+
+	if participant.PendingCommits > 0 {
+		return sdkerrors.Wrapf(types.ErrPendingBeaconCommits, "%d commitments of %s are not revealed", participant.PendingCommits, address)
+	}
+
+	if !participant.Deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, participant.Deposit); err != nil {
+			return err
+		}
+	}
+
+	k.DeleteBeaconParticipant(ctx, address)
+	return nil
+}
+
+// SetBeaconParticipant stores the beacon participant
+func (k Keeper) SetBeaconParticipant(ctx sdk.Context, participant types.BeaconParticipant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&participant)
+	store.Set(types.KeyBeaconParticipant(participant.Address), bz)
+}
+
+// GetBeaconParticipant retrieves the beacon participant by the specified address
+func (k Keeper) GetBeaconParticipant(ctx sdk.Context, address sdk.AccAddress) (participant types.BeaconParticipant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyBeaconParticipant(address))
+	if bz == nil {
+		return participant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &participant)
+	return participant, true
+}
+
+// DeleteBeaconParticipant deletes the beacon participant
+func (k Keeper) DeleteBeaconParticipant(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyBeaconParticipant(address))
+}
+
+// IterateBeaconParticipants iterates through all the beacon participants
+func (k Keeper) IterateBeaconParticipants(ctx sdk.Context, op func(p types.BeaconParticipant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixBeaconParticipant)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var participant types.BeaconParticipant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &participant)
+
+		if stop := op(participant); stop {
+			break
+		}
+	}
+}
+
+// SetBeaconRequest stores the beacon request of an open round
+func (k Keeper) SetBeaconRequest(ctx sdk.Context, reqID []byte, request types.Request) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&request)
+	store.Set(types.KeyBeaconRequest(reqID), bz)
+}
+
+// GetBeaconRequest retrieves the beacon request of an open round by the specified request id
+func (k Keeper) GetBeaconRequest(ctx sdk.Context, reqID []byte) (types.Request, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyBeaconRequest(reqID))
+	if bz == nil {
+		return types.Request{}, sdkerrors.Wrap(types.ErrInvalidBeaconRound, hex.EncodeToString(reqID))
+	}
+
+	var request types.Request
+	k.cdc.MustUnmarshalBinaryBare(bz, &request)
+
+	return request, nil
+}
+
+// DeleteBeaconRequest deletes the beacon request of a finished round
+func (k Keeper) DeleteBeaconRequest(ctx sdk.Context, reqID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyBeaconRequest(reqID))
+}
+
+// SetBeaconCommit stores the beacon commitment
+func (k Keeper) SetBeaconCommit(ctx sdk.Context, commit types.BeaconCommit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&commit)
+	store.Set(types.KeyBeaconCommit(commit.ReqId, commit.Participant), bz)
+}
+
+// GetBeaconCommit retrieves the commitment of the participant to the specified beacon round
+func (k Keeper) GetBeaconCommit(ctx sdk.Context, reqID []byte, participant sdk.AccAddress) (commit types.BeaconCommit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyBeaconCommit(reqID, participant))
+	if bz == nil {
+		return commit, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &commit)
+	return commit, true
+}
+
+// DeleteBeaconCommit deletes the beacon commitment
+func (k Keeper) DeleteBeaconCommit(ctx sdk.Context, reqID []byte, participant sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyBeaconCommit(reqID, participant))
+}
+
+// IterateBeaconCommits iterates through the commitments of the specified beacon round in participant order
+func (k Keeper) IterateBeaconCommits(ctx sdk.Context, reqID []byte, op func(c types.BeaconCommit) (stop bool)) {
+	k.iterateBeaconCommits(ctx, types.KeyBeaconCommitSubspace(reqID), op)
+}
+
+// IterateAllBeaconCommits iterates through the commitments of all the beacon rounds
+func (k Keeper) IterateAllBeaconCommits(ctx sdk.Context, op func(c types.BeaconCommit) (stop bool)) {
+	k.iterateBeaconCommits(ctx, types.PrefixBeaconCommit, op)
+}
+
+func (k Keeper) iterateBeaconCommits(ctx sdk.Context, prefix []byte, op func(c types.BeaconCommit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var commit types.BeaconCommit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &commit)
+
+		if stop := op(commit); stop {
+			break
+		}
+	}
+}
+
+// CommitBeacon commits the hash of the secret of a registered participant during the commit window,
+// the participant whose deposit was slashed below the minimal deposit can not commit any more
+func (k Keeper) CommitBeacon(ctx sdk.Context, reqID []byte, address sdk.AccAddress, commitment []byte) error {
+	participant, found := k.GetBeaconParticipant(ctx, address)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownParticipant, address.String())
+	}
+
+	if minDeposit := k.GetParamSet(ctx).BeaconMinDeposit; !participant.Deposit.IsAllGTE(minDeposit) {
+		return sdkerrors.Wrapf(types.ErrInsufficientDeposit, "deposit %s is less than the minimal deposit %s", participant.Deposit, minDeposit)
+	}
+
+	request, err := k.GetBeaconRequest(ctx, reqID)
+	if err != nil {
+		return err
+	}
+
+	if ctx.BlockHeight() > request.CommitEndHeight {
+		return sdkerrors.Wrapf(types.ErrInvalidBeaconRound, "the commit window ended at height %d", request.CommitEndHeight)
+	}
+
+	if _, found := k.GetBeaconCommit(ctx, reqID, address); found {
+		return sdkerrors.Wrap(types.ErrBeaconCommitExists, address.String())
+	}
+
+	k.SetBeaconCommit(ctx, types.NewBeaconCommit(reqID, address, commitment))
+
+	participant.PendingCommits++
+	k.SetBeaconParticipant(ctx, participant)
+
+	return nil
+}
+
+// RevealBeacon reveals the committed secret of a participant during the reveal window
+func (k Keeper) RevealBeacon(ctx sdk.Context, reqID []byte, address sdk.AccAddress, secret []byte) error {
+	request, err := k.GetBeaconRequest(ctx, reqID)
+	if err != nil {
+		return err
+	}
+
+	if ctx.BlockHeight() <= request.CommitEndHeight || ctx.BlockHeight() > request.RevealEndHeight {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidBeaconRound, "the reveal window is from height %d to %d",
+			request.CommitEndHeight+1, request.RevealEndHeight,
+		)
+	}
+
+	commit, found := k.GetBeaconCommit(ctx, reqID, address)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownBeaconCommit, address.String())
+	}
+
+	if commit.Revealed() {
+		return sdkerrors.Wrap(types.ErrInvalidBeaconReveal, "the secret has already been revealed")
+	}
+
+	if !bytes.Equal(commit.Commitment, types.GenerateBeaconCommitment(secret, address)) {
+		return sdkerrors.Wrap(types.ErrInvalidBeaconReveal, "the secret does not match the commitment")
+	}
+
+	commit.Secret = secret
+	k.SetBeaconCommit(ctx, commit)
+
+	if participant, found := k.GetBeaconParticipant(ctx, address); found {
+		participant.PendingCommits--
+		k.SetBeaconParticipant(ctx, participant)
+	}
+
+	return nil
+}
+
+// OpenBeaconRound opens the commit window of the beacon request and enqueues the request
+// again to be finalized when the reveal window ends
+func (k Keeper) OpenBeaconRound(ctx sdk.Context, reqID []byte, request types.Request) types.Request {
+	params := k.GetParamSet(ctx)
+	request.CommitEndHeight = ctx.BlockHeight() + int64(params.BeaconCommitWindow)
+	request.RevealEndHeight = request.CommitEndHeight + int64(params.BeaconRevealWindow)
+
+	k.SetBeaconRequest(ctx, reqID, request)
+	k.EnqueueRandomRequest(ctx, request.RevealEndHeight, reqID, request)
+
+	return request
+}
+
+// FinalizeBeaconRound generates the random number from all the reveals of the beacon round
+// and slashes the participants who committed without revealing
+func (k Keeper) FinalizeBeaconRound(ctx sdk.Context, reqID []byte, request types.Request, blockHash []byte) types.Random {
+	var secrets [][]byte
+	var commits []types.BeaconCommit
+
+	k.IterateBeaconCommits(ctx, reqID, func(commit types.BeaconCommit) bool {
+		if commit.Revealed() {
+			secrets = append(secrets, commit.Secret)
+		}
+		commits = append(commits, commit)
+		return false
+	})
+
+	for _, commit := range commits {
+		if !commit.Revealed() {
+			k.slashBeaconParticipant(ctx, reqID, commit.Participant)
+		}
+		k.DeleteBeaconCommit(ctx, reqID, commit.Participant)
+	}
+
+	seed := types.GetBeaconSeed(blockHash, reqID, secrets)
+	random := types.NewRandom(
+		request.TxHash,
+		request.RevealEndHeight,
+		types.SeedToRand(seed).FloatString(types.RandPrec),
+	)
+	random = k.StoreRandom(ctx, reqID, request, random, seed)
+	k.DeleteBeaconRequest(ctx, reqID)
+
+	return random
+}
+
+// slashBeaconParticipant burns a fraction of the deposit of the participant who did not reveal,
+// the slashed amount is rounded up so that a positive fraction never slashes nothing
+func (k Keeper) slashBeaconParticipant(ctx sdk.Context, reqID []byte, address sdk.AccAddress) {
+	participant, found := k.GetBeaconParticipant(ctx, address)
+	if !found {
+		return
+	}
+
+	slashFraction := k.GetParamSet(ctx).BeaconSlashFraction
+
+	var coins []sdk.Coin
+	for _, coin := range participant.Deposit {
+		coins = append(coins, sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(slashFraction).Ceil().TruncateInt()))
+	}
+	slashed := sdk.NewCoins(coins...)
+
+	if !slashed.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, slashed); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to slash beacon participant %s: %s", address, err.Error()))
+			slashed = sdk.Coins{}
+		} else {
+			participant.Deposit = participant.Deposit.Sub(slashed)
+		}
+	}
+
+	participant.PendingCommits--
+	k.SetBeaconParticipant(ctx, participant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashBeacon,
+			sdk.NewAttribute(types.AttributeKeyRequestID, hex.EncodeToString(reqID)),
+			sdk.NewAttribute(types.AttributeKeyParticipant, address.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, slashed.String()),
+		),
+	)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/random/types"
+	"github.com/irisnet/irishub/pagination"
+)
+
+// SetConsumerRequest adds the random request to the index of the requests of the consumer
+func (k Keeper) SetConsumerRequest(ctx sdk.Context, reqID []byte, request types.Request) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&request)
+	store.Set(types.KeyConsumerRequest(request.Consumer, reqID), bz)
+}
+
+// DeleteConsumerRequest removes the random request from the index of the requests of the consumer
+func (k Keeper) DeleteConsumerRequest(ctx sdk.Context, consumer sdk.AccAddress, reqID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyConsumerRequest(consumer, reqID))
+}
+
+// IterateConsumerRequests iterates through the index of the random requests of all consumers
+func (k Keeper) IterateConsumerRequests(ctx sdk.Context, op func(reqID []byte, r types.Request) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixConsumerRequest)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.Request
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &request)
+
+		reqID := iterator.Key()[len(types.KeyConsumerRequestSubspace(request.Consumer)):]
+		if stop := op(reqID, request); stop {
+			break
+		}
+	}
+}
+
+// GetRequestStatus returns the status of the random request, which is pending until
+// the random number is generated or the request fails
+func (k Keeper) GetRequestStatus(ctx sdk.Context, reqID []byte) types.RequestStatus {
+	random, err := k.GetRandom(ctx, reqID)
+	if err != nil {
+		return types.StatusPending
+	}
+	return random.Status
+}
+
+// GetPaginatedConsumerRequests returns the random requests of the consumer with their status,
+// ordered by the request id
+func (k Keeper) GetPaginatedConsumerRequests(
+	ctx sdk.Context,
+	consumer sdk.AccAddress,
+	pageReq *query.PageRequest,
+) ([]types.ConsumerRequest, *query.PageResponse, error) {
+	var result []types.ConsumerRequest
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyConsumerRequestSubspace(consumer))
+	pageRes, err := pagination.Paginate(
+		pageReq, types.DefaultConsumerRequestsLimit,
+		func(pageKey []byte) sdk.Iterator {
+			return store.Iterator(pageKey, nil)
+		},
+		func(reqID, value []byte, accumulate bool) bool {
+			if accumulate {
+				var request types.Request
+				k.cdc.MustUnmarshalBinaryBare(value, &request)
+				result = append(result, types.NewConsumerRequest(reqID, request, k.GetRequestStatus(ctx, reqID)))
+			}
+			return true
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, pageRes, nil
+}
@@ -35,6 +35,27 @@ func (k Keeper) Random(c context.Context, req *types.QueryRandomRequest) (*types
 	return &types.QueryRandomResponse{Random: &random}, nil
 }
 
+// RandomValue implements the Query/RandomValue gRPC method
+func (k Keeper) RandomValue(c context.Context, req *types.QueryRandomValueRequest) (*types.QueryRandomValueResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	reqID, err := hex.DecodeString(req.ReqId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request id")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	value, err := k.GetRandomValue(ctx, reqID, req.Index)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "value %d of random %s not found", req.Index, req.ReqId)
+	}
+
+	return &types.QueryRandomValueResponse{Value: value}, nil
+}
+
 // RandomRequestQueue implements the Query/RandomRequestQueue gRPC method
 func (k Keeper) RandomRequestQueue(c context.Context, req *types.QueryRandomRequestQueueRequest) (*types.QueryRandomRequestQueueResponse, error) {
 	if req == nil {
@@ -58,3 +79,78 @@ func (k Keeper) RandomRequestQueue(c context.Context, req *types.QueryRandomRequ
 
 	return &types.QueryRandomRequestQueueResponse{Requests: requests}, nil
 }
+
+// ConsumerRequests implements the Query/ConsumerRequests gRPC method
+func (k Keeper) ConsumerRequests(c context.Context, req *types.QueryConsumerRequestsRequest) (*types.QueryConsumerRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumer, err := sdk.AccAddressFromBech32(req.Consumer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid consumer address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	requests, pageRes, err := k.GetPaginatedConsumerRequests(ctx, consumer, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryConsumerRequestsResponse{Requests: requests, Pagination: pageRes}, nil
+}
+
+// VerifyRandom implements the Query/VerifyRandom gRPC method
+func (k Keeper) VerifyRandom(c context.Context, req *types.QueryVerifyRandomRequest) (*types.QueryVerifyRandomResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	reqID, err := hex.DecodeString(req.ReqId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request id")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	random, err := k.GetRandom(ctx, reqID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "random %s not found", req.ReqId)
+	}
+
+	return &types.QueryVerifyRandomResponse{
+		Random:       &random,
+		VRFPublicKey: k.GetVRFPublicKey(ctx),
+		Verified:     k.VerifyVRFRandom(ctx, random),
+	}, nil
+}
+
+// PrunedRandomsCommitment implements the Query/PrunedRandomsCommitment gRPC method
+func (k Keeper) PrunedRandomsCommitment(
+	c context.Context, req *types.QueryPrunedRandomsCommitmentRequest,
+) (*types.QueryPrunedRandomsCommitmentResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	commitment, err := k.GetPrunedRandomsCommitment(ctx, req.Height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no randoms pruned at height %d", req.Height)
+	}
+
+	return &types.QueryPrunedRandomsCommitmentResponse{Commitment: commitment}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParamSet(ctx)}, nil
+}
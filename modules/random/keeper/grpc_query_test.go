@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/random/types"
 )
@@ -57,3 +58,39 @@ func (suite *KeeperTestSuite) TestGRPCRandomRequestQueue() {
 	})
 	suite.Equal(requests, randomResp.Requests)
 }
+
+func (suite *KeeperTestSuite) TestGRPCConsumerRequests() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+	pendingReqID := []byte("test_req_id_1")
+	fulfilledReqID := []byte("test_req_id_2")
+	request := types.NewRequest(1, addr, []byte("test_hash"), false, sdk.NewCoins(), nil)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.RandomKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	app.RandomKeeper.SetConsumerRequest(ctx, pendingReqID, request)
+	app.RandomKeeper.SetConsumerRequest(ctx, fulfilledReqID, request)
+	app.RandomKeeper.SetRandom(ctx, fulfilledReqID, types.NewRandom([]byte("test_hash"), 2, "test"))
+
+	resp, err := queryClient.ConsumerRequests(gocontext.Background(), &types.QueryConsumerRequestsRequest{
+		Consumer:   addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Equal([]types.ConsumerRequest{types.NewConsumerRequest(pendingReqID, request, types.StatusPending)}, resp.Requests)
+	suite.Equal(uint64(2), resp.Pagination.Total)
+	suite.NotNil(resp.Pagination.NextKey)
+
+	resp, err = queryClient.ConsumerRequests(gocontext.Background(), &types.QueryConsumerRequestsRequest{
+		Consumer:   addr.String(),
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Equal([]types.ConsumerRequest{types.NewConsumerRequest(fulfilledReqID, request, types.StatusFulfilled)}, resp.Requests)
+	suite.Nil(resp.Pagination.NextKey)
+
+	_, err = queryClient.ConsumerRequests(gocontext.Background(), &types.QueryConsumerRequestsRequest{Consumer: "invalid"})
+	suite.Require().Error(err)
+}
@@ -11,25 +11,45 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/irisnet/irishub/modules/random/types"
 )
 
 // Keeper defines the random module Keeper
 type Keeper struct {
-	cdc           codec.Marshaler
-	storeKey      sdk.StoreKey
-	bankKeeper    types.BankKeeper
-	serviceKeeper types.ServiceKeeper
+	cdc              codec.Marshaler
+	storeKey         sdk.StoreKey
+	paramSpace       paramtypes.Subspace
+	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistrKeeper
+	serviceKeeper    types.ServiceKeeper
+	feeCollectorName string
+
+	// hooks of the modules requesting random numbers, keyed by the module name
+	hooks map[string]types.RandomHooks
 }
 
 // NewKeeper returns a new random keeper
-func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey, bankKeeper types.BankKeeper, serviceKeeper types.ServiceKeeper) Keeper {
+func NewKeeper(
+	cdc codec.Marshaler,
+	key sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	serviceKeeper types.ServiceKeeper,
+	feeCollectorName string,
+) Keeper {
 	keeper := Keeper{
-		cdc:           cdc,
-		storeKey:      key,
-		bankKeeper:    bankKeeper,
-		serviceKeeper: serviceKeeper,
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		bankKeeper:       bankKeeper,
+		distrKeeper:      distrKeeper,
+		serviceKeeper:    serviceKeeper,
+		feeCollectorName: feeCollectorName,
+		hooks:            make(map[string]types.RandomHooks),
 	}
 
 	_ = serviceKeeper.RegisterResponseCallback(types.ModuleName, keeper.HandlerResponse)
@@ -42,24 +62,96 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
 }
 
+// GetModuleAddress returns the address of the random module account, which escrows the request fees
+// of the oracle requests and the deposits of the beacon participants
+func (k Keeper) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
 // GetCdc returns the cdc
 func (k Keeper) GetCdc() codec.Marshaler {
 	return k.cdc
 }
 
+// RegisterHooks registers the hooks of a module, which are called back when
+// the random numbers requested by the module are generated
+func (k Keeper) RegisterHooks(moduleName string, hooks types.RandomHooks) error {
+	if _, ok := k.hooks[moduleName]; ok {
+		return sdkerrors.Wrapf(types.ErrHooksAlreadyRegistered, "module %s", moduleName)
+	}
+
+	k.hooks[moduleName] = hooks
+	return nil
+}
+
 // RequestRandom requests a random number
 func (k Keeper) RequestRandom(
 	ctx sdk.Context, consumer sdk.AccAddress,
-	blockInterval uint64, oracle bool,
-	serviceFeeCap sdk.Coins,
+	blockInterval uint64, oracle bool, vrf bool, beacon bool,
+	serviceFeeCap sdk.Coins, output types.OutputSpec,
 ) (types.Request, error) {
+	return k.requestRandom(ctx, consumer, blockInterval, oracle, vrf, beacon, serviceFeeCap, output, "")
+}
+
+// RequestRandomFromModule requests a random number on behalf of a module, the hooks registered
+// by the module are called back with the random number when it is generated
+func (k Keeper) RequestRandomFromModule(
+	ctx sdk.Context, moduleName string, consumer sdk.AccAddress,
+	blockInterval uint64, oracle bool, vrf bool, beacon bool,
+	serviceFeeCap sdk.Coins, output types.OutputSpec,
+) (types.Request, error) {
+	if _, ok := k.hooks[moduleName]; !ok {
+		return types.Request{}, sdkerrors.Wrapf(types.ErrHooksNotRegistered, "module %s", moduleName)
+	}
+
+	return k.requestRandom(ctx, consumer, blockInterval, oracle, vrf, beacon, serviceFeeCap, output, moduleName)
+}
+
+func (k Keeper) requestRandom(
+	ctx sdk.Context, consumer sdk.AccAddress,
+	blockInterval uint64, oracle bool, vrf bool, beacon bool,
+	serviceFeeCap sdk.Coins, output types.OutputSpec, moduleName string,
+) (types.Request, error) {
+	var request types.Request
+
+	if err := output.Validate(); err != nil {
+		return request, err
+	}
+
+	// the params are only checked one by one when changed by governance, so that
+	// the invariants across the params are enforced before a request relies on them
+	params := k.GetParamSet(ctx)
+	if err := params.Validate(); err != nil {
+		return request, err
+	}
+
+	if blockInterval < params.MinBlockInterval || blockInterval > params.MaxBlockInterval {
+		return request, sdkerrors.Wrapf(
+			types.ErrInvalidBlockInterval, "block interval %d must be between %d and %d",
+			blockInterval, params.MinBlockInterval, params.MaxBlockInterval,
+		)
+	}
+
+	requestCount := k.GetRequestCount(ctx)
+	if requestCount >= params.MaxRequestsPerBlock {
+		return request, sdkerrors.Wrapf(types.ErrTooManyRequests, "at most %d requests in a block", params.MaxRequestsPerBlock)
+	}
+
+	// the request fee of an oracle request is escrowed until the request is settled
+	if oracle {
+		if err := k.escrowRequestFee(ctx, consumer, params.RequestFee); err != nil {
+			return request, err
+		}
+	} else if err := k.payRequestFee(ctx, consumer, params.RequestFee, params.FeeDestination); err != nil {
+		return request, err
+	}
+
 	currentHeight := ctx.BlockHeight()
 	destHeight := currentHeight + int64(blockInterval)
 
 	// get tx hash
 	txHash := types.SHA256(ctx.TxBytes())
 
-	var request types.Request
 	if oracle {
 		// create paused request context
 		requestContextID, err := k.RequestService(ctx, consumer, serviceFeeCap)
@@ -69,20 +161,88 @@ func (k Keeper) RequestRandom(
 
 		// build request
 		request = types.NewRequest(currentHeight, consumer, txHash, oracle, serviceFeeCap, requestContextID)
+		request.RequestFee = params.RequestFee
 	} else {
+		// the proof can only be verified against the designated VRF key
+		if vrf && len(k.GetVRFPublicKey(ctx)) == 0 {
+			return request, types.ErrVRFPublicKeyNotSet
+		}
+
 		// build request
 		request = types.NewRequest(currentHeight, consumer, txHash, oracle, nil, nil)
+		request.VRF = vrf
+		request.Beacon = beacon
 	}
 
+	request.ModuleName = moduleName
+	request.Output = output
+	request.Nonce = requestCount
+
 	// generate the request id
 	reqID := types.GenerateRequestID(request)
 
 	// add to the queue
 	k.EnqueueRandomRequest(ctx, destHeight, reqID, request)
+	k.SetConsumerRequest(ctx, reqID, request)
+	k.SetRequestCount(ctx, requestCount+1)
 
 	return request, nil
 }
 
+// payRequestFee sends the request fee from the payer to the fee collector or the community pool
+func (k Keeper) payRequestFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins, destination string) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	if destination == types.FeeDestinationCommunityPool {
+		return k.distrKeeper.FundCommunityPool(ctx, fee, payer)
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, k.feeCollectorName, fee)
+}
+
+// escrowRequestFee sends the request fee from the consumer to the random module account
+func (k Keeper) escrowRequestFee(ctx sdk.Context, consumer sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, consumer, types.ModuleName, fee)
+}
+
+// GetRequestCount returns the number of random requests in the current block
+func (k Keeper) GetRequestCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyRequestCount)
+	if bz == nil || int64(sdk.BigEndianToUint64(bz[:8])) != ctx.BlockHeight() {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz[8:])
+}
+
+// SetRequestCount stores the number of random requests in the current block
+func (k Keeper) SetRequestCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := append(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())), sdk.Uint64ToBigEndian(count)...)
+	store.Set(types.KeyRequestCount, bz)
+}
+
+// GetParamSet returns the random module parameters
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParamSet sets the random module parameters
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
 // SetRandom stores the random number
 func (k Keeper) SetRandom(ctx sdk.Context, reqID []byte, random types.Random) {
 	store := ctx.KVStore(k.storeKey)
@@ -90,6 +250,85 @@ func (k Keeper) SetRandom(ctx sdk.Context, reqID []byte, random types.Random) {
 	store.Set(types.KeyRandom(reqID), bz)
 }
 
+// StoreRandom stores the random number of the request along with the random values derived from the seed,
+// the random number, fulfilled or failed, is scheduled to be pruned after the retention window
+func (k Keeper) StoreRandom(ctx sdk.Context, reqID []byte, request types.Request, random types.Random, seed []byte) types.Random {
+	// no values are derived for a failed request
+	if random.Status == types.StatusFulfilled {
+		random.Output = request.Output
+
+		for i, value := range types.DeriveRandomValues(seed, random.Output) {
+			k.SetRandomValue(ctx, reqID, uint32(i), value)
+		}
+	}
+	k.SetRandom(ctx, reqID, random)
+
+	if retention := k.GetParamSet(ctx).RandomRetentionBlocks; retention > 0 {
+		k.EnqueueRandomExpiry(ctx, ctx.BlockHeight()+int64(retention), reqID, request.Consumer)
+	}
+
+	return random
+}
+
+// SetRandomValue stores the random value by the specified request id and index
+func (k Keeper) SetRandomValue(ctx sdk.Context, reqID []byte, index uint32, value string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRandomValue(reqID, index), []byte(value))
+}
+
+// GetRandomValue retrieves the random value by the specified request id and index
+func (k Keeper) GetRandomValue(ctx sdk.Context, reqID []byte, index uint32) (string, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyRandomValue(reqID, index))
+	if bz == nil {
+		return "", sdkerrors.Wrapf(types.ErrInvalidValueIndex, "no value at index %d of %s", index, hex.EncodeToString(reqID))
+	}
+
+	return string(bz), nil
+}
+
+// GetRandomValues retrieves the stored random values derived from the random number
+func (k Keeper) GetRandomValues(ctx sdk.Context, reqID []byte, random types.Random) []string {
+	var values []string
+	for i := uint32(0); i < random.Output.ValueCount(); i++ {
+		if value, err := k.GetRandomValue(ctx, reqID, i); err == nil {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// AfterRandomGenerated calls back the hooks of the module which requested the random number,
+// the state changes of the hooks are discarded if they panic
+func (k Keeper) AfterRandomGenerated(ctx sdk.Context, reqID []byte, request types.Request, random types.Random) {
+	if len(request.ModuleName) == 0 {
+		return
+	}
+
+	hooks, ok := k.hooks[request.ModuleName]
+	if !ok {
+		k.Logger(ctx).Error(fmt.Sprintf("random hooks of module %s not registered", request.ModuleName))
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("random hooks of module %s panicked: %v", request.ModuleName, r))
+		}
+	}()
+
+	// the hooks are run in a cached context so that a failed callback does not
+	// interfere with the random number which has been generated
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	hooks.AfterRandomGenerated(cacheCtx, reqID, random)
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
 // EnqueueRandomRequest enqueue the random number request
 func (k Keeper) EnqueueRandomRequest(ctx sdk.Context, height int64, reqID []byte, request types.Request) {
 	store := ctx.KVStore(k.storeKey)
@@ -147,7 +386,7 @@ func (k Keeper) GetRandom(ctx sdk.Context, reqID []byte) (types.Random, error) {
 }
 
 // IterateRandoms iterates through all the random numbers
-func (k Keeper) IterateRandoms(ctx sdk.Context, op func(r types.Random) (stop bool)) {
+func (k Keeper) IterateRandoms(ctx sdk.Context, op func(reqID []byte, r types.Random) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 
 	iterator := sdk.KVStorePrefixIterator(store, types.PrefixRandom)
@@ -157,7 +396,7 @@ func (k Keeper) IterateRandoms(ctx sdk.Context, op func(r types.Random) (stop bo
 		var random types.Random
 		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &random)
 
-		if stop := op(random); stop {
+		if stop := op(iterator.Key()[len(types.PrefixRandom):], random); stop {
 			break
 		}
 	}
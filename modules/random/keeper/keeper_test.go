@@ -1,18 +1,26 @@
 package keeper_test
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"math/big"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"github.com/irisnet/irishub/modules/random"
 	"github.com/irisnet/irishub/modules/random/keeper"
 	"github.com/irisnet/irishub/modules/random/types"
 	"github.com/irisnet/irishub/simapp"
@@ -69,7 +77,7 @@ func (suite *KeeperTestSuite) TestSetRandom() {
 func (suite *KeeperTestSuite) TestRequestRandom() {
 	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
 
-	request, err := suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, nil)
+	request, err := suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, false, false, nil, types.OutputSpec{})
 	suite.NoError(err)
 
 	expectedRequest := types.NewRequest(testHeight, testConsumer, types.SHA256(testTxBytes), false, nil, nil)
@@ -83,4 +91,580 @@ func (suite *KeeperTestSuite) TestRequestRandom() {
 		suite.app.AppCodec().MustUnmarshalBinaryBare(iterator.Value(), &request)
 		suite.Equal(expectedRequest, request)
 	}
+
+	// another request of the consumer in the same block gets a distinct id
+	request2, err := suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, false, false, nil, types.OutputSpec{})
+	suite.NoError(err)
+	suite.Equal(uint64(1), request2.Nonce)
+	suite.NotEqual(types.GenerateRequestID(request), types.GenerateRequestID(request2))
+
+	queued := 0
+	iterator2 := suite.keeper.IterateRandomRequestQueueByHeight(suite.ctx, testHeight+int64(testBlockInterval))
+	defer iterator2.Close()
+	for ; iterator2.Valid(); iterator2.Next() {
+		queued++
+	}
+	suite.Equal(2, queued)
+}
+
+func (suite *KeeperTestSuite) TestRandomValues() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	output := types.NewOutputSpec(types.Integer, 5, 1, 50)
+	request, err := suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, false, false, nil, output)
+	suite.NoError(err)
+	suite.Equal(output, request.Output)
+
+	reqID := types.GenerateRequestID(request)
+
+	suite.ctx = suite.ctx.WithBlockHeader(tmproto.Header{
+		Height:      testHeight + int64(testBlockInterval) + 1,
+		LastBlockId: tmproto.BlockID{Hash: []byte("last_block_hash")},
+	})
+	random.BeginBlocker(suite.ctx, suite.keeper)
+
+	storedRandom, err := suite.keeper.GetRandom(suite.ctx, reqID)
+	suite.NoError(err)
+	suite.Equal(output, storedRandom.Output)
+
+	for i := uint32(0); i < output.Count; i++ {
+		value, err := suite.keeper.GetRandomValue(suite.ctx, reqID, i)
+		suite.NoError(err)
+
+		v, err := strconv.ParseUint(value, 10, 64)
+		suite.NoError(err)
+		suite.True(v >= output.RangeMin && v < output.RangeMax)
+	}
+
+	_, err = suite.keeper.GetRandomValue(suite.ctx, reqID, output.Count)
+	suite.Error(err)
+
+	// the output must be valid
+	_, err = suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, false, false, nil, types.NewOutputSpec(types.Integer, 5, 50, 1))
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestRandomsInSameBlock() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	// the requests of the consumer in the same block mature in the same block
+	output := types.NewOutputSpec(types.Integer, 5, 1, 1000)
+	request1, err := suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, false, false, nil, output)
+	suite.NoError(err)
+	request2, err := suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, false, false, nil, output)
+	suite.NoError(err)
+
+	reqID1, reqID2 := types.GenerateRequestID(request1), types.GenerateRequestID(request2)
+
+	suite.ctx = suite.ctx.WithBlockHeader(tmproto.Header{
+		Height:      testHeight + int64(testBlockInterval) + 1,
+		LastBlockId: tmproto.BlockID{Hash: []byte("last_block_hash")},
+	})
+	random.BeginBlocker(suite.ctx, suite.keeper)
+
+	random1, err := suite.keeper.GetRandom(suite.ctx, reqID1)
+	suite.NoError(err)
+	random2, err := suite.keeper.GetRandom(suite.ctx, reqID2)
+	suite.NoError(err)
+
+	suite.NotEqual(random1.Value, random2.Value)
+	suite.NotEqual(suite.keeper.GetRandomValues(suite.ctx, reqID1, random1), suite.keeper.GetRandomValues(suite.ctx, reqID2, random2))
+}
+
+func (suite *KeeperTestSuite) TestPruneRandoms() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	retention := uint64(50)
+	params := suite.keeper.GetParamSet(suite.ctx)
+	params.RandomRetentionBlocks = retention
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	output := types.NewOutputSpec(types.Decimal, 2, 0, 0)
+	request, err := suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, false, false, nil, output)
+	suite.NoError(err)
+
+	reqID := types.GenerateRequestID(request)
+	suite.Equal(types.StatusPending, suite.keeper.GetRequestStatus(suite.ctx, reqID))
+
+	genHeight := testHeight + int64(testBlockInterval) + 1
+	suite.ctx = suite.ctx.WithBlockHeader(tmproto.Header{
+		Height:      genHeight,
+		LastBlockId: tmproto.BlockID{Hash: []byte("last_block_hash")},
+	})
+	random.BeginBlocker(suite.ctx, suite.keeper)
+
+	storedRandom, err := suite.keeper.GetRandom(suite.ctx, reqID)
+	suite.NoError(err)
+	suite.Equal(types.StatusFulfilled, suite.keeper.GetRequestStatus(suite.ctx, reqID))
+	value0, err := suite.keeper.GetRandomValue(suite.ctx, reqID, 0)
+	suite.NoError(err)
+	value1, err := suite.keeper.GetRandomValue(suite.ctx, reqID, 1)
+	suite.NoError(err)
+
+	// the random number is kept within the retention window
+	suite.ctx = suite.ctx.WithBlockHeight(genHeight + int64(retention) - 1)
+	random.BeginBlocker(suite.ctx, suite.keeper)
+	_, err = suite.keeper.GetRandom(suite.ctx, reqID)
+	suite.NoError(err)
+
+	pruneHeight := genHeight + int64(retention)
+	suite.ctx = suite.ctx.WithBlockHeight(pruneHeight)
+	random.BeginBlocker(suite.ctx, suite.keeper)
+
+	_, err = suite.keeper.GetRandom(suite.ctx, reqID)
+	suite.Error(err)
+	_, err = suite.keeper.GetRandomValue(suite.ctx, reqID, 0)
+	suite.Error(err)
+
+	// the request is removed from the index of the consumer requests
+	consumerRequests, _, err := suite.keeper.GetPaginatedConsumerRequests(suite.ctx, testConsumer, nil)
+	suite.NoError(err)
+	suite.Empty(consumerRequests)
+
+	// the pruned random number is committed by the Merkle root
+	prunedRandom := types.NewPrunedRandom(reqID, storedRandom, []string{value0, value1})
+	leaf := suite.app.AppCodec().MustMarshalBinaryBare(&prunedRandom)
+
+	commitment, err := suite.keeper.GetPrunedRandomsCommitment(suite.ctx, pruneHeight)
+	suite.NoError(err)
+	suite.Equal(uint32(1), commitment.Count)
+	suite.Equal(merkle.HashFromByteSlices([][]byte{leaf}), []byte(commitment.Root))
+
+	_, err = suite.keeper.GetPrunedRandomsCommitment(suite.ctx, pruneHeight+1)
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestVRFRandom() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	// the VRF key must be designated before requesting
+	_, err := suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, true, false, nil, types.OutputSpec{})
+	suite.Error(err)
+
+	privKey, err := rsa.GenerateKey(rand.Reader, types.MinVRFPublicKeyModulusBits)
+	suite.NoError(err)
+	pubKey, err := x509.MarshalPKIXPublicKey(&privKey.PublicKey)
+	suite.NoError(err)
+	suite.keeper.SetVRFPublicKey(suite.ctx, pubKey)
+
+	request, err := suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, true, false, nil, types.OutputSpec{})
+	suite.NoError(err)
+	suite.True(request.VRF)
+
+	reqID := types.GenerateRequestID(request)
+	lastBlockHash := []byte("last_block_hash")
+
+	// the VRF input is fixed at the generation height
+	genHeight := testHeight + int64(testBlockInterval)
+	suite.ctx = suite.ctx.WithBlockHeader(tmproto.Header{
+		Height:      genHeight + 1,
+		LastBlockId: tmproto.BlockID{Hash: lastBlockHash},
+	})
+	random.BeginBlocker(suite.ctx, suite.keeper)
+
+	pendingRequest, err := suite.keeper.GetVRFRandomRequest(suite.ctx, reqID)
+	suite.NoError(err)
+	suite.Equal(types.GenerateVRFInput(lastBlockHash, reqID), []byte(pendingRequest.VRFInput))
+
+	_, err = suite.keeper.GetRandom(suite.ctx, reqID)
+	suite.Error(err)
+
+	// an invalid proof is rejected
+	_, err = suite.keeper.SubmitVRFProof(suite.ctx, reqID, []byte("invalid_proof"))
+	suite.Error(err)
+
+	proof := types.ProveVRF(privKey, pendingRequest.VRFInput)
+	result, err := suite.keeper.SubmitVRFProof(suite.ctx, reqID, proof)
+	suite.NoError(err)
+
+	storedRandom, err := suite.keeper.GetRandom(suite.ctx, reqID)
+	suite.NoError(err)
+	suite.Equal(result, storedRandom)
+	suite.Equal(pubKey, []byte(storedRandom.VRFPublicKey))
+	suite.True(suite.keeper.VerifyVRFRandom(suite.ctx, storedRandom))
+
+	_, err = suite.keeper.GetVRFRandomRequest(suite.ctx, reqID)
+	suite.Error(err)
+
+	// the random number is still verified against its own key after the key is rotated
+	newPrivKey, err := rsa.GenerateKey(rand.Reader, types.MinVRFPublicKeyModulusBits)
+	suite.NoError(err)
+	newPubKey, err := x509.MarshalPKIXPublicKey(&newPrivKey.PublicKey)
+	suite.NoError(err)
+
+	suite.Error(keeper.HandleUpdateVRFPublicKeyProposal(
+		suite.ctx, suite.keeper, types.NewUpdateVRFPublicKeyProposal("title", "description", []byte("invalid_key")),
+	))
+	suite.NoError(keeper.HandleUpdateVRFPublicKeyProposal(
+		suite.ctx, suite.keeper, types.NewUpdateVRFPublicKeyProposal("title", "description", newPubKey),
+	))
+	suite.Equal(newPubKey, suite.keeper.GetVRFPublicKey(suite.ctx))
+	suite.True(suite.keeper.VerifyVRFRandom(suite.ctx, storedRandom))
+
+	// a modified random number is not verified
+	storedRandom.Value = big.NewRat(testRandomNumerator, testRandomDenomiator).FloatString(types.RandPrec)
+	suite.False(suite.keeper.VerifyVRFRandom(suite.ctx, storedRandom))
+}
+
+func (suite *KeeperTestSuite) TestVRFRandomExpiry() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	privKey, err := rsa.GenerateKey(rand.Reader, types.MinVRFPublicKeyModulusBits)
+	suite.NoError(err)
+	pubKey, err := x509.MarshalPKIXPublicKey(&privKey.PublicKey)
+	suite.NoError(err)
+	suite.keeper.SetVRFPublicKey(suite.ctx, pubKey)
+
+	request, err := suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, true, false, nil, types.OutputSpec{})
+	suite.NoError(err)
+
+	reqID := types.GenerateRequestID(request)
+
+	genHeight := testHeight + int64(testBlockInterval)
+	suite.ctx = suite.ctx.WithBlockHeader(tmproto.Header{
+		Height:      genHeight + 1,
+		LastBlockId: tmproto.BlockID{Hash: []byte("last_block_hash")},
+	})
+	random.BeginBlocker(suite.ctx, suite.keeper)
+
+	pendingRequest, err := suite.keeper.GetVRFRandomRequest(suite.ctx, reqID)
+	suite.NoError(err)
+
+	expirationHeight := genHeight + 1 + int64(suite.keeper.GetParamSet(suite.ctx).VRFProofTimeout)
+	suite.Equal(expirationHeight, pendingRequest.VRFExpirationHeight)
+
+	// the request fails when the proof is not submitted before the timeout
+	suite.ctx = suite.ctx.WithBlockHeader(tmproto.Header{
+		Height:      expirationHeight + 1,
+		LastBlockId: tmproto.BlockID{Hash: []byte("last_block_hash")},
+	})
+	random.BeginBlocker(suite.ctx, suite.keeper)
+
+	failedRandom, err := suite.keeper.GetRandom(suite.ctx, reqID)
+	suite.NoError(err)
+	suite.Equal(types.StatusFailed, failedRandom.Status)
+	suite.NotEmpty(failedRandom.FailureReason)
+
+	_, err = suite.keeper.GetVRFRandomRequest(suite.ctx, reqID)
+	suite.Error(err)
+
+	_, err = suite.keeper.SubmitVRFProof(suite.ctx, reqID, types.ProveVRF(privKey, pendingRequest.VRFInput))
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMigrateVRFRequests() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	// a VRF request pending before the proof timeout was introduced
+	request := types.NewRequest(testHeight, testConsumer, types.SHA256(testTxBytes), false, nil, nil)
+	request.VRF = true
+	request.VRFInput = types.GenerateVRFInput([]byte("last_block_hash"), testReqID)
+
+	reqID := types.GenerateRequestID(request)
+	suite.keeper.SetVRFRandomRequest(suite.ctx, reqID, request)
+
+	suite.keeper.MigrateVRFRequests(suite.ctx)
+
+	expirationHeight := testHeight + int64(suite.keeper.GetParamSet(suite.ctx).VRFProofTimeout)
+
+	migratedRequest, err := suite.keeper.GetVRFRandomRequest(suite.ctx, reqID)
+	suite.NoError(err)
+	suite.Equal(expirationHeight, migratedRequest.VRFExpirationHeight)
+
+	var queued []types.Request
+	suite.keeper.IterateRandomRequestQueue(suite.ctx, func(height int64, r types.Request) bool {
+		suite.Equal(expirationHeight, height)
+		queued = append(queued, r)
+		return false
+	})
+	suite.Equal([]types.Request{migratedRequest}, queued)
+}
+
+func (suite *KeeperTestSuite) TestMigrateRandomExpiry() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	retention := uint64(50)
+	params := suite.keeper.GetParamSet(suite.ctx)
+	params.RandomRetentionBlocks = retention
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	// a random number stored before the pruning was introduced
+	suite.keeper.SetRandom(suite.ctx, testReqID, types.NewRandom(types.SHA256(testTxBytes), testHeight, "0.5"))
+
+	suite.keeper.MigrateRandomExpiry(suite.ctx)
+	// the migration is idempotent
+	suite.keeper.MigrateRandomExpiry(suite.ctx)
+
+	pruneHeight := testHeight + int64(retention)
+
+	var expiries []types.RandomExpiry
+	suite.keeper.IterateRandomExpiryQueue(suite.ctx, func(height int64, reqID []byte, consumer sdk.AccAddress) bool {
+		expiries = append(expiries, types.NewRandomExpiry(height, reqID, consumer))
+		return false
+	})
+	suite.Equal([]types.RandomExpiry{types.NewRandomExpiry(pruneHeight, testReqID, nil)}, expiries)
+
+	suite.ctx = suite.ctx.WithBlockHeight(pruneHeight)
+	random.BeginBlocker(suite.ctx, suite.keeper)
+
+	_, err := suite.keeper.GetRandom(suite.ctx, testReqID)
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestBeaconRandom() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	participants := simapp.AddTestAddrsIncremental(suite.app, suite.ctx, 2, sdk.NewInt(10000))
+
+	// the deposit must be no less than the minimal deposit
+	suite.Error(suite.keeper.RegisterBeaconParticipant(suite.ctx, participants[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 999))))
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	for _, participant := range participants {
+		suite.NoError(suite.keeper.RegisterBeaconParticipant(suite.ctx, participant, deposit))
+	}
+	suite.Error(suite.keeper.RegisterBeaconParticipant(suite.ctx, participants[0], deposit))
+
+	request, err := suite.keeper.RequestRandom(suite.ctx, testConsumer, testBlockInterval, false, false, true, nil, types.OutputSpec{})
+	suite.NoError(err)
+	suite.True(request.Beacon)
+
+	reqID := types.GenerateRequestID(request)
+	lastBlockHash := []byte("last_block_hash")
+
+	// the round is opened at the generation height
+	genHeight := testHeight + int64(testBlockInterval)
+	suite.ctx = suite.ctx.WithBlockHeader(tmproto.Header{
+		Height:      genHeight + 1,
+		LastBlockId: tmproto.BlockID{Hash: lastBlockHash},
+	})
+	random.BeginBlocker(suite.ctx, suite.keeper)
+
+	round, err := suite.keeper.GetBeaconRequest(suite.ctx, reqID)
+	suite.NoError(err)
+	params := suite.keeper.GetParamSet(suite.ctx)
+	suite.Equal(genHeight+1+int64(params.BeaconCommitWindow), round.CommitEndHeight)
+	suite.Equal(round.CommitEndHeight+int64(params.BeaconRevealWindow), round.RevealEndHeight)
+
+	secrets := [][]byte{[]byte("secret0"), []byte("secret1")}
+	for i, participant := range participants {
+		commitment := types.GenerateBeaconCommitment(secrets[i], participant)
+		suite.NoError(suite.keeper.CommitBeacon(suite.ctx, reqID, participant, commitment))
+		suite.Error(suite.keeper.CommitBeacon(suite.ctx, reqID, participant, commitment))
+	}
+
+	// the secrets can not be revealed during the commit window
+	suite.Error(suite.keeper.RevealBeacon(suite.ctx, reqID, participants[0], secrets[0]))
+
+	suite.ctx = suite.ctx.WithBlockHeight(round.CommitEndHeight + 1)
+	suite.Error(suite.keeper.RevealBeacon(suite.ctx, reqID, participants[0], secrets[1]))
+	suite.NoError(suite.keeper.RevealBeacon(suite.ctx, reqID, participants[0], secrets[0]))
+
+	// the participant with an unrevealed commitment can not leave
+	suite.Error(suite.keeper.UnregisterBeaconParticipant(suite.ctx, participants[1]))
+
+	suite.ctx = suite.ctx.WithBlockHeader(tmproto.Header{
+		Height:      round.RevealEndHeight + 1,
+		LastBlockId: tmproto.BlockID{Hash: lastBlockHash},
+	})
+	random.BeginBlocker(suite.ctx, suite.keeper)
+
+	storedRandom, err := suite.keeper.GetRandom(suite.ctx, reqID)
+	suite.NoError(err)
+	suite.Equal(types.GetBeaconRand(lastBlockHash, reqID, secrets[:1]).FloatString(types.RandPrec), storedRandom.Value)
+
+	_, err = suite.keeper.GetBeaconRequest(suite.ctx, reqID)
+	suite.Error(err)
+
+	// the participant who did not reveal is slashed
+	participant, found := suite.keeper.GetBeaconParticipant(suite.ctx, participants[1])
+	suite.True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900)), participant.Deposit)
+	suite.Equal(uint64(0), participant.PendingCommits)
+
+	// the deposit slashed below the minimal deposit can not commit any more
+	err = suite.keeper.CommitBeacon(suite.ctx, reqID, participants[1], types.GenerateBeaconCommitment(secrets[1], participants[1]))
+	suite.True(errors.Is(err, types.ErrInsufficientDeposit))
+
+	suite.NoError(suite.keeper.UnregisterBeaconParticipant(suite.ctx, participants[0]))
+	suite.Equal(sdk.NewInt(10000), suite.app.BankKeeper.GetBalance(suite.ctx, participants[0], sdk.DefaultBondDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestRandomHooks() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	moduleName := "game"
+
+	// the hooks must be registered before requesting
+	_, err := suite.keeper.RequestRandomFromModule(suite.ctx, moduleName, testConsumer, testBlockInterval, false, false, false, nil, types.OutputSpec{})
+	suite.Error(err)
+
+	hooks := &MockRandomHooks{}
+	suite.NoError(suite.keeper.RegisterHooks(moduleName, hooks))
+	suite.Error(suite.keeper.RegisterHooks(moduleName, hooks))
+
+	request, err := suite.keeper.RequestRandomFromModule(suite.ctx, moduleName, testConsumer, testBlockInterval, false, false, false, nil, types.OutputSpec{})
+	suite.NoError(err)
+	suite.Equal(moduleName, request.ModuleName)
+
+	reqID := types.GenerateRequestID(request)
+
+	suite.ctx = suite.ctx.WithBlockHeader(tmproto.Header{
+		Height:      testHeight + int64(testBlockInterval) + 1,
+		LastBlockId: tmproto.BlockID{Hash: []byte("last_block_hash")},
+	})
+	random.BeginBlocker(suite.ctx, suite.keeper)
+
+	storedRandom, err := suite.keeper.GetRandom(suite.ctx, reqID)
+	suite.NoError(err)
+	suite.Equal([][]byte{reqID}, hooks.reqIDs)
+	suite.Equal([]types.Random{storedRandom}, hooks.randoms)
+
+	// a panic in the hooks neither reverts the random number nor keeps the writes of the hooks
+	panicModule := "panic"
+	panicHooks := &MockPanicRandomHooks{storeKey: suite.app.GetKey(types.StoreKey)}
+	suite.NoError(suite.keeper.RegisterHooks(panicModule, panicHooks))
+
+	request, err = suite.keeper.RequestRandomFromModule(suite.ctx, panicModule, testConsumer, testBlockInterval, false, false, false, nil, types.OutputSpec{})
+	suite.NoError(err)
+	reqID = types.GenerateRequestID(request)
+
+	suite.ctx = suite.ctx.WithBlockHeader(tmproto.Header{
+		Height:      suite.ctx.BlockHeight() + int64(testBlockInterval) + 1,
+		LastBlockId: tmproto.BlockID{Hash: []byte("last_block_hash")},
+	})
+	suite.NotPanics(func() { random.BeginBlocker(suite.ctx, suite.keeper) })
+
+	_, err = suite.keeper.GetRandom(suite.ctx, reqID)
+	suite.NoError(err)
+	suite.Nil(suite.ctx.KVStore(panicHooks.storeKey).Get(mockHooksKey))
+}
+
+func (suite *KeeperTestSuite) TestFailedOracleRequest() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	consumer := simapp.AddTestAddrsIncremental(suite.app, suite.ctx, 1, sdk.NewInt(10000))[0]
+	requestFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	requestContextID := []byte("test_request_context_id")
+
+	// the request fee of the oracle request is escrowed
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, consumer, types.ModuleName, requestFee))
+
+	request := types.NewRequest(testHeight, consumer, types.SHA256(testTxBytes), true, nil, requestContextID)
+	request.RequestFee = requestFee
+	suite.keeper.SetOracleRandRequest(suite.ctx, requestContextID, request)
+
+	reqID := types.GenerateRequestID(request)
+
+	suite.keeper.HandlerResponse(suite.ctx, requestContextID, nil, errors.New("service timeout"))
+
+	// the failure is recorded and the request fee is refunded
+	random, err := suite.keeper.GetRandom(suite.ctx, reqID)
+	suite.NoError(err)
+	suite.Equal(types.StatusFailed, random.Status)
+	suite.Equal("service timeout", random.FailureReason)
+	suite.Empty(random.Value)
+
+	_, err = suite.keeper.GetOracleRandRequest(suite.ctx, requestContextID)
+	suite.Error(err)
+	suite.Equal(sdk.NewInt(10000), suite.app.BankKeeper.GetBalance(suite.ctx, consumer, sdk.DefaultBondDenom).Amount)
+
+	events := suite.ctx.EventManager().Events()
+	suite.Equal(types.EventTypeRandomFailed, events[len(events)-1].Type)
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight).WithTxBytes(testTxBytes)
+
+	consumer := simapp.AddTestAddrsIncremental(suite.app, suite.ctx, 1, sdk.NewInt(10000))[0]
+	requestFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	params := types.NewParams(10, 1000, requestFee, types.FeeDestinationFeeCollector, 2, 1, 1, types.SeedCombinationHash, 0, types.DefaultVRFProofTimeout,
+		types.DefaultBeaconMinDeposit, types.DefaultBeaconSlashFraction, types.DefaultBeaconCommitWindow, types.DefaultBeaconRevealWindow,
+	)
+	suite.keeper.SetParamSet(suite.ctx, params)
+	suite.Equal(params, suite.keeper.GetParamSet(suite.ctx))
+
+	// the block interval must be within the bounds
+	_, err := suite.keeper.RequestRandom(suite.ctx, consumer, 9, false, false, false, nil, types.OutputSpec{})
+	suite.Error(err)
+	_, err = suite.keeper.RequestRandom(suite.ctx, consumer, 1001, false, false, false, nil, types.OutputSpec{})
+	suite.Error(err)
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, sdk.DefaultBondDenom)
+
+	_, err = suite.keeper.RequestRandom(suite.ctx, consumer, testBlockInterval, false, false, false, nil, types.OutputSpec{})
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(9900), suite.app.BankKeeper.GetBalance(suite.ctx, consumer, sdk.DefaultBondDenom).Amount)
+	suite.Equal(
+		feeCollectorBalance.Add(requestFee[0]),
+		suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, sdk.DefaultBondDenom),
+	)
+
+	// the request fee is paid to the community pool
+	params.FeeDestination = types.FeeDestinationCommunityPool
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+	_, err = suite.keeper.RequestRandom(suite.ctx, consumer, testBlockInterval, false, false, false, nil, types.OutputSpec{})
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(9800), suite.app.BankKeeper.GetBalance(suite.ctx, consumer, sdk.DefaultBondDenom).Amount)
+	suite.Equal(
+		communityPool.Add(sdk.NewDecCoinsFromCoins(requestFee...)...),
+		suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx),
+	)
+
+	// the number of requests in a block is limited
+	suite.Equal(uint64(2), suite.keeper.GetRequestCount(suite.ctx))
+	_, err = suite.keeper.RequestRandom(suite.ctx, consumer, testBlockInterval, false, false, false, nil, types.OutputSpec{})
+	suite.Error(err)
+
+	// the count is reset in the next block
+	suite.ctx = suite.ctx.WithBlockHeight(testHeight + 1)
+	suite.Equal(uint64(0), suite.keeper.GetRequestCount(suite.ctx))
+	_, err = suite.keeper.RequestRandom(suite.ctx, consumer, testBlockInterval, false, false, false, nil, types.OutputSpec{})
+	suite.NoError(err)
+
+	// the requests are rejected while the params break the invariants across them
+	invalidParams := params
+	invalidParams.MinBlockInterval, invalidParams.MaxBlockInterval = 1000, 10
+	suite.keeper.SetParamSet(suite.ctx, invalidParams)
+	_, err = suite.keeper.RequestRandom(suite.ctx, consumer, testBlockInterval, false, false, false, nil, types.OutputSpec{})
+	suite.True(errors.Is(err, types.ErrInvalidParams))
+
+	invalidParams = params
+	invalidParams.OracleProviders, invalidParams.OracleThreshold = 1, 2
+	suite.keeper.SetParamSet(suite.ctx, invalidParams)
+	_, err = suite.keeper.RequestRandom(suite.ctx, consumer, testBlockInterval, false, false, false, nil, types.OutputSpec{})
+	suite.True(errors.Is(err, types.ErrInvalidParams))
+	suite.Equal(uint64(1), suite.keeper.GetRequestCount(suite.ctx))
+}
+
+var _ types.RandomHooks = &MockRandomHooks{}
+
+type MockRandomHooks struct {
+	reqIDs  [][]byte
+	randoms []types.Random
+}
+
+func (h *MockRandomHooks) AfterRandomGenerated(ctx sdk.Context, reqID []byte, random types.Random) {
+	h.reqIDs = append(h.reqIDs, reqID)
+	h.randoms = append(h.randoms, random)
+}
+
+var (
+	_ types.RandomHooks = &MockPanicRandomHooks{}
+
+	mockHooksKey = []byte("mock_hooks")
+)
+
+type MockPanicRandomHooks struct {
+	storeKey sdk.StoreKey
+}
+
+func (h *MockPanicRandomHooks) AfterRandomGenerated(ctx sdk.Context, reqID []byte, random types.Random) {
+	ctx.KVStore(h.storeKey).Set(mockHooksKey, reqID)
+	panic("mock hooks panic")
 }
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/random/types"
+)
+
+// MigrateParams sets the parameters introduced since the parameters were stored to the default values,
+// such as the VRF proof timeout and the beacon parameters which replaced the fixed windows and slash fraction
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// MigrateVRFRequests schedules the expiry of the pending VRF requests, which were kept
+// until the proof was submitted before the VRF proof timeout was introduced
+func (k Keeper) MigrateVRFRequests(ctx sdk.Context) {
+	var requests []types.Request
+	k.IterateVRFRandomRequests(
+		ctx,
+		func(request types.Request) bool {
+			if request.VRFExpirationHeight == 0 {
+				requests = append(requests, request)
+			}
+			return false
+		},
+	)
+
+	for _, request := range requests {
+		k.ScheduleVRFExpiry(ctx, types.GenerateRequestID(request), request)
+	}
+}
+
+// MigrateRandomExpiry schedules the random numbers stored before the pruning was introduced to be pruned,
+// the retention window is counted from the migration so that the consumers can still query them for a while
+func (k Keeper) MigrateRandomExpiry(ctx sdk.Context) {
+	retention := k.GetParamSet(ctx).RandomRetentionBlocks
+	if retention == 0 {
+		return
+	}
+
+	scheduled := make(map[string]bool)
+	k.IterateRandomExpiryQueue(
+		ctx,
+		func(height int64, reqID []byte, consumer sdk.AccAddress) bool {
+			scheduled[string(reqID)] = true
+			return false
+		},
+	)
+
+	consumers := make(map[string]sdk.AccAddress)
+	k.IterateConsumerRequests(
+		ctx,
+		func(reqID []byte, request types.Request) bool {
+			consumers[string(reqID)] = request.Consumer
+			return false
+		},
+	)
+
+	var reqIDs [][]byte
+	k.IterateRandoms(
+		ctx,
+		func(reqID []byte, random types.Random) bool {
+			if !scheduled[string(reqID)] {
+				reqIDs = append(reqIDs, append([]byte{}, reqID...))
+			}
+			return false
+		},
+	)
+
+	for _, reqID := range reqIDs {
+		// the random numbers generated before the consumer index was introduced are not indexed
+		consumer, ok := consumers[string(reqID)]
+		if !ok {
+			consumer = sdk.AccAddress{}
+		}
+		k.EnqueueRandomExpiry(ctx, ctx.BlockHeight()+int64(retention), reqID, consumer)
+	}
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/random/types"
+)
+
+// HandleUpdateVRFPublicKeyProposal designates the VRF public key of the passed proposal,
+// the pending VRF requests must be proved by the new key from then on
+func HandleUpdateVRFPublicKeyProposal(ctx sdk.Context, k Keeper, p *types.UpdateVRFPublicKeyProposal) error {
+	if _, err := types.ParseVRFPublicKey(p.PublicKey); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidVRFPublicKey, err.Error())
+	}
+
+	k.SetVRFPublicKey(ctx, p.PublicKey)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateVRFKey,
+			sdk.NewAttribute(types.AttributeKeyVRFPublicKey, p.PublicKey.String()),
+		),
+	)
+
+	k.Logger(ctx).Info("update VRF public key by proposal", "public_key", p.PublicKey.String())
+	return nil
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/random/types"
+)
+
+// EnqueueRandomExpiry schedules the random number to be pruned at the specified height, the consumer
+// is kept so that the request is removed from the index of the consumer requests along with the random number
+func (k Keeper) EnqueueRandomExpiry(ctx sdk.Context, height int64, reqID []byte, consumer sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRandomExpiryQueue(height, reqID), consumer)
+}
+
+// DequeueRandomExpiry removes the random number from the expiry queue
+func (k Keeper) DequeueRandomExpiry(ctx sdk.Context, height int64, reqID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRandomExpiryQueue(height, reqID))
+}
+
+// IterateExpiredRandoms iterates through the random numbers to be pruned at or before the specified height
+func (k Keeper) IterateExpiredRandoms(
+	ctx sdk.Context, height int64,
+	op func(height int64, reqID []byte, consumer sdk.AccAddress) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.PrefixRandomExpiryQueue, types.KeyRandomExpiryQueueSubspace(height+1))
+	k.iterateRandomExpiryQueue(iterator, op)
+}
+
+// IterateRandomExpiryQueue iterates through all the random numbers to be pruned
+func (k Keeper) IterateRandomExpiryQueue(
+	ctx sdk.Context,
+	op func(height int64, reqID []byte, consumer sdk.AccAddress) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixRandomExpiryQueue)
+	k.iterateRandomExpiryQueue(iterator, op)
+}
+
+func (k Keeper) iterateRandomExpiryQueue(
+	iterator sdk.Iterator,
+	op func(height int64, reqID []byte, consumer sdk.AccAddress) (stop bool),
+) {
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.PrefixRandomExpiryQueue):]
+		expiryHeight := int64(sdk.BigEndianToUint64(key[:8]))
+
+		if stop := op(expiryHeight, key[8:], iterator.Value()); stop {
+			break
+		}
+	}
+}
+
+// DeleteRandom deletes the random number along with the derived random values
+func (k Keeper) DeleteRandom(ctx sdk.Context, reqID []byte, random types.Random) {
+	store := ctx.KVStore(k.storeKey)
+
+	for i := uint32(0); i < random.Output.ValueCount(); i++ {
+		store.Delete(types.KeyRandomValue(reqID, i))
+	}
+	store.Delete(types.KeyRandom(reqID))
+}
+
+// SetPrunedRandomsCommitment stores the Merkle commitment of the random numbers pruned in a block
+func (k Keeper) SetPrunedRandomsCommitment(ctx sdk.Context, commitment types.PrunedRandomsCommitment) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&commitment)
+	store.Set(types.KeyPrunedCommitment(commitment.Height), bz)
+}
+
+// GetPrunedRandomsCommitment retrieves the Merkle commitment of the random numbers pruned at the specified height
+func (k Keeper) GetPrunedRandomsCommitment(ctx sdk.Context, height int64) (types.PrunedRandomsCommitment, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyPrunedCommitment(height))
+	if bz == nil {
+		return types.PrunedRandomsCommitment{}, sdkerrors.Wrapf(types.ErrUnknownCommitment, "height %d", height)
+	}
+
+	var commitment types.PrunedRandomsCommitment
+	k.cdc.MustUnmarshalBinaryBare(bz, &commitment)
+
+	return commitment, nil
+}
+
+// IteratePrunedRandomsCommitments iterates through the Merkle commitments of the pruned random numbers
+func (k Keeper) IteratePrunedRandomsCommitments(ctx sdk.Context, op func(c types.PrunedRandomsCommitment) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixPrunedCommitment)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment types.PrunedRandomsCommitment
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &commitment)
+
+		if stop := op(commitment); stop {
+			break
+		}
+	}
+}
+
+// PruneRandoms deletes the random numbers whose retention window has passed and commits to them
+// by the Merkle root of the encoded PrunedRandom leaves in the order of pruning, so that a pruned
+// random number can still be proven against the commitment with the historical state
+func (k Keeper) PruneRandoms(ctx sdk.Context) {
+	var heights []int64
+	var reqIDs [][]byte
+	var consumers []sdk.AccAddress
+	k.IterateExpiredRandoms(ctx, ctx.BlockHeight(), func(height int64, reqID []byte, consumer sdk.AccAddress) bool {
+		heights = append(heights, height)
+		reqIDs = append(reqIDs, reqID)
+		consumers = append(consumers, consumer)
+		return false
+	})
+
+	var leaves [][]byte
+	for i, reqID := range reqIDs {
+		if random, err := k.GetRandom(ctx, reqID); err == nil {
+			leaves = append(leaves, k.prunedRandomLeaf(ctx, reqID, random))
+			k.DeleteRandom(ctx, reqID, random)
+		}
+
+		k.DeleteConsumerRequest(ctx, consumers[i], reqID)
+		k.DequeueRandomExpiry(ctx, heights[i], reqID)
+	}
+
+	if len(leaves) == 0 {
+		return
+	}
+
+	commitment := types.NewPrunedRandomsCommitment(ctx.BlockHeight(), merkle.HashFromByteSlices(leaves), uint32(len(leaves)))
+	k.SetPrunedRandomsCommitment(ctx, commitment)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePruneRandoms,
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", commitment.Height)),
+			sdk.NewAttribute(types.AttributeKeyCount, fmt.Sprintf("%d", commitment.Count)),
+			sdk.NewAttribute(types.AttributeKeyRoot, hex.EncodeToString(commitment.Root)),
+		),
+	)
+}
+
+// prunedRandomLeaf returns the encoded PrunedRandom of the random number as the Merkle leaf
+func (k Keeper) prunedRandomLeaf(ctx sdk.Context, reqID []byte, random types.Random) []byte {
+	prunedRandom := types.NewPrunedRandom(reqID, random, k.GetRandomValues(ctx, reqID, random))
+	return k.cdc.MustMarshalBinaryBare(&prunedRandom)
+}
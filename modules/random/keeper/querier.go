@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/random/types"
 )
@@ -18,8 +19,18 @@ func NewQuerier(k Keeper, legacyQuerierCdc codec.JSONMarshaler) sdk.Querier {
 		switch path[0] {
 		case types.QueryRandom:
 			return queryRandom(ctx, req, k, legacyQuerierCdc)
+		case types.QueryRandomValue:
+			return queryRandomValue(ctx, req, k, legacyQuerierCdc)
 		case types.QueryRandomRequestQueue:
 			return queryRandomRequestQueue(ctx, req, k, legacyQuerierCdc)
+		case types.QueryConsumerRequests:
+			return queryConsumerRequests(ctx, req, k, legacyQuerierCdc)
+		case types.QueryVerifyRandom:
+			return queryVerifyRandom(ctx, req, k, legacyQuerierCdc)
+		case types.QueryPrunedCommitment:
+			return queryPrunedCommitment(ctx, req, k, legacyQuerierCdc)
+		case types.QueryParams:
+			return queryParams(ctx, k, legacyQuerierCdc)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -50,6 +61,84 @@ func queryRandom(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerier
 	return bz, nil
 }
 
+func queryRandomValue(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryRandomValueParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	reqID, err := hex.DecodeString(params.ReqID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidReqID, params.ReqID)
+	}
+
+	value, err := k.GetRandomValue(ctx, reqID, params.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, types.QueryRandomValueResponse{Value: value})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryConsumerRequests(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryConsumerRequestsParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	pageReq := &query.PageRequest{Limit: params.Limit}
+	if params.Page > 1 {
+		pageReq.Offset = (params.Page - 1) * params.Limit
+	}
+
+	requests, _, err := k.GetPaginatedConsumerRequests(ctx, params.Consumer, pageReq)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, requests)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryVerifyRandom(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryRandomParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	reqID, err := hex.DecodeString(params.ReqID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidReqID, params.ReqID)
+	}
+
+	random, err2 := k.GetRandom(ctx, reqID)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	result := types.QueryVerifyRandomResponse{
+		Random:       &random,
+		VRFPublicKey: k.GetVRFPublicKey(ctx),
+		Verified:     k.VerifyVRFRandom(ctx, random),
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, result)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryRandomRequestQueue(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
 	var params types.QueryRandomRequestQueueParams
 	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
@@ -104,3 +193,33 @@ func queryAllRandomRequestsInQueue(ctx sdk.Context, k Keeper) []types.Request {
 
 	return requests
 }
+
+func queryPrunedCommitment(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	var params types.QueryPrunedCommitmentParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	commitment, err := k.GetPrunedRandomsCommitment(ctx, params.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, commitment)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper, legacyQuerierCdc codec.JSONMarshaler) ([]byte, error) {
+	params := k.GetParamSet(ctx)
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -2,8 +2,7 @@ package keeper
 
 import (
 	"encoding/hex"
-	"math/rand"
-	"time"
+	"fmt"
 
 	"github.com/tidwall/gjson"
 
@@ -18,36 +17,49 @@ import (
 	"github.com/irisnet/irishub/modules/random/types"
 )
 
-// RequestService request the service for oracle seed
+// RequestService request the service for oracle seeds from the providers selected deterministically
 func (k Keeper) RequestService(ctx sdk.Context, consumer sdk.AccAddress, serviceFeeCap sdk.Coins) (tmbytes.HexBytes, error) {
 	iterator := k.serviceKeeper.ServiceBindingsIterator(ctx, types.ServiceName)
 	defer iterator.Close()
 
-	var bindings []servicetypes.ServiceBinding
+	var candidates []sdk.AccAddress
 	for ; iterator.Valid(); iterator.Next() {
 		var binding servicetypes.ServiceBinding
 		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &binding)
 
-		bindings = append(bindings, binding)
+		if binding.Available {
+			candidates = append(candidates, binding.Provider)
+		}
 	}
 
-	if len(bindings) < 1 {
-		return nil, types.ErrInvalidServiceBindings
+	params := k.GetParamSet(ctx)
+	if len(candidates) < int(params.OracleThreshold) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidServiceBindings, "%d available providers, %d required",
+			len(candidates), params.OracleThreshold,
+		)
+	}
+
+	seed := types.GenerateProviderSeed(ctx.BlockHeader().LastBlockId.Hash, types.SHA256(ctx.TxBytes()), consumer)
+	providers := types.SelectProviders(seed, candidates, int(params.OracleProviders))
+
+	// the service fee cap applies to each provider
+	var totalFeeCap sdk.Coins
+	for _, coin := range serviceFeeCap {
+		totalFeeCap = append(totalFeeCap, sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(len(providers)))))
 	}
 
 	coins := k.bankKeeper.SpendableCoins(ctx, consumer)
-	if !coins.IsAllGTE(serviceFeeCap) {
+	if !coins.IsAllGTE(totalFeeCap) {
 		return nil, sdkerrors.ErrInsufficientFee
 	}
 
-	rand.Seed(time.Now().UnixNano())
-	provider := []sdk.AccAddress{bindings[rand.Intn(len(bindings))].Provider}
 	timeout := k.serviceKeeper.GetParams(ctx).MaxRequestTimeout
 
 	requestContextID, err := k.serviceKeeper.CreateRequestContext(
 		ctx,
 		types.ServiceName,
-		provider,
+		providers,
 		consumer,
 		"{}",
 		serviceFeeCap,
@@ -57,7 +69,7 @@ func (k Keeper) RequestService(ctx sdk.Context, consumer sdk.AccAddress, service
 		0,
 		0,
 		exported.PAUSED,
-		1,
+		params.OracleThreshold,
 		types.ModuleName,
 	)
 	if err != nil {
@@ -76,71 +88,93 @@ func (k Keeper) StartRequestContext(
 	return k.serviceKeeper.StartRequestContext(ctx, serviceContextID, consumer)
 }
 
+// HandlerStateChanged is responsible for processing the state change of the request context,
+// the request fails if the request context is paused or completed before the response is handled
 func (k Keeper) HandlerStateChanged(ctx sdk.Context, requestContextID tmbytes.HexBytes, err string) {
-	reqCtx, existed := k.serviceKeeper.GetRequestContext(ctx, requestContextID)
-	if !existed {
+	request, e := k.GetOracleRandRequest(ctx, requestContextID)
+	if e != nil {
 		ctx.Logger().Error(
-			"Not existed requestContext",
-			"requestContextID", requestContextID.String(),
+			"can not find request",
+			"requestContextID",
+			requestContextID.String(),
+			"err",
+			e.Error(),
 		)
 		return
 	}
-	ctx.Logger().Error(
-		"Oracle state invalid", "requestContextID",
-		requestContextID.String(), "state", reqCtx.State.String(),
-	)
-	k.DeleteOracleRandRequest(ctx, requestContextID)
-	return
+
+	reason := err
+	if len(reason) == 0 {
+		reqCtx, existed := k.serviceKeeper.GetRequestContext(ctx, requestContextID)
+		if !existed {
+			reason = "request context not found"
+		} else {
+			reason = fmt.Sprintf("request context state changed to %s", reqCtx.State.String())
+		}
+	}
+
+	k.FailOracleRandRequest(ctx, request, reason)
 }
 
 // HandlerResponse is responsible for processing the data returned from the service module
 func (k Keeper) HandlerResponse(ctx sdk.Context, requestContextID tmbytes.HexBytes, responseOutput []string, err error) {
-	if len(responseOutput) == 0 || err != nil {
+	request, e := k.GetOracleRandRequest(ctx, requestContextID)
+	if e != nil {
 		ctx.Logger().Error(
-			"respond service failed",
+			"can not find request",
 			"requestContextID",
 			requestContextID.String(),
 			"err",
-			err.Error(),
+			e.Error(),
 		)
-		k.DeleteOracleRandRequest(ctx, requestContextID)
 		return
 	}
 
-	_, existed := k.serviceKeeper.GetRequestContext(ctx, requestContextID)
-	if !existed {
-		k.DeleteOracleRandRequest(ctx, requestContextID)
+	if err != nil {
+		k.FailOracleRandRequest(ctx, request, err.Error())
 		return
 	}
 
-	request, err := k.GetOracleRandRequest(ctx, requestContextID)
-	if err != nil {
-		ctx.Logger().Error(
-			"can not find request",
-			"requestContextID",
-			requestContextID.String(),
-			"err",
-			err.Error(),
-		)
-		k.DeleteOracleRandRequest(ctx, requestContextID)
+	if len(responseOutput) == 0 {
+		k.FailOracleRandRequest(ctx, request, "no response")
 		return
 	}
 
-	result := gjson.Get(responseOutput[0], types.ServiceValueJsonPath)
+	if _, existed := k.serviceKeeper.GetRequestContext(ctx, requestContextID); !existed {
+		k.FailOracleRandRequest(ctx, request, "request context not found")
+		return
+	}
 
-	seed, err := hex.DecodeString(result.String())
-	if err != nil || len(seed) != types.SeedBytesLength {
-		ctx.Logger().Error(
-			"invalid seed",
-			"seed",
-			hex.EncodeToString(seed),
-			"err",
-			err.Error(),
+	var seeds [][]byte
+	for _, output := range responseOutput {
+		result := gjson.Get(output, types.ServiceValueJsonPath)
+
+		seed, err := hex.DecodeString(result.String())
+		if err != nil || len(seed) != types.SeedBytesLength {
+			ctx.Logger().Error(
+				"invalid seed",
+				"requestContextID",
+				requestContextID.String(),
+				"seed",
+				result.String(),
+			)
+			continue
+		}
+
+		seeds = append(seeds, seed)
+	}
+
+	params := k.GetParamSet(ctx)
+	if len(seeds) < int(params.OracleThreshold) {
+		k.FailOracleRandRequest(
+			ctx, request,
+			fmt.Sprintf("%d valid seeds, %d required", len(seeds), params.OracleThreshold),
 		)
-		k.DeleteOracleRandRequest(ctx, requestContextID)
 		return
 	}
 
+	seed := types.CombineSeeds(seeds)
+
 	currentTimestamp := ctx.BlockHeader().Time.Unix()
 	lastBlockHeight := ctx.BlockHeight() - 1
 	lastBlockHash := ctx.BlockHeader().LastBlockId.Hash
@@ -149,10 +183,63 @@ func (k Keeper) HandlerResponse(ctx sdk.Context, requestContextID tmbytes.HexByt
 	reqID := types.GenerateRequestID(request)
 
 	// generate a random number
-	random := types.MakePRNG(lastBlockHash, currentTimestamp, request.Consumer, seed, true).GetRand()
-	k.SetRandom(ctx, reqID, types.NewRandom(request.TxHash, lastBlockHeight, random.FloatString(types.RandPrec)))
+	prng := types.MakePRNG(lastBlockHash, currentTimestamp, request.Consumer, reqID, seed, true)
+	randomNum := types.NewRandom(request.TxHash, lastBlockHeight, prng.GetRand().FloatString(types.RandPrec))
+	randomNum = k.StoreRandom(ctx, reqID, request, randomNum, prng.GetSeed())
+
+	// the escrowed request fee is paid to the fee destination
+	if err := k.payRequestFee(ctx, k.GetModuleAddress(), request.RequestFee, params.FeeDestination); err != nil {
+		ctx.Logger().Error(
+			"pay request fee failed",
+			"requestContextID",
+			requestContextID.String(),
+			"err",
+			err.Error(),
+		)
+	}
 
 	k.DeleteOracleRandRequest(ctx, requestContextID)
+
+	k.AfterRandomGenerated(ctx, reqID, request, randomNum)
+}
+
+// FailOracleRandRequest records the failure of the oracle request, refunds the escrowed request fee
+// to the consumer and calls back the hooks of the module which requested the random number
+func (k Keeper) FailOracleRandRequest(ctx sdk.Context, request types.Request, reason string) types.Random {
+	reqID := types.GenerateRequestID(request)
+
+	random := types.NewFailedRandom(request.TxHash, ctx.BlockHeight(), reason)
+	random = k.StoreRandom(ctx, reqID, request, random, nil)
+
+	refund := request.RequestFee
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, request.Consumer, refund); err != nil {
+			ctx.Logger().Error(
+				"refund request fee failed",
+				"requestContextID",
+				request.ServiceContextID.String(),
+				"err",
+				err.Error(),
+			)
+			refund = sdk.Coins{}
+		}
+	}
+
+	k.DeleteOracleRandRequest(ctx, request.ServiceContextID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRandomFailed,
+			sdk.NewAttribute(types.AttributeKeyRequestID, hex.EncodeToString(reqID)),
+			sdk.NewAttribute(types.AttributeKeyRequestContextID, request.ServiceContextID.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+	)
+
+	k.AfterRandomGenerated(ctx, reqID, request, random)
+
+	return random
 }
 
 // GetRequestContext retrieves the request context by the specified request context id
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/random/types"
+)
+
+// SetVRFPublicKey stores the designated VRF public key
+func (k Keeper) SetVRFPublicKey(ctx sdk.Context, pubKey []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyVRFPublicKey, pubKey)
+}
+
+// GetVRFPublicKey retrieves the designated VRF public key
+func (k Keeper) GetVRFPublicKey(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.KeyVRFPublicKey)
+}
+
+// SetVRFRandomRequest stores the VRF request waiting for the proof
+func (k Keeper) SetVRFRandomRequest(ctx sdk.Context, reqID []byte, request types.Request) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&request)
+	store.Set(types.KeyVRFRandomRequest(reqID), bz)
+}
+
+// GetVRFRandomRequest retrieves the VRF request waiting for the proof by the specified request id
+func (k Keeper) GetVRFRandomRequest(ctx sdk.Context, reqID []byte) (types.Request, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyVRFRandomRequest(reqID))
+	if bz == nil {
+		return types.Request{}, sdkerrors.Wrap(types.ErrInvalidReqID, hex.EncodeToString(reqID))
+	}
+
+	var request types.Request
+	k.cdc.MustUnmarshalBinaryBare(bz, &request)
+
+	return request, nil
+}
+
+// DeleteVRFRandomRequest deletes a VRF request
+func (k Keeper) DeleteVRFRandomRequest(ctx sdk.Context, reqID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyVRFRandomRequest(reqID))
+}
+
+// IterateVRFRandomRequests iterates through the VRF requests waiting for the proof
+func (k Keeper) IterateVRFRandomRequests(ctx sdk.Context, op func(r types.Request) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixVRFRandomRequest)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.Request
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &request)
+
+		if stop := op(request); stop {
+			break
+		}
+	}
+}
+
+// OpenVRFRequest fixes the VRF input of the request and enqueues the request again
+// to be failed if the proof is not submitted before the VRF proof timeout
+func (k Keeper) OpenVRFRequest(ctx sdk.Context, reqID []byte, request types.Request, blockHash []byte) types.Request {
+	request.VRFInput = types.GenerateVRFInput(blockHash, reqID)
+	return k.ScheduleVRFExpiry(ctx, reqID, request)
+}
+
+// ExpireVRFRequest fails the VRF request whose proof was not submitted in time
+// and calls back the hooks of the module which requested the random number
+func (k Keeper) ExpireVRFRequest(ctx sdk.Context, reqID []byte, request types.Request) types.Random {
+	reason := fmt.Sprintf("the VRF proof was not submitted before height %d", request.VRFExpirationHeight)
+
+	random := types.NewFailedRandom(request.TxHash, ctx.BlockHeight(), reason)
+	random = k.StoreRandom(ctx, reqID, request, random, nil)
+	k.DeleteVRFRandomRequest(ctx, reqID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRandomFailed,
+			sdk.NewAttribute(types.AttributeKeyRequestID, hex.EncodeToString(reqID)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+
+	k.AfterRandomGenerated(ctx, reqID, request, random)
+
+	return random
+}
+
+// ScheduleVRFExpiry stores the VRF request waiting for the proof and enqueues it at the expiration height
+func (k Keeper) ScheduleVRFExpiry(ctx sdk.Context, reqID []byte, request types.Request) types.Request {
+	request.VRFExpirationHeight = ctx.BlockHeight() + int64(k.GetParamSet(ctx).VRFProofTimeout)
+
+	k.SetVRFRandomRequest(ctx, reqID, request)
+	k.EnqueueRandomRequest(ctx, request.VRFExpirationHeight, reqID, request)
+
+	return request
+}
+
+// SubmitVRFProof verifies the proof of a VRF request against the designated VRF key
+// and stores the random number derived from the VRF output
+func (k Keeper) SubmitVRFProof(ctx sdk.Context, reqID []byte, proof []byte) (types.Random, error) {
+	request, err := k.GetVRFRandomRequest(ctx, reqID)
+	if err != nil {
+		return types.Random{}, err
+	}
+
+	pubKeyBz := k.GetVRFPublicKey(ctx)
+	pubKey, err := types.ParseVRFPublicKey(pubKeyBz)
+	if err != nil {
+		return types.Random{}, sdkerrors.Wrap(types.ErrInvalidVRFPublicKey, err.Error())
+	}
+
+	output, err := types.VerifyVRF(pubKey, request.VRFInput, proof)
+	if err != nil {
+		return types.Random{}, sdkerrors.Wrap(types.ErrInvalidVRFProof, err.Error())
+	}
+
+	random := types.NewVRFRandom(
+		request.TxHash,
+		ctx.BlockHeight(),
+		types.GetVRFRand(output).FloatString(types.RandPrec),
+		proof,
+		request.VRFInput,
+		pubKeyBz,
+	)
+	random = k.StoreRandom(ctx, reqID, request, random, output)
+	k.DeleteVRFRandomRequest(ctx, reqID)
+	k.DequeueRandomRequest(ctx, request.VRFExpirationHeight, reqID)
+
+	k.AfterRandomGenerated(ctx, reqID, request, random)
+
+	return random, nil
+}
+
+// VerifyVRFRandom verifies the VRF proof of the random number against the VRF key it was proved by
+// and checks that the random number is derived from the VRF output. The random numbers stored
+// before the key was recorded along with them are verified against the designated VRF key
+func (k Keeper) VerifyVRFRandom(ctx sdk.Context, random types.Random) bool {
+	if len(random.Proof) == 0 {
+		return false
+	}
+
+	pubKeyBz := []byte(random.VRFPublicKey)
+	if len(pubKeyBz) == 0 {
+		pubKeyBz = k.GetVRFPublicKey(ctx)
+	}
+
+	pubKey, err := types.ParseVRFPublicKey(pubKeyBz)
+	if err != nil {
+		return false
+	}
+
+	output, err := types.VerifyVRF(pubKey, random.VRFInput, random.Proof)
+	if err != nil {
+		return false
+	}
+
+	return types.GetVRFRand(output).FloatString(types.RandPrec) == random.Value
+}
@@ -0,0 +1,22 @@
+package random
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/random/keeper"
+	"github.com/irisnet/irishub/modules/random/types"
+)
+
+// NewProposalHandler returns a handler for the random governance proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateVRFPublicKeyProposal:
+			return keeper.HandleUpdateVRFPublicKeyProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized random proposal content type: %T", c)
+		}
+	}
+}
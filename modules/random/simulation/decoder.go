@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/irisnet/irishub/modules/random/types"
@@ -14,6 +15,39 @@ import (
 func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
+		case bytes.Equal(kvA.Key, types.KeyVRFPublicKey):
+			return fmt.Sprintf("vrfPublicKeyA: %X\nvrfPublicKeyB: %X", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key, types.KeyRequestCount):
+			return fmt.Sprintf("requestCountA: %X\nrequestCountB: %X", kvA.Value, kvB.Value)
+		case bytes.HasPrefix(kvA.Key, types.PrefixRandomValue):
+			return fmt.Sprintf("valueA: %s\nvalueB: %s", kvA.Value, kvB.Value)
+		case bytes.HasPrefix(kvA.Key, types.PrefixRandomExpiryQueue):
+			return fmt.Sprintf("expiryConsumerA: %s\nexpiryConsumerB: %s", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+		case bytes.HasPrefix(kvA.Key, types.PrefixPrunedCommitment):
+			var commitmentA, commitmentB types.PrunedRandomsCommitment
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &commitmentA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &commitmentB)
+			return fmt.Sprintf("prunedCommitmentA: %v\nprunedCommitmentB: %v", commitmentA, commitmentB)
+		case bytes.HasPrefix(kvA.Key, types.PrefixBeaconParticipant):
+			var participantA, participantB types.BeaconParticipant
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &participantA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &participantB)
+			return fmt.Sprintf("participantA: %v\nparticipantB: %v", participantA, participantB)
+		case bytes.HasPrefix(kvA.Key, types.PrefixBeaconRequest):
+			var requestA, requestB types.Request
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &requestA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &requestB)
+			return fmt.Sprintf("beaconRequestA: %v\nbeaconRequestB: %v", requestA, requestB)
+		case bytes.HasPrefix(kvA.Key, types.PrefixBeaconCommit):
+			var commitA, commitB types.BeaconCommit
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &commitA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &commitB)
+			return fmt.Sprintf("commitA: %v\ncommitB: %v", commitA, commitB)
+		case bytes.HasPrefix(kvA.Key, types.PrefixConsumerRequest):
+			var requestA, requestB types.Request
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &requestA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &requestB)
+			return fmt.Sprintf("consumerRequestA: %v\nconsumerRequestB: %v", requestA, requestB)
 		case bytes.Equal(kvA.Key[:6], types.PrefixRandom):
 			var randomA, randomB types.Random
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &randomA)
@@ -24,6 +58,11 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &requestA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &requestB)
 			return fmt.Sprintf("requestA: %v\nrequestB: %v", requestA, requestB)
+		case bytes.HasPrefix(kvA.Key, types.PrefixVRFRandomRequest):
+			var requestA, requestB types.Request
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &requestA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &requestB)
+			return fmt.Sprintf("vrfRequestA: %v\nvrfRequestB: %v", requestA, requestB)
 		default:
 			panic(fmt.Sprintf("invalid random key prefix %X", kvA.Key[:1]))
 		}
@@ -0,0 +1,110 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/irisnet/irishub/modules/random/types"
+)
+
+// Simulation parameter constants
+const (
+	MinBlockInterval    = "min_block_interval"
+	MaxBlockInterval    = "max_block_interval"
+	FeeDestination      = "fee_destination"
+	MaxRequestsPerBlock = "max_requests_per_block"
+	OracleProviders     = "oracle_providers"
+	OracleThreshold     = "oracle_threshold"
+)
+
+// GenMinBlockInterval randomized MinBlockInterval, which keeps the block intervals
+// of the simulated requests valid
+func GenMinBlockInterval(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 10))
+}
+
+// GenMaxBlockInterval randomized MaxBlockInterval, which keeps the block intervals
+// of the simulated requests valid
+func GenMaxBlockInterval(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 100, 100000))
+}
+
+// GenFeeDestination randomized FeeDestination
+func GenFeeDestination(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return types.FeeDestinationCommunityPool
+	}
+	return types.FeeDestinationFeeCollector
+}
+
+// GenMaxRequestsPerBlock randomized MaxRequestsPerBlock
+func GenMaxRequestsPerBlock(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 50, 200))
+}
+
+// GenOracleProviders randomized OracleProviders
+func GenOracleProviders(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 4))
+}
+
+// GenOracleThreshold randomized OracleThreshold, which is not greater than the oracle providers
+func GenOracleThreshold(r *rand.Rand, oracleProviders uint32) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, int(oracleProviders)+1))
+}
+
+// RandomizedGenState generates a random GenesisState for random
+func RandomizedGenState(simState *module.SimulationState) {
+	var minBlockInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinBlockInterval, &minBlockInterval, simState.Rand,
+		func(r *rand.Rand) { minBlockInterval = GenMinBlockInterval(r) },
+	)
+
+	var maxBlockInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxBlockInterval, &maxBlockInterval, simState.Rand,
+		func(r *rand.Rand) { maxBlockInterval = GenMaxBlockInterval(r) },
+	)
+
+	var feeDestination string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeDestination, &feeDestination, simState.Rand,
+		func(r *rand.Rand) { feeDestination = GenFeeDestination(r) },
+	)
+
+	var maxRequestsPerBlock uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxRequestsPerBlock, &maxRequestsPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxRequestsPerBlock = GenMaxRequestsPerBlock(r) },
+	)
+
+	var oracleProviders uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OracleProviders, &oracleProviders, simState.Rand,
+		func(r *rand.Rand) { oracleProviders = GenOracleProviders(r) },
+	)
+
+	var oracleThreshold uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OracleThreshold, &oracleThreshold, simState.Rand,
+		func(r *rand.Rand) { oracleThreshold = GenOracleThreshold(r, oracleProviders) },
+	)
+
+	params := types.NewParams(
+		minBlockInterval, maxBlockInterval, sdk.Coins{}, feeDestination, maxRequestsPerBlock,
+		oracleProviders, oracleThreshold, types.SeedCombinationHash, types.DefaultParams().RandomRetentionBlocks,
+		types.DefaultVRFProofTimeout, types.DefaultBeaconMinDeposit, types.DefaultBeaconSlashFraction,
+		types.DefaultBeaconCommitWindow, types.DefaultBeaconRevealWindow,
+	)
+	randomGenesis := types.NewGenesisState(map[string]types.Requests{}, params)
+
+	fmt.Printf("Selected randomly generated random parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, randomGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(randomGenesis)
+}
@@ -1,63 +1,171 @@
 package simulation
 
 import (
+	"encoding/hex"
+	"fmt"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
-	"github.com/cosmos/cosmos-sdk/simapp/helpers"
-	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
+	servicetypes "github.com/irismod/service/types"
+
 	"github.com/irisnet/irishub/modules/random/keeper"
 	"github.com/irisnet/irishub/modules/random/types"
+	"github.com/irisnet/irishub/simapp/helpers"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgRequestRandom             = "op_weight_msg_request_random"
+	OpWeightMsgRequestOracleRandom       = "op_weight_msg_request_oracle_random"
+	OpWeightMsgRespondService            = "op_weight_msg_respond_service"
+	DefaultWeightMsgRequestRandom        = 100
+	DefaultWeightMsgRequestOracleRandom  = 30
+	DefaultWeightMsgRespondService       = 100
+	DefaultInvalidSeedResponsePercentage = 10 // percentage of the mock responses with an invalid seed
+)
+
+// Mock binding of the providers to the random service
+const (
+	ServiceQoS     = 1
+	ServiceOptions = "{}"
+)
+
+var (
+	ServicePricing = fmt.Sprintf(`{"price":"1%s"}`, sdk.DefaultBondDenom)
+	ServiceFeeCap  = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+
+	mockService = helpers.MockService{
+		Name:              types.ServiceName,
+		Description:       types.ServiceDesc,
+		Tags:              types.ServiceTags,
+		AuthorDescription: types.AuthorDescription,
+		Schemas:           types.ServiceSchemas,
+		Pricing:           ServicePricing,
+		QoS:               ServiceQoS,
+		Options:           ServiceOptions,
+	}
 )
 
-// WeightedOperations generates a MsgRequestRandom with random values.
-func WeightedOperations(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simulation.WeightedOperations {
-	var weightMsgRequestRandom = 100
+// WeightedOperations returns all the operations from the random module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONMarshaler,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.ServiceSimKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightRequest       int
+		weightRequestOracle int
+		weightRespond       int
+	)
+
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgRequestRandom, &weightRequest, nil,
+		func(_ *rand.Rand) { weightRequest = DefaultWeightMsgRequestRandom },
+	)
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgRequestOracleRandom, &weightRequestOracle, nil,
+		func(_ *rand.Rand) { weightRequestOracle = DefaultWeightMsgRequestOracleRandom },
+	)
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgRespondService, &weightRespond, nil,
+		func(_ *rand.Rand) { weightRespond = DefaultWeightMsgRespondService },
+	)
+
 	return simulation.WeightedOperations{
-		simulation.NewWeightedOperation(
-			weightMsgRequestRandom,
-			func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
-				accs []simtypes.Account, chainID string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-
-				simAccount, _ := simtypes.RandomAcc(r, accs)
-				blockInterval := simtypes.RandIntBetween(r, 10, 100)
-
-				account := ak.GetAccount(ctx, simAccount.Address)
-
-				spendable := bk.SpendableCoins(ctx, account.GetAddress())
-
-				msg := types.NewMsgRequestRandom(simAccount.Address, uint64(blockInterval), false, nil)
-
-				fees, err := simtypes.RandomFees(r, ctx, spendable)
-				if err != nil {
-					return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
-				}
-
-				txGen := simappparams.MakeEncodingConfig().TxConfig
-				tx, err := helpers.GenTx(
-					txGen,
-					[]sdk.Msg{msg},
-					fees,
-					helpers.DefaultGenTxGas,
-					chainID,
-					[]uint64{account.GetAccountNumber()},
-					[]uint64{account.GetSequence()},
-					simAccount.PrivKey,
-				)
-				if err != nil {
-					return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
-				}
-
-				if _, _, err := app.Deliver(tx); err != nil {
-					return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
-				}
-
-				return simtypes.NewOperationMsg(msg, true, ""), nil, nil
-			},
-		),
+		simulation.NewWeightedOperation(weightRequest, SimulateMsgRequestRandom(k, ak, bk)),
+		simulation.NewWeightedOperation(weightRequestOracle, SimulateMsgRequestOracleRandom(k, ak, bk, sk)),
+		simulation.NewWeightedOperation(weightRespond, SimulateMsgRespondService(ak, bk, sk)),
+	}
+}
+
+// SimulateMsgRequestRandom generates a MsgRequestRandom with random values
+func SimulateMsgRequestRandom(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		blockInterval := simtypes.RandIntBetween(r, 10, 100)
+
+		msg := types.NewMsgRequestRandom(simAccount.Address, uint64(blockInterval), false, false, false, nil, types.OutputSpec{})
+
+		return helpers.DeliverTx(r, app, ctx, ak, bk, simAccount, msg, chainID, k.GetParamSet(ctx).RequestFee)
+	}
+}
+
+// SimulateMsgRequestOracleRandom generates a MsgRequestRandom in the oracle mode, the providers
+// are bound to the random service by the service txs so that the oracle seeds can be requested from them
+func SimulateMsgRequestOracleRandom(
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.ServiceSimKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParamSet(ctx)
+		consumer, _ := simtypes.RandomAcc(r, accs)
+
+		providers := helpers.RandomProviders(r, accs, int(params.OracleThreshold))
+		if err := mockService.Bind(r, app, ctx, ak, bk, sk, consumer, providers, chainID); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestRandom, err.Error()), nil, nil
+		}
+
+		if availableProviders(ctx, k, sk) < int(params.OracleThreshold) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRequestRandom, "not enough available providers"), nil, nil
+		}
+
+		blockInterval := simtypes.RandIntBetween(r, 10, 100)
+
+		msg := types.NewMsgRequestRandom(consumer.Address, uint64(blockInterval), true, false, false, ServiceFeeCap, types.OutputSpec{})
+
+		// the service fee cap applies to each provider
+		var totalFeeCap sdk.Coins
+		for _, coin := range ServiceFeeCap {
+			totalFeeCap = append(totalFeeCap, sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(params.OracleProviders))))
+		}
+
+		return helpers.DeliverTx(r, app, ctx, ak, bk, consumer, msg, chainID, totalFeeCap.Add(params.RequestFee...))
+	}
+}
+
+// SimulateMsgRespondService mocks the response of a provider to a random active request of the
+// random service, a part of the responses carry an invalid seed to exercise the failed requests
+func SimulateMsgRespondService(ak types.AccountKeeper, bk types.BankKeeper, sk types.ServiceSimKeeper) simtypes.Operation {
+	return helpers.SimulateMsgRespondService(ak, bk, sk, types.ServiceName, func(r *rand.Rand) string {
+		seed := make([]byte, types.SeedBytesLength)
+		r.Read(seed)
+		if r.Intn(100) < DefaultInvalidSeedResponsePercentage {
+			seed = seed[:types.SeedBytesLength/2]
+		}
+
+		return fmt.Sprintf(`{"header":{},"body":{"%s":"%s"}}`, types.ServiceValueJsonPath, hex.EncodeToString(seed))
+	})
+}
+
+// availableProviders returns the number of the providers available for the random service
+func availableProviders(ctx sdk.Context, k keeper.Keeper, sk types.ServiceSimKeeper) int {
+	iterator := sk.ServiceBindingsIterator(ctx, types.ServiceName)
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		var binding servicetypes.ServiceBinding
+		k.GetCdc().MustUnmarshalBinaryBare(iterator.Value(), &binding)
+
+		if binding.Available {
+			count++
+		}
 	}
+	return count
 }
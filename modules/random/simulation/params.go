@@ -3,13 +3,25 @@ package simulation
 // DONTCOVER
 
 import (
+	"fmt"
 	"math/rand"
 
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/irisnet/irishub/modules/random/types"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
 // on the simulation
-func ParamChanges(r *rand.Rand) []simulation.ParamChange {
-	return []simulation.ParamChange{}
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyMaxRequestsPerBlock),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxRequestsPerBlock(r))
+			},
+		),
+	}
 }
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// In the beacon mode the registered participants commit the hash of a secret during the commit
+// window of a round and reveal the secret during the following reveal window. The random number
+// mixes all the reveals, and the participants who committed without revealing are slashed.
+// The windows, the slash fraction and the minimal deposit are the parameters of the module
+var (
+	DefaultBeaconCommitWindow  = uint64(10)                                                 // number of blocks in which the commitments are accepted
+	DefaultBeaconRevealWindow  = uint64(10)                                                 // number of blocks in which the secrets are revealed
+	DefaultBeaconSlashFraction = sdk.NewDecWithPrec(1, 1)                                   // fraction of the deposit slashed for a missing reveal
+	DefaultBeaconMinDeposit    = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)) // minimal deposit to commit to the rounds
+)
+
+// NewBeaconParticipant constructs a BeaconParticipant
+func NewBeaconParticipant(address sdk.AccAddress, deposit sdk.Coins) BeaconParticipant {
+	return BeaconParticipant{
+		Address: address,
+		Deposit: deposit,
+	}
+}
+
+// NewBeaconCommit constructs a BeaconCommit
+func NewBeaconCommit(reqID []byte, participant sdk.AccAddress, commitment []byte) BeaconCommit {
+	return BeaconCommit{
+		ReqId:       reqID,
+		Participant: participant,
+		Commitment:  commitment,
+	}
+}
+
+// GenerateBeaconCommitment returns the commitment of the secret, which is bound to the
+// participant so that the commitments of others can not be replayed
+func GenerateBeaconCommitment(secret []byte, participant sdk.AccAddress) []byte {
+	return SHA256(append(append([]byte{}, secret...), participant.Bytes()...))
+}
+
+// Revealed returns true if the secret of the commitment has been revealed
+func (c BeaconCommit) Revealed() bool {
+	return len(c.Secret) > 0
+}
+
+// GetBeaconRand returns the random number between [0,1) with `RandPrec` precision, which mixes
+// the block hash at the end of the round with all the revealed secrets in order
+func GetBeaconRand(blockHash []byte, reqID []byte, secrets [][]byte) *big.Rat {
+	return SeedToRand(GetBeaconSeed(blockHash, reqID, secrets))
+}
+
+// GetBeaconSeed returns the seed of the beacon random number
+func GetBeaconSeed(blockHash []byte, reqID []byte, secrets [][]byte) []byte {
+	seed := append(append([]byte{}, blockHash...), reqID...)
+	for _, secret := range secrets {
+		seed = append(seed, SHA256(secret)...)
+	}
+	return SHA256(seed)
+}
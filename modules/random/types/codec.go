@@ -5,16 +5,32 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterCodec registers concrete types on the codec.
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRequestRandom{}, "irishub/random/MsgRequestRandom", nil)
+	cdc.RegisterConcrete(&MsgSubmitVRFProof{}, "irishub/random/MsgSubmitVRFProof", nil)
+	cdc.RegisterConcrete(&MsgRegisterBeaconParticipant{}, "irishub/random/MsgRegisterBeaconParticipant", nil)
+	cdc.RegisterConcrete(&MsgUnregisterBeaconParticipant{}, "irishub/random/MsgUnregisterBeaconParticipant", nil)
+	cdc.RegisterConcrete(&MsgCommitBeacon{}, "irishub/random/MsgCommitBeacon", nil)
+	cdc.RegisterConcrete(&MsgRevealBeacon{}, "irishub/random/MsgRevealBeacon", nil)
+	cdc.RegisterConcrete(&UpdateVRFPublicKeyProposal{}, "irishub/random/UpdateVRFPublicKeyProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRequestRandom{},
+		&MsgSubmitVRFProof{},
+		&MsgRegisterBeaconParticipant{},
+		&MsgUnregisterBeaconParticipant{},
+		&MsgCommitBeacon{},
+		&MsgRevealBeacon{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdateVRFPublicKeyProposal{},
 	)
 }
 
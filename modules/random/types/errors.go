@@ -10,4 +10,23 @@ var (
 	ErrInvalidHeight           = sdkerrors.Register(ModuleName, 3, "invalid height, must be greater than 0")
 	ErrInvalidServiceBindings  = sdkerrors.Register(ModuleName, 4, "no service bindings available")
 	ErrInvalidRequestContextID = sdkerrors.Register(ModuleName, 5, "invalid request context id")
+	ErrVRFPublicKeyNotSet      = sdkerrors.Register(ModuleName, 6, "VRF public key not set")
+	ErrInvalidVRFPublicKey     = sdkerrors.Register(ModuleName, 7, "invalid VRF public key")
+	ErrInvalidVRFProof         = sdkerrors.Register(ModuleName, 8, "invalid VRF proof")
+	ErrBeaconParticipantExists = sdkerrors.Register(ModuleName, 9, "beacon participant already exists")
+	ErrUnknownParticipant      = sdkerrors.Register(ModuleName, 10, "unknown beacon participant")
+	ErrPendingBeaconCommits    = sdkerrors.Register(ModuleName, 11, "beacon participant has pending commitments")
+	ErrInvalidBeaconRound      = sdkerrors.Register(ModuleName, 12, "invalid beacon round")
+	ErrBeaconCommitExists      = sdkerrors.Register(ModuleName, 13, "beacon commitment already exists")
+	ErrUnknownBeaconCommit     = sdkerrors.Register(ModuleName, 14, "unknown beacon commitment")
+	ErrInvalidBeaconReveal     = sdkerrors.Register(ModuleName, 15, "invalid beacon reveal")
+	ErrHooksAlreadyRegistered  = sdkerrors.Register(ModuleName, 16, "random hooks already registered")
+	ErrHooksNotRegistered      = sdkerrors.Register(ModuleName, 17, "random hooks not registered")
+	ErrInvalidParams           = sdkerrors.Register(ModuleName, 18, "invalid params")
+	ErrInvalidBlockInterval    = sdkerrors.Register(ModuleName, 19, "invalid block interval")
+	ErrTooManyRequests         = sdkerrors.Register(ModuleName, 20, "too many random requests in the block")
+	ErrInvalidOutput           = sdkerrors.Register(ModuleName, 21, "invalid output")
+	ErrInvalidValueIndex       = sdkerrors.Register(ModuleName, 22, "invalid random value index")
+	ErrUnknownCommitment       = sdkerrors.Register(ModuleName, 23, "unknown pruned randoms commitment")
+	ErrInsufficientDeposit     = sdkerrors.Register(ModuleName, 24, "insufficient beacon deposit")
 )
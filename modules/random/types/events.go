@@ -3,13 +3,33 @@ package types
 
 // random module event types
 const (
-	EventTypeRequestRandom  = "request_random"
-	EventTypeGenerateRandom = "generate_random"
+	EventTypeRequestRandom   = "request_random"
+	EventTypeGenerateRandom  = "generate_random"
+	EventTypeRequestVRFProof = "request_vrf_proof"
+	EventTypeOpenBeacon      = "open_beacon"
+	EventTypeCommitBeacon    = "commit_beacon"
+	EventTypeRevealBeacon    = "reveal_beacon"
+	EventTypeSlashBeacon     = "slash_beacon_participant"
+	EventTypePruneRandoms    = "prune_randoms"
+	EventTypeRandomFailed    = "random_failed"
+	EventTypeUpdateVRFKey    = "update_vrf_public_key"
 
 	AttributeKeyRequestID        = "request_id"
 	AttributeKeyGenHeight        = "generate_height"
 	AttributeKeyRandom           = "random"
 	AttributeKeyRequestContextID = "request_context_id"
+	AttributeKeyVRFInput         = "vrf_input"
+	AttributeKeyCommitEndHeight  = "commit_end_height"
+	AttributeKeyRevealEndHeight  = "reveal_end_height"
+	AttributeKeyParticipant      = "participant"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyHeight           = "height"
+	AttributeKeyCount            = "count"
+	AttributeKeyRoot             = "root"
+	AttributeKeyReason           = "reason"
+	AttributeKeyRefund           = "refund"
+	AttributeKeyExpirationHeight = "expiration_height"
+	AttributeKeyVRFPublicKey     = "vrf_public_key"
 
 	AttributeValueCategory = ModuleName
 )
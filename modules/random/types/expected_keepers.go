@@ -18,6 +18,14 @@ type AccountKeeper interface {
 // bankKeeper defines the expected bank keeper for module accounts (noalias)
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+}
+
+// DistrKeeper defines the expected distribution keeper (noalias)
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 //expected Service keeper
@@ -79,3 +87,31 @@ type ServiceKeeper interface {
 
 	GetParams(ctx sdk.Context) servicetypes.Params
 }
+
+// ServiceSimKeeper defines the expected service keeper used for simulations to find the
+// service definition, bindings and requests of the oracle random requests (noalias)
+type ServiceSimKeeper interface {
+	ServiceKeeper
+
+	GetServiceDefinition(
+		ctx sdk.Context,
+		serviceName string,
+	) (servicetypes.ServiceDefinition, bool)
+
+	GetServiceBinding(
+		ctx sdk.Context,
+		serviceName string,
+		provider sdk.AccAddress,
+	) (servicetypes.ServiceBinding, bool)
+
+	GetMinDeposit(ctx sdk.Context, pricing servicetypes.Pricing) (sdk.Coins, error)
+
+	ActiveRequestsIterator(ctx sdk.Context, serviceName string, provider sdk.AccAddress) sdk.Iterator
+
+	GetRequest(ctx sdk.Context, requestID tmbytes.HexBytes) (servicetypes.Request, bool)
+}
+
+// RandomHooks event hooks for the random numbers requested by other modules (noalias)
+type RandomHooks interface {
+	AfterRandomGenerated(ctx sdk.Context, reqID []byte, random Random) // Must be called when the requested random number is generated or the request fails
+}
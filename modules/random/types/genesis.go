@@ -1,13 +1,17 @@
 package types
 
 import (
+	"fmt"
 	"strconv"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 // NewGenesisState constructs a GenesisState
-func NewGenesisState(pendingRequests map[string]Requests) *GenesisState {
+func NewGenesisState(pendingRequests map[string]Requests, params Params) *GenesisState {
 	return &GenesisState{
 		PendingRandomRequests: pendingRequests,
+		Params:                params,
 	}
 }
 
@@ -15,15 +19,82 @@ func NewGenesisState(pendingRequests map[string]Requests) *GenesisState {
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		PendingRandomRequests: map[string]Requests{},
+		Params:                DefaultParams(),
 	}
 }
 
 // ValidateGenesis validates the given random genesis state
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	for height := range data.PendingRandomRequests {
 		if _, err := strconv.ParseUint(height, 10, 64); err != nil {
 			return err
 		}
 	}
+
+	if len(data.VRFPublicKey) > 0 {
+		if _, err := ParseVRFPublicKey(data.VRFPublicKey); err != nil {
+			return fmt.Errorf("invalid VRF public key: %s", err)
+		}
+	}
+
+	for _, request := range data.PendingVRFRequests {
+		if !request.VRF || len(request.VRFInput) == 0 {
+			return fmt.Errorf("invalid pending VRF request of consumer %s at height %d", request.Consumer, request.Height)
+		}
+		if len(data.VRFPublicKey) == 0 {
+			return fmt.Errorf("the VRF public key must be specified for the pending VRF requests")
+		}
+	}
+
+	participants := make(map[string]bool)
+	for _, participant := range data.BeaconParticipants {
+		if len(participant.Address) == 0 {
+			return fmt.Errorf("the address of the beacon participant must be specified")
+		}
+		if !participant.Deposit.IsValid() {
+			return fmt.Errorf("invalid deposit of the beacon participant %s: %s", participant.Address, participant.Deposit)
+		}
+		participants[participant.Address.String()] = true
+	}
+
+	for _, commit := range data.BeaconCommits {
+		if !participants[commit.Participant.String()] {
+			return fmt.Errorf("the beacon participant %s of the commitment is not registered", commit.Participant)
+		}
+		if len(commit.ReqId) == 0 || len(commit.Commitment) != SeedBytesLength {
+			return fmt.Errorf("invalid beacon commitment of the participant %s", commit.Participant)
+		}
+	}
+
+	for _, random := range data.Randoms {
+		if len(random.ReqId) == 0 {
+			return fmt.Errorf("the request id of the random number must be specified")
+		}
+		if uint32(len(random.Values)) > random.Random.Output.ValueCount() {
+			return fmt.Errorf("too many random values of the random number %s", random.ReqId)
+		}
+	}
+
+	for _, expiry := range data.RandomExpiryQueue {
+		if expiry.Height <= 0 || len(expiry.ReqId) == 0 {
+			return fmt.Errorf("invalid expiry of the random number %s at height %d", expiry.ReqId, expiry.Height)
+		}
+	}
+
+	for _, commitment := range data.PrunedRandomsCommitments {
+		if commitment.Height <= 0 || len(commitment.Root) != tmhash.Size || commitment.Count == 0 {
+			return fmt.Errorf("invalid commitment of the random numbers pruned at height %d", commitment.Height)
+		}
+	}
+
+	for _, consumerRequest := range data.ConsumerRequests {
+		if len(consumerRequest.ReqId) == 0 || len(consumerRequest.Request.Consumer) == 0 {
+			return fmt.Errorf("invalid request %s in the index of the consumer requests", consumerRequest.ReqId)
+		}
+	}
 	return nil
 }
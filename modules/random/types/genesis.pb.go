@@ -5,8 +5,10 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_tendermint_tendermint_libs_bytes "github.com/tendermint/tendermint/libs/bytes"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -25,7 +27,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the random module's genesis state.
 type GenesisState struct {
-	PendingRandomRequests map[string]Requests `protobuf:"bytes,1,rep,name=pending_random_requests,json=pendingRandomRequests,proto3" json:"pending_random_requests" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PendingRandomRequests    map[string]Requests                                  `protobuf:"bytes,1,rep,name=pending_random_requests,json=pendingRandomRequests,proto3" json:"pending_random_requests" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PendingVRFRequests       []Request                                            `protobuf:"bytes,2,rep,name=pending_vrf_requests,json=pendingVrfRequests,proto3" json:"pending_vrf_requests"`
+	VRFPublicKey             github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=vrf_public_key,json=vrfPublicKey,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"vrf_public_key,omitempty"`
+	BeaconParticipants       []BeaconParticipant                                  `protobuf:"bytes,4,rep,name=beacon_participants,json=beaconParticipants,proto3" json:"beacon_participants"`
+	BeaconCommits            []BeaconCommit                                       `protobuf:"bytes,5,rep,name=beacon_commits,json=beaconCommits,proto3" json:"beacon_commits"`
+	Params                   Params                                               `protobuf:"bytes,6,opt,name=params,proto3" json:"params"`
+	Randoms                  []GenesisRandom                                      `protobuf:"bytes,7,rep,name=randoms,proto3" json:"randoms"`
+	RandomExpiryQueue        []RandomExpiry                                       `protobuf:"bytes,8,rep,name=random_expiry_queue,json=randomExpiryQueue,proto3" json:"random_expiry_queue"`
+	PrunedRandomsCommitments []PrunedRandomsCommitment                            `protobuf:"bytes,9,rep,name=pruned_randoms_commitments,json=prunedRandomsCommitments,proto3" json:"pruned_randoms_commitments"`
+	ConsumerRequests         []ConsumerRequest                                    `protobuf:"bytes,10,rep,name=consumer_requests,json=consumerRequests,proto3" json:"consumer_requests"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +79,69 @@ func (m *GenesisState) GetPendingRandomRequests() map[string]Requests {
 	return nil
 }
 
+func (m *GenesisState) GetPendingVRFRequests() []Request {
+	if m != nil {
+		return m.PendingVRFRequests
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVRFPublicKey() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.VRFPublicKey
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBeaconParticipants() []BeaconParticipant {
+	if m != nil {
+		return m.BeaconParticipants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBeaconCommits() []BeaconCommit {
+	if m != nil {
+		return m.BeaconCommits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRandoms() []GenesisRandom {
+	if m != nil {
+		return m.Randoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRandomExpiryQueue() []RandomExpiry {
+	if m != nil {
+		return m.RandomExpiryQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrunedRandomsCommitments() []PrunedRandomsCommitment {
+	if m != nil {
+		return m.PrunedRandomsCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerRequests() []ConsumerRequest {
+	if m != nil {
+		return m.ConsumerRequests
+	}
+	return nil
+}
+
 type Requests struct {
 	Requests []Request `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
 }
@@ -112,35 +186,186 @@ func (m *Requests) GetRequests() []Request {
 	return nil
 }
 
+// GenesisRandom defines a stored random number along with the derived random values
+type GenesisRandom struct {
+	ReqId  github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"req_id,omitempty" yaml:"req_id"`
+	Random Random                                               `protobuf:"bytes,2,opt,name=random,proto3" json:"random"`
+	Values []string                                             `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *GenesisRandom) Reset()         { *m = GenesisRandom{} }
+func (m *GenesisRandom) String() string { return proto.CompactTextString(m) }
+func (*GenesisRandom) ProtoMessage()    {}
+func (*GenesisRandom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55381a259c753e1a, []int{2}
+}
+func (m *GenesisRandom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisRandom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisRandom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisRandom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisRandom.Merge(m, src)
+}
+func (m *GenesisRandom) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisRandom) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisRandom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisRandom proto.InternalMessageInfo
+
+func (m *GenesisRandom) GetReqId() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.ReqId
+	}
+	return nil
+}
+
+func (m *GenesisRandom) GetRandom() Random {
+	if m != nil {
+		return m.Random
+	}
+	return Random{}
+}
+
+func (m *GenesisRandom) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// RandomExpiry defines a random number scheduled to be pruned, the height is relative to the export height
+type RandomExpiry struct {
+	Height   int64                                                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	ReqId    github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=req_id,json=reqId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"req_id,omitempty" yaml:"req_id"`
+	Consumer github_com_cosmos_cosmos_sdk_types.AccAddress        `protobuf:"bytes,3,opt,name=consumer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"consumer,omitempty"`
+}
+
+func (m *RandomExpiry) Reset()         { *m = RandomExpiry{} }
+func (m *RandomExpiry) String() string { return proto.CompactTextString(m) }
+func (*RandomExpiry) ProtoMessage()    {}
+func (*RandomExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55381a259c753e1a, []int{3}
+}
+func (m *RandomExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RandomExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RandomExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RandomExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandomExpiry.Merge(m, src)
+}
+func (m *RandomExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RandomExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandomExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandomExpiry proto.InternalMessageInfo
+
+func (m *RandomExpiry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RandomExpiry) GetReqId() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.ReqId
+	}
+	return nil
+}
+
+func (m *RandomExpiry) GetConsumer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Consumer
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "irishub.random.GenesisState")
 	proto.RegisterMapType((map[string]Requests)(nil), "irishub.random.GenesisState.PendingRandomRequestsEntry")
 	proto.RegisterType((*Requests)(nil), "irishub.random.Requests")
+	proto.RegisterType((*GenesisRandom)(nil), "irishub.random.GenesisRandom")
+	proto.RegisterType((*RandomExpiry)(nil), "irishub.random.RandomExpiry")
 }
 
 func init() { proto.RegisterFile("random/genesis.proto", fileDescriptor_55381a259c753e1a) }
 
 var fileDescriptor_55381a259c753e1a = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x29, 0x4a, 0xcc, 0x4b,
-	0xc9, 0xcf, 0xd5, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0xe2, 0xcb, 0x2c, 0xca, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x83, 0xc8, 0x4a, 0x09, 0x43, 0x55,
-	0x41, 0x28, 0x88, 0x22, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x88,
-	0x2a, 0xbd, 0x64, 0xe4, 0xe2, 0x71, 0x87, 0x18, 0x16, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x54, 0xca,
-	0x25, 0x5e, 0x90, 0x9a, 0x97, 0x92, 0x99, 0x97, 0x1e, 0x0f, 0xd1, 0x1e, 0x5f, 0x94, 0x5a, 0x58,
-	0x9a, 0x5a, 0x5c, 0x52, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x64, 0xae, 0x87, 0x6a, 0x9b,
-	0x1e, 0xb2, 0x76, 0xbd, 0x00, 0x88, 0xde, 0x20, 0xb0, 0x54, 0x10, 0x54, 0xa7, 0x6b, 0x5e, 0x49,
-	0x51, 0xa5, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0xa2, 0x05, 0xd8, 0x54, 0x48, 0x25, 0x71,
-	0x49, 0xe1, 0xd6, 0x2a, 0x24, 0xc0, 0xc5, 0x9c, 0x9d, 0x5a, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x19, 0x04, 0x62, 0x0a, 0xe9, 0x71, 0xb1, 0x96, 0x25, 0xe6, 0x94, 0xa6, 0x4a, 0x30, 0x29, 0x30,
-	0x6a, 0x70, 0x1b, 0x49, 0xa0, 0x3b, 0x0a, 0xa6, 0x3f, 0x08, 0xa2, 0xcc, 0x8a, 0xc9, 0x82, 0x51,
-	0xc9, 0x95, 0x8b, 0x03, 0x26, 0x2c, 0x64, 0xc9, 0xc5, 0x81, 0xe6, 0x2f, 0x71, 0x1c, 0x46, 0x40,
-	0xdd, 0x0d, 0x57, 0xee, 0xe4, 0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
-	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
-	0xfa, 0xe9, 0x99, 0x25, 0x20, 0x03, 0x92, 0xf3, 0x73, 0xf5, 0x41, 0x86, 0xe5, 0xa5, 0x96, 0xe8,
-	0x43, 0x0d, 0xd5, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x86, 0xc6, 0x89, 0x7e, 0x49, 0x65,
-	0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x12, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb3, 0xb2,
-	0x39, 0x1c, 0xd7, 0x01, 0x00, 0x00,
+	// 730 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0xb6, 0x50, 0xca, 0x50, 0x08, 0x0c, 0x08, 0x9b, 0x46, 0xdb, 0xda, 0x8b, 0xbd, 0xb0,
+	0x1b, 0x91, 0x44, 0x25, 0xf1, 0x40, 0x09, 0x48, 0x63, 0x4c, 0xea, 0x9a, 0x10, 0xe3, 0xa5, 0xee,
+	0x8f, 0x47, 0x99, 0xd0, 0xfd, 0xd1, 0x99, 0x59, 0x42, 0xff, 0x0b, 0xff, 0x23, 0xaf, 0x1c, 0xb9,
+	0xe9, 0xa9, 0x31, 0xe5, 0x0f, 0x30, 0xf1, 0xc8, 0xc9, 0xec, 0xcc, 0xb4, 0x6c, 0x6b, 0x7b, 0x31,
+	0xf1, 0xb4, 0xb3, 0xf3, 0xbe, 0xf7, 0x7d, 0xf3, 0xde, 0x7c, 0x6f, 0x17, 0x6d, 0x51, 0x3b, 0xf0,
+	0x42, 0xdf, 0xec, 0x40, 0x00, 0x8c, 0x30, 0x23, 0xa2, 0x21, 0x0f, 0xf1, 0x1a, 0xa1, 0x84, 0x5d,
+	0xc4, 0x8e, 0x21, 0xa3, 0xa5, 0x4d, 0x85, 0x92, 0x0f, 0x09, 0x2a, 0x6d, 0x75, 0xc2, 0x4e, 0x28,
+	0x96, 0x66, 0xb2, 0x92, 0xbb, 0xb5, 0x5f, 0x4b, 0xa8, 0xf8, 0x56, 0x92, 0x7d, 0xe4, 0x36, 0x07,
+	0x1c, 0xa3, 0x9d, 0x08, 0x02, 0x8f, 0x04, 0x9d, 0xb6, 0x4c, 0x6f, 0x53, 0xe8, 0xc5, 0xc0, 0x38,
+	0xd3, 0xb5, 0x6a, 0xae, 0xbe, 0xb2, 0xf7, 0xd2, 0x98, 0x54, 0x33, 0xd2, 0xe9, 0x46, 0x4b, 0xe6,
+	0x5a, 0x22, 0x64, 0xa9, 0xcc, 0xe3, 0x80, 0xd3, 0x7e, 0x63, 0xe1, 0x66, 0x50, 0xc9, 0x58, 0x8f,
+	0xa2, 0x59, 0x08, 0x0c, 0x68, 0x6b, 0x24, 0x7b, 0x45, 0xcf, 0x1f, 0x34, 0xb3, 0x42, 0x73, 0x67,
+	0x5a, 0x53, 0xe5, 0x35, 0x4a, 0x09, 0xe7, 0x70, 0x50, 0xc1, 0x4a, 0xf7, 0xcc, 0x3a, 0x19, 0x51,
+	0x5a, 0x58, 0x11, 0x9e, 0xd1, 0xf3, 0xb1, 0x4c, 0x80, 0xd6, 0x12, 0xfa, 0x28, 0x76, 0xba, 0xc4,
+	0x6d, 0x5f, 0x42, 0x5f, 0xcf, 0x55, 0xb5, 0x7a, 0xb1, 0x71, 0x3a, 0x1c, 0x54, 0x8a, 0x67, 0xd6,
+	0x49, 0x4b, 0x04, 0xde, 0x41, 0xff, 0x7e, 0x50, 0xd9, 0xef, 0x10, 0x9e, 0x48, 0xba, 0xa1, 0x6f,
+	0x72, 0x08, 0x3c, 0xa0, 0x3e, 0x09, 0x78, 0x7a, 0xd9, 0x25, 0x0e, 0x33, 0x9d, 0x3e, 0x07, 0x66,
+	0x9c, 0xc2, 0x75, 0x23, 0x59, 0x58, 0xc5, 0x2b, 0x7a, 0x3e, 0x66, 0xc1, 0x9f, 0xd0, 0xa6, 0x03,
+	0xb6, 0x1b, 0x06, 0xed, 0xc8, 0xa6, 0x9c, 0xb8, 0x24, 0xb2, 0x03, 0xce, 0xf4, 0x05, 0x51, 0xd5,
+	0xd3, 0xe9, 0xaa, 0x1a, 0x02, 0xda, 0x7a, 0x40, 0xaa, 0x9e, 0x61, 0x67, 0x3a, 0xc0, 0x70, 0x13,
+	0xad, 0x29, 0x66, 0x37, 0xf4, 0x7d, 0xc2, 0x99, 0xbe, 0x28, 0x48, 0x1f, 0xcf, 0x26, 0x3d, 0x12,
+	0x20, 0xc5, 0xb7, 0xea, 0xa4, 0xf6, 0x18, 0xde, 0x47, 0xf9, 0xc8, 0xa6, 0xb6, 0xcf, 0xf4, 0x7c,
+	0x55, 0xab, 0xaf, 0xec, 0x6d, 0x4f, 0x53, 0xb4, 0x44, 0x54, 0x25, 0x2b, 0x2c, 0x7e, 0x83, 0x96,
+	0x64, 0x98, 0xe9, 0x4b, 0x42, 0xf9, 0xc9, 0x1c, 0x63, 0xc8, 0x9b, 0x56, 0xd9, 0xa3, 0x1c, 0x6c,
+	0x21, 0xe5, 0xd2, 0x36, 0x5c, 0x47, 0x84, 0xf6, 0xdb, 0xbd, 0x18, 0x62, 0xd0, 0x0b, 0xb3, 0x8b,
+	0x90, 0x1c, 0xc7, 0x02, 0xa9, 0x98, 0x36, 0x68, 0x6a, 0xef, 0x43, 0x92, 0x8c, 0x2f, 0x51, 0x29,
+	0xa2, 0x71, 0x00, 0x9e, 0xb2, 0x2e, 0x53, 0xbd, 0xf1, 0x21, 0x69, 0xfa, 0xb2, 0xa0, 0x7e, 0xf6,
+	0x57, 0x71, 0x22, 0x43, 0x0a, 0xb0, 0xa3, 0x31, 0x5e, 0xa9, 0xe8, 0xd1, 0xec, 0x70, 0x52, 0xc0,
+	0x86, 0x1b, 0x06, 0x2c, 0xf6, 0x81, 0x3e, 0xd8, 0x15, 0x09, 0x8d, 0xca, 0xb4, 0xc6, 0x91, 0x02,
+	0x8e, 0x6c, 0x2b, 0xb9, 0xd7, 0xdd, 0xc9, 0x6d, 0x56, 0x72, 0x50, 0x69, 0xfe, 0x00, 0xe1, 0x75,
+	0x94, 0x4b, 0x1c, 0xab, 0x55, 0xb5, 0xfa, 0xb2, 0x95, 0x2c, 0xb1, 0x81, 0x16, 0xaf, 0xec, 0x6e,
+	0x0c, 0x7a, 0x56, 0x5c, 0x9c, 0x3e, 0x67, 0x4c, 0x98, 0x25, 0x61, 0x07, 0xd9, 0x57, 0x5a, 0xed,
+	0x18, 0x15, 0xc6, 0xe3, 0xf0, 0x1a, 0x15, 0xa6, 0xa6, 0x7b, 0xee, 0xa4, 0xc9, 0x23, 0x8f, 0xe1,
+	0xb5, 0x6f, 0x1a, 0x5a, 0x9d, 0xb8, 0x60, 0xfc, 0x05, 0xe5, 0x29, 0xf4, 0xda, 0xc4, 0x13, 0x27,
+	0x2c, 0x36, 0x9a, 0xbf, 0x07, 0x95, 0xd5, 0xbe, 0xed, 0x77, 0x0f, 0x6a, 0x72, 0xbf, 0xf6, 0xcf,
+	0x43, 0xb5, 0x48, 0xa1, 0xd7, 0xf4, 0x12, 0xa3, 0xca, 0x53, 0xe9, 0xd9, 0xd9, 0x46, 0x9d, 0xb0,
+	0x9a, 0xc2, 0xe2, 0x6d, 0x94, 0x17, 0xd5, 0x33, 0x3d, 0x57, 0xcd, 0xd5, 0x97, 0x2d, 0xf5, 0x56,
+	0xfb, 0xae, 0xa1, 0x62, 0xda, 0x57, 0x09, 0xf0, 0x02, 0x48, 0xe7, 0x82, 0x8b, 0x02, 0x72, 0x96,
+	0x7a, 0x4b, 0x15, 0x96, 0xfd, 0x4f, 0x85, 0xbd, 0x47, 0x85, 0x91, 0x17, 0xd4, 0x07, 0xe9, 0xf9,
+	0xfd, 0xa0, 0xb2, 0x9b, 0xa2, 0x74, 0x43, 0xe6, 0x87, 0x4c, 0x3d, 0x76, 0x99, 0x77, 0x69, 0xf2,
+	0x7e, 0x04, 0xcc, 0x38, 0x74, 0xdd, 0x43, 0xcf, 0xa3, 0xc0, 0x98, 0x35, 0xa6, 0x68, 0x34, 0x6f,
+	0x86, 0x65, 0xed, 0x76, 0x58, 0xd6, 0x7e, 0x0e, 0xcb, 0xda, 0xd7, 0xbb, 0x72, 0xe6, 0xf6, 0xae,
+	0x9c, 0xf9, 0x71, 0x57, 0xce, 0x7c, 0x36, 0x53, 0x94, 0x49, 0xef, 0x02, 0xe0, 0xa6, 0xea, 0xa1,
+	0xe9, 0x87, 0x5e, 0xdc, 0x05, 0xa6, 0xfe, 0x1a, 0x92, 0xdf, 0xc9, 0x8b, 0xdf, 0xc4, 0x8b, 0x3f,
+	0x03, 0x00, 0x86, 0x33, 0x30, 0xb4, 0x79, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -163,6 +388,121 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsumerRequests) > 0 {
+		for iNdEx := len(m.ConsumerRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PrunedRandomsCommitments) > 0 {
+		for iNdEx := len(m.PrunedRandomsCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrunedRandomsCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RandomExpiryQueue) > 0 {
+		for iNdEx := len(m.RandomExpiryQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RandomExpiryQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Randoms) > 0 {
+		for iNdEx := len(m.Randoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Randoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BeaconCommits) > 0 {
+		for iNdEx := len(m.BeaconCommits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeaconCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BeaconParticipants) > 0 {
+		for iNdEx := len(m.BeaconParticipants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeaconParticipants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.VRFPublicKey) > 0 {
+		i -= len(m.VRFPublicKey)
+		copy(dAtA[i:], m.VRFPublicKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VRFPublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PendingVRFRequests) > 0 {
+		for iNdEx := len(m.PendingVRFRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingVRFRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.PendingRandomRequests) > 0 {
 		for k := range m.PendingRandomRequests {
 			v := m.PendingRandomRequests[k]
@@ -227,6 +567,97 @@ func (m *Requests) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisRandom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisRandom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisRandom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Random.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RandomExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RandomExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RandomExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -253,24 +684,113 @@ func (m *GenesisState) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-
-func (m *Requests) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PendingVRFRequests) > 0 {
+		for _, e := range m.PendingVRFRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
+	l = len(m.VRFPublicKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BeaconParticipants) > 0 {
+		for _, e := range m.BeaconParticipants {
 			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
+	if len(m.BeaconCommits) > 0 {
+		for _, e := range m.BeaconCommits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Randoms) > 0 {
+		for _, e := range m.Randoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RandomExpiryQueue) > 0 {
+		for _, e := range m.RandomExpiryQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PrunedRandomsCommitments) > 0 {
+		for _, e := range m.PrunedRandomsCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerRequests) > 0 {
+		for _, e := range m.ConsumerRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Requests) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisRandom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Random.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RandomExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -435,62 +955,111 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.PendingRandomRequests[mapkey] = *mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingVRFRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingVRFRequests = append(m.PendingVRFRequests, Request{})
+			if err := m.PendingVRFRequests[len(m.PendingVRFRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Requests) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.VRFPublicKey = append(m.VRFPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VRFPublicKey == nil {
+				m.VRFPublicKey = []byte{}
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconParticipants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BeaconParticipants = append(m.BeaconParticipants, BeaconParticipant{})
+			if err := m.BeaconParticipants[len(m.BeaconParticipants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Requests: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Requests: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconCommits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -517,11 +1086,559 @@ func (m *Requests) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, Request{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BeaconCommits = append(m.BeaconCommits, BeaconCommit{})
+			if err := m.BeaconCommits[len(m.BeaconCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Randoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Randoms = append(m.Randoms, GenesisRandom{})
+			if err := m.Randoms[len(m.Randoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomExpiryQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandomExpiryQueue = append(m.RandomExpiryQueue, RandomExpiry{})
+			if err := m.RandomExpiryQueue[len(m.RandomExpiryQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedRandomsCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedRandomsCommitments = append(m.PrunedRandomsCommitments, PrunedRandomsCommitment{})
+			if err := m.PrunedRandomsCommitments[len(m.PrunedRandomsCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRequests = append(m.ConsumerRequests, ConsumerRequest{})
+			if err := m.ConsumerRequests[len(m.ConsumerRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Requests) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Requests: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Requests: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, Request{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisRandom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisRandom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisRandom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = append(m.ReqId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReqId == nil {
+				m.ReqId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Random", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Random.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RandomExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RandomExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RandomExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = append(m.ReqId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReqId == nil {
+				m.ReqId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = append(m.Consumer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Consumer == nil {
+				m.Consumer = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -21,8 +23,18 @@ const (
 var (
 	KeyDelimiter              = []byte(":")                   // key delimiter
 	PrefixRandom              = []byte("randoms:")            // key prefix for the random number
+	PrefixRandomValue         = []byte("randomValues:")       // key prefix for the random values derived from the random number
+	PrefixRandomExpiryQueue   = []byte("randomExpiryQueue:")  // key prefix for the queue of the random numbers to be pruned
+	PrefixPrunedCommitment    = []byte("prunedCommitments:")  // key prefix for the Merkle commitment of the pruned random numbers
 	PrefixRandomRequestQueue  = []byte("randRequestQueue:")   // key prefix for the random number request queue
 	PrefixOracleRandomRequest = []byte("oracleRandRequests:") // key prefix for the oracle request
+	PrefixConsumerRequest     = []byte("consumerRequests:")   // key prefix for the index of the random requests by consumer
+	PrefixVRFRandomRequest    = []byte("vrfRandRequests:")    // key prefix for the VRF request waiting for the proof
+	KeyVRFPublicKey           = []byte("vrfPublicKey")        // key for the designated VRF public key
+	PrefixBeaconParticipant   = []byte("beaconParticipants:") // key prefix for the beacon participant
+	PrefixBeaconRequest       = []byte("beaconRequests:")     // key prefix for the beacon request of an open round
+	PrefixBeaconCommit        = []byte("beaconCommits:")      // key prefix for the beacon commitment
+	KeyRequestCount           = []byte("requestCount")        // key for the number of random requests in the current block
 )
 
 // KeyRandom returns the key for a random number by the specified request id
@@ -30,6 +42,28 @@ func KeyRandom(reqID []byte) []byte {
 	return append(PrefixRandom, reqID...)
 }
 
+// KeyRandomValue returns the key for a random value by the specified request id and index
+func KeyRandomValue(reqID []byte, index uint32) []byte {
+	key := append(append([]byte{}, PrefixRandomValue...), reqID...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// KeyRandomExpiryQueue returns the key for the random number to be pruned by the given height and request id
+func KeyRandomExpiryQueue(height int64, reqID []byte) []byte {
+	return append(KeyRandomExpiryQueueSubspace(height), reqID...)
+}
+
+// KeyRandomExpiryQueueSubspace returns the key prefix for iterating through the random numbers to be pruned
+// at the specified height, the heights are big endian encoded so that the keys are ordered by height
+func KeyRandomExpiryQueueSubspace(height int64) []byte {
+	return append(append([]byte{}, PrefixRandomExpiryQueue...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// KeyPrunedCommitment returns the key for the Merkle commitment of the random numbers pruned at the given height
+func KeyPrunedCommitment(height int64) []byte {
+	return append(append([]byte{}, PrefixPrunedCommitment...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 // KeyRandomRequestQueue returns the key for the random number request queue by the given height and request id
 func KeyRandomRequestQueue(height int64, reqID []byte) []byte {
 	return append([]byte(fmt.Sprintf("randRequestQueue:%d:", height)), reqID...)
@@ -44,3 +78,38 @@ func KeyRandomRequestQueueSubspace(height int64) []byte {
 func KeyOracleRandomRequest(requestContextID []byte) []byte {
 	return append(PrefixOracleRandomRequest, requestContextID...)
 }
+
+// KeyConsumerRequest returns the key for a random request in the index by the specified consumer and request id
+func KeyConsumerRequest(consumer sdk.AccAddress, reqID []byte) []byte {
+	return append(KeyConsumerRequestSubspace(consumer), reqID...)
+}
+
+// KeyConsumerRequestSubspace returns the key prefix for iterating through all random requests of the consumer
+func KeyConsumerRequestSubspace(consumer sdk.AccAddress) []byte {
+	return append(append([]byte{}, PrefixConsumerRequest...), consumer.Bytes()...)
+}
+
+// KeyVRFRandomRequest returns the key for a VRF request waiting for the proof by the specified request id
+func KeyVRFRandomRequest(reqID []byte) []byte {
+	return append(PrefixVRFRandomRequest, reqID...)
+}
+
+// KeyBeaconParticipant returns the key for a beacon participant by the specified address
+func KeyBeaconParticipant(address sdk.AccAddress) []byte {
+	return append(PrefixBeaconParticipant, address.Bytes()...)
+}
+
+// KeyBeaconRequest returns the key for the beacon request of an open round by the specified request id
+func KeyBeaconRequest(reqID []byte) []byte {
+	return append(PrefixBeaconRequest, reqID...)
+}
+
+// KeyBeaconCommit returns the key for the commitment of a participant by the specified request id and address
+func KeyBeaconCommit(reqID []byte, participant sdk.AccAddress) []byte {
+	return append(KeyBeaconCommitSubspace(reqID), participant.Bytes()...)
+}
+
+// KeyBeaconCommitSubspace returns the key prefix for iterating through all commitments of a beacon round
+func KeyBeaconCommitSubspace(reqID []byte) []byte {
+	return append(append([]byte{}, PrefixBeaconCommit...), reqID...)
+}
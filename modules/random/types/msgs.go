@@ -6,25 +6,44 @@ import (
 )
 
 const (
-	TypeMsgRequestRandom = "request_rand" // type for MsgRequestRandom
+	TypeMsgRequestRandom  = "request_rand"     // type for MsgRequestRandom
+	TypeMsgSubmitVRFProof = "submit_vrf_proof" // type for MsgSubmitVRFProof
+
+	TypeMsgRegisterBeaconParticipant   = "register_beacon_participant"   // type for MsgRegisterBeaconParticipant
+	TypeMsgUnregisterBeaconParticipant = "unregister_beacon_participant" // type for MsgUnregisterBeaconParticipant
+	TypeMsgCommitBeacon                = "commit_beacon"                 // type for MsgCommitBeacon
+	TypeMsgRevealBeacon                = "reveal_beacon"                 // type for MsgRevealBeacon
 
 	DefaultBlockInterval = uint64(10) // DefaultBlockInterval is the default block interval
 )
 
-var _ sdk.Msg = &MsgRequestRandom{}
+var (
+	_ sdk.Msg = &MsgRequestRandom{}
+	_ sdk.Msg = &MsgSubmitVRFProof{}
+	_ sdk.Msg = &MsgRegisterBeaconParticipant{}
+	_ sdk.Msg = &MsgUnregisterBeaconParticipant{}
+	_ sdk.Msg = &MsgCommitBeacon{}
+	_ sdk.Msg = &MsgRevealBeacon{}
+)
 
 // NewMsgRequestRandom constructs a MsgRequestRandom
 func NewMsgRequestRandom(
 	consumer sdk.AccAddress,
 	blockInterval uint64,
 	oracle bool,
+	vrf bool,
+	beacon bool,
 	serviceFeeCap sdk.Coins,
+	output OutputSpec,
 ) *MsgRequestRandom {
 	return &MsgRequestRandom{
 		Consumer:      consumer,
 		BlockInterval: blockInterval,
 		Oracle:        oracle,
+		VRF:           vrf,
+		Beacon:        beacon,
 		ServiceFeeCap: serviceFeeCap,
+		Output:        output,
 	}
 }
 
@@ -39,7 +58,10 @@ func (msg MsgRequestRandom) ValidateBasic() error {
 	if len(msg.Consumer) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "the consumer address must be specified")
 	}
-	return nil
+	if (msg.Oracle && msg.VRF) || (msg.Oracle && msg.Beacon) || (msg.VRF && msg.Beacon) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only one of the oracle, VRF and beacon methods can be used")
+	}
+	return msg.Output.Validate()
 }
 
 // GetSignBytes implements Msg.
@@ -55,3 +77,206 @@ func (msg MsgRequestRandom) GetSignBytes() []byte {
 func (msg MsgRequestRandom) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Consumer}
 }
+
+// NewMsgSubmitVRFProof constructs a MsgSubmitVRFProof
+func NewMsgSubmitVRFProof(reqID string, proof []byte, sender sdk.AccAddress) *MsgSubmitVRFProof {
+	return &MsgSubmitVRFProof{
+		ReqId:  reqID,
+		Proof:  proof,
+		Sender: sender,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgSubmitVRFProof) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSubmitVRFProof) Type() string { return TypeMsgSubmitVRFProof }
+
+// ValidateBasic implements Msg.
+func (msg MsgSubmitVRFProof) ValidateBasic() error {
+	if len(msg.Sender) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "the sender address must be specified")
+	}
+	if err := CheckReqID(msg.ReqId); err != nil {
+		return err
+	}
+	if len(msg.Proof) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVRFProof, "the proof must be specified")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgSubmitVRFProof) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg.
+func (msg MsgSubmitVRFProof) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// NewMsgRegisterBeaconParticipant constructs a MsgRegisterBeaconParticipant
+func NewMsgRegisterBeaconParticipant(participant sdk.AccAddress, deposit sdk.Coins) *MsgRegisterBeaconParticipant {
+	return &MsgRegisterBeaconParticipant{
+		Participant: participant,
+		Deposit:     deposit,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRegisterBeaconParticipant) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRegisterBeaconParticipant) Type() string { return TypeMsgRegisterBeaconParticipant }
+
+// ValidateBasic implements Msg.
+func (msg MsgRegisterBeaconParticipant) ValidateBasic() error {
+	if len(msg.Participant) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "the participant address must be specified")
+	}
+	if !msg.Deposit.IsValid() || msg.Deposit.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid deposit: %s", msg.Deposit)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgRegisterBeaconParticipant) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg.
+func (msg MsgRegisterBeaconParticipant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Participant}
+}
+
+// NewMsgUnregisterBeaconParticipant constructs a MsgUnregisterBeaconParticipant
+func NewMsgUnregisterBeaconParticipant(participant sdk.AccAddress) *MsgUnregisterBeaconParticipant {
+	return &MsgUnregisterBeaconParticipant{
+		Participant: participant,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgUnregisterBeaconParticipant) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgUnregisterBeaconParticipant) Type() string { return TypeMsgUnregisterBeaconParticipant }
+
+// ValidateBasic implements Msg.
+func (msg MsgUnregisterBeaconParticipant) ValidateBasic() error {
+	if len(msg.Participant) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "the participant address must be specified")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgUnregisterBeaconParticipant) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg.
+func (msg MsgUnregisterBeaconParticipant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Participant}
+}
+
+// NewMsgCommitBeacon constructs a MsgCommitBeacon
+func NewMsgCommitBeacon(reqID string, commitment []byte, participant sdk.AccAddress) *MsgCommitBeacon {
+	return &MsgCommitBeacon{
+		ReqId:       reqID,
+		Commitment:  commitment,
+		Participant: participant,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgCommitBeacon) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgCommitBeacon) Type() string { return TypeMsgCommitBeacon }
+
+// ValidateBasic implements Msg.
+func (msg MsgCommitBeacon) ValidateBasic() error {
+	if len(msg.Participant) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "the participant address must be specified")
+	}
+	if err := CheckReqID(msg.ReqId); err != nil {
+		return err
+	}
+	if len(msg.Commitment) != SeedBytesLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the commitment must be %d bytes", SeedBytesLength)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgCommitBeacon) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg.
+func (msg MsgCommitBeacon) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Participant}
+}
+
+// NewMsgRevealBeacon constructs a MsgRevealBeacon
+func NewMsgRevealBeacon(reqID string, secret []byte, participant sdk.AccAddress) *MsgRevealBeacon {
+	return &MsgRevealBeacon{
+		ReqId:       reqID,
+		Secret:      secret,
+		Participant: participant,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRevealBeacon) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRevealBeacon) Type() string { return TypeMsgRevealBeacon }
+
+// ValidateBasic implements Msg.
+func (msg MsgRevealBeacon) ValidateBasic() error {
+	if len(msg.Participant) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "the participant address must be specified")
+	}
+	if err := CheckReqID(msg.ReqId); err != nil {
+		return err
+	}
+	if len(msg.Secret) == 0 {
+		return sdkerrors.Wrap(ErrInvalidBeaconReveal, "the secret must be specified")
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgRevealBeacon) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg.
+func (msg MsgRevealBeacon) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Participant}
+}
@@ -17,7 +17,7 @@ var (
 )
 
 func TestNewMsgRequestRandom(t *testing.T) {
-	msg := NewMsgRequestRandom(testAddr, blockInterval, false, serviceFeeCap)
+	msg := NewMsgRequestRandom(testAddr, blockInterval, false, false, false, serviceFeeCap, OutputSpec{})
 
 	require.Equal(t, testAddr, msg.Consumer)
 	require.Equal(t, blockInterval, msg.BlockInterval)
@@ -25,7 +25,7 @@ func TestNewMsgRequestRandom(t *testing.T) {
 
 func TestMsgRequestRandomRoute(t *testing.T) {
 	// build a MsgRequestRandom
-	msg := NewMsgRequestRandom(testAddr, blockInterval, false, serviceFeeCap)
+	msg := NewMsgRequestRandom(testAddr, blockInterval, false, false, false, serviceFeeCap, OutputSpec{})
 
 	require.Equal(t, "random", msg.Route())
 }
@@ -36,15 +36,28 @@ func TestMsgRequestRandomValidation(t *testing.T) {
 		consumer      sdk.AccAddress
 		blockInterval uint64
 		oracle        bool
+		vrf           bool
+		beacon        bool
 		serviceFeeCap sdk.Coins
+		output        OutputSpec
 		expectPass    bool
 	}{
-		{"empty consumer", emptyAddr, blockInterval, false, serviceFeeCap, false},
-		{"basic good", testAddr, blockInterval, false, serviceFeeCap, true},
+		{"empty consumer", emptyAddr, blockInterval, false, false, false, serviceFeeCap, OutputSpec{}, false},
+		{"basic good", testAddr, blockInterval, false, false, false, serviceFeeCap, OutputSpec{}, true},
+		{"vrf good", testAddr, blockInterval, false, true, false, nil, OutputSpec{}, true},
+		{"beacon good", testAddr, blockInterval, false, false, true, nil, OutputSpec{}, true},
+		{"oracle and vrf", testAddr, blockInterval, true, true, false, serviceFeeCap, OutputSpec{}, false},
+		{"vrf and beacon", testAddr, blockInterval, false, true, true, nil, OutputSpec{}, false},
+		{"integers good", testAddr, blockInterval, false, false, false, nil, NewOutputSpec(Integer, 5, 1, 50), true},
+		{"raw good", testAddr, blockInterval, false, false, false, nil, NewOutputSpec(Raw, 2, 0, 0), true},
+		{"empty range", testAddr, blockInterval, false, false, false, nil, NewOutputSpec(Integer, 5, 50, 50), false},
+		{"range of decimals", testAddr, blockInterval, false, false, false, nil, NewOutputSpec(Decimal, 5, 1, 50), false},
+		{"too many values", testAddr, blockInterval, false, false, false, nil, NewOutputSpec(Decimal, MaxOutputCount+1, 0, 0), false},
+		{"unknown output type", testAddr, blockInterval, false, false, false, nil, NewOutputSpec(OutputType(3), 1, 0, 0), false},
 	}
 
 	for _, td := range testData {
-		msg := NewMsgRequestRandom(td.consumer, td.blockInterval, td.oracle, td.serviceFeeCap)
+		msg := NewMsgRequestRandom(td.consumer, td.blockInterval, td.oracle, td.vrf, td.beacon, td.serviceFeeCap, td.output)
 		if td.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", td.name)
 		} else {
@@ -54,17 +67,94 @@ func TestMsgRequestRandomValidation(t *testing.T) {
 }
 
 func TestMsgRequestRandomGetSignBytes(t *testing.T) {
-	var msg = NewMsgRequestRandom(testAddr, blockInterval, true, serviceFeeCap)
+	var msg = NewMsgRequestRandom(testAddr, blockInterval, true, false, false, serviceFeeCap, OutputSpec{})
 	res := msg.GetSignBytes()
 
-	expected := fmt.Sprintf("{\"type\":\"irishub/random/MsgRequestRandom\",\"value\":{\"block_interval\":\"10\",\"consumer\":\"cosmos1w3jhxazpv3j8y5jww2c\",\"oracle\":true,\"service_fee_cap\":[{\"amount\":\"1000000000000000000\",\"denom\":\"%s\"}]}}", sdk.DefaultBondDenom)
+	expected := fmt.Sprintf("{\"type\":\"irishub/random/MsgRequestRandom\",\"value\":{\"block_interval\":\"10\",\"consumer\":\"cosmos1w3jhxazpv3j8y5jww2c\",\"oracle\":true,\"output\":{},\"service_fee_cap\":[{\"amount\":\"1000000000000000000\",\"denom\":\"%s\"}]}}", sdk.DefaultBondDenom)
 	require.Equal(t, expected, string(res))
 }
 
 func TestMsgRequestRandomGetSigners(t *testing.T) {
-	var msg = NewMsgRequestRandom(testAddr, blockInterval, false, serviceFeeCap)
+	var msg = NewMsgRequestRandom(testAddr, blockInterval, false, false, false, serviceFeeCap, OutputSpec{})
 	res := msg.GetSigners()
 
 	expected := "[7465737441646472]"
 	require.Equal(t, expected, fmt.Sprintf("%v", res))
 }
+
+func TestMsgSubmitVRFProofValidation(t *testing.T) {
+	reqID := "ac3e8ee4ed8a1cdc5bf6cb4b4fdb4e20c8ab7b2cfe6bd1a4d0ebf2f9aaeb8ca5"
+
+	testData := []struct {
+		name       string
+		reqID      string
+		proof      []byte
+		sender     sdk.AccAddress
+		expectPass bool
+	}{
+		{"empty sender", reqID, []byte("proof"), emptyAddr, false},
+		{"invalid request id", "reqID", []byte("proof"), testAddr, false},
+		{"empty proof", reqID, nil, testAddr, false},
+		{"basic good", reqID, []byte("proof"), testAddr, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgSubmitVRFProof(td.reqID, td.proof, td.sender)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.name)
+		}
+	}
+}
+
+func TestMsgRegisterBeaconParticipantValidation(t *testing.T) {
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+
+	testData := []struct {
+		name        string
+		participant sdk.AccAddress
+		deposit     sdk.Coins
+		expectPass  bool
+	}{
+		{"empty participant", emptyAddr, deposit, false},
+		{"empty deposit", testAddr, nil, false},
+		{"basic good", testAddr, deposit, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgRegisterBeaconParticipant(td.participant, td.deposit)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.name)
+		}
+	}
+}
+
+func TestMsgCommitBeaconValidation(t *testing.T) {
+	reqID := "ac3e8ee4ed8a1cdc5bf6cb4b4fdb4e20c8ab7b2cfe6bd1a4d0ebf2f9aaeb8ca5"
+	commitment := GenerateBeaconCommitment([]byte("secret"), testAddr)
+
+	testData := []struct {
+		name        string
+		reqID       string
+		commitment  []byte
+		participant sdk.AccAddress
+		expectPass  bool
+	}{
+		{"empty participant", reqID, commitment, emptyAddr, false},
+		{"invalid request id", "reqID", commitment, testAddr, false},
+		{"invalid commitment", reqID, []byte("commitment"), testAddr, false},
+		{"basic good", reqID, commitment, testAddr, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgCommitBeacon(td.reqID, td.commitment, td.participant)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.name)
+		}
+	}
+}
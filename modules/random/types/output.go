@@ -0,0 +1,108 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxOutputCount is the maximal number of random values of a request
+const MaxOutputCount = 100
+
+// NewOutputSpec constructs an OutputSpec
+func NewOutputSpec(outputType OutputType, count uint32, rangeMin, rangeMax uint64) OutputSpec {
+	return OutputSpec{
+		Type:     outputType,
+		Count:    count,
+		RangeMin: rangeMin,
+		RangeMax: rangeMax,
+	}
+}
+
+// OutputTypeFromString returns the output type from the case-insensitive name
+func OutputTypeFromString(str string) (OutputType, error) {
+	outputType, ok := OutputType_value[strings.ToUpper(str)]
+	if !ok {
+		return Decimal, fmt.Errorf("invalid output type: %s", str)
+	}
+	return OutputType(outputType), nil
+}
+
+// ValueCount returns the number of random values, a single value is generated if unspecified
+func (s OutputSpec) ValueCount() uint32 {
+	if s.Count == 0 {
+		return 1
+	}
+	return s.Count
+}
+
+// Validate validates the output specification
+func (s OutputSpec) Validate() error {
+	if _, ok := OutputType_name[int32(s.Type)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidOutput, "unknown output type %d", s.Type)
+	}
+	if s.Count > MaxOutputCount {
+		return sdkerrors.Wrapf(ErrInvalidOutput, "at most %d values can be requested", MaxOutputCount)
+	}
+	if s.Type == Integer {
+		if s.RangeMax <= s.RangeMin {
+			return sdkerrors.Wrapf(ErrInvalidOutput, "range max %d must be greater than range min %d", s.RangeMax, s.RangeMin)
+		}
+	} else if s.RangeMin != 0 || s.RangeMax != 0 {
+		return sdkerrors.Wrap(ErrInvalidOutput, "the range is only applicable to the integer output")
+	}
+	return nil
+}
+
+// DeriveRandomValues derives the requested random values from the seed of the random number.
+// The values consume the hash chain seed, SHA256(seed), SHA256(SHA256(seed)), ... in order, the
+// integers are drawn by rejection sampling so that they are uniform in the range, and the first
+// decimal value is the random number itself
+func DeriveRandomValues(seed []byte, spec OutputSpec) []string {
+	chain := &hashChain{next: seed}
+
+	values := make([]string, spec.ValueCount())
+	for i := range values {
+		switch spec.Type {
+		case Integer:
+			values[i] = deriveInteger(chain, spec.RangeMin, spec.RangeMax)
+		case Raw:
+			values[i] = hex.EncodeToString(chain.Next())
+		default:
+			values[i] = SeedToRand(chain.Next()).FloatString(RandPrec)
+		}
+	}
+
+	return values
+}
+
+// deriveInteger draws an integer in [min,max) from the hash chain, the 256-bit draws beyond the
+// largest multiple of the range are rejected so that every integer is equally likely
+func deriveInteger(chain *hashChain, min, max uint64) string {
+	size := new(big.Int).SetUint64(max - min)
+	space := new(big.Int).Lsh(big.NewInt(1), 256)
+	limit := new(big.Int).Sub(space, new(big.Int).Mod(space, size))
+
+	for {
+		draw := new(big.Int).SetBytes(chain.Next())
+		if draw.Cmp(limit) < 0 {
+			value := new(big.Int).Add(new(big.Int).Mod(draw, size), new(big.Int).SetUint64(min))
+			return value.String()
+		}
+	}
+}
+
+// hashChain is the sequence of the seed and its repeated SHA256 hashes
+type hashChain struct {
+	next []byte
+}
+
+// Next returns the next element of the hash chain
+func (c *hashChain) Next() []byte {
+	current := c.next
+	c.next = SHA256(current)
+	return current
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputTypeFromString(t *testing.T) {
+	outputType, err := OutputTypeFromString("integer")
+	require.NoError(t, err)
+	require.Equal(t, Integer, outputType)
+
+	outputType, err = OutputTypeFromString("RAW")
+	require.NoError(t, err)
+	require.Equal(t, Raw, outputType)
+
+	_, err = OutputTypeFromString("float")
+	require.Error(t, err)
+}
+
+func TestDeriveRandomValues(t *testing.T) {
+	seed := SHA256([]byte("seed"))
+
+	// the first decimal value is the random number of the seed
+	values := DeriveRandomValues(seed, OutputSpec{})
+	require.Equal(t, []string{SeedToRand(seed).FloatString(RandPrec)}, values)
+
+	values = DeriveRandomValues(seed, NewOutputSpec(Decimal, 3, 0, 0))
+	require.Len(t, values, 3)
+	require.Equal(t, SeedToRand(SHA256(seed)).FloatString(RandPrec), values[1])
+
+	values = DeriveRandomValues(seed, NewOutputSpec(Raw, 2, 0, 0))
+	require.Equal(t, []string{hex.EncodeToString(seed), hex.EncodeToString(SHA256(seed))}, values)
+
+	spec := NewOutputSpec(Integer, MaxOutputCount, 5, 8)
+	values = DeriveRandomValues(seed, spec)
+	require.Len(t, values, MaxOutputCount)
+	require.Equal(t, values, DeriveRandomValues(seed, spec))
+
+	counts := make(map[uint64]int)
+	for _, value := range values {
+		v, err := strconv.ParseUint(value, 10, 64)
+		require.NoError(t, err)
+		require.True(t, v >= 5 && v < 8)
+		counts[v]++
+	}
+	require.Len(t, counts, 3)
+}
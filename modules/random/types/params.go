@@ -0,0 +1,327 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+
+	FeeDestinationFeeCollector  = "fee_collector"  // the request fee is paid to the fee collector
+	FeeDestinationCommunityPool = "community_pool" // the request fee is paid to the community pool
+
+	SeedCombinationHash = "hash" // the oracle seeds are combined by hashing
+
+	DefaultVRFProofTimeout = uint64(100) // number of blocks in which the VRF proof is expected by default
+)
+
+// Parameter store key
+var (
+	KeyMinBlockInterval    = []byte("MinBlockInterval")
+	KeyMaxBlockInterval    = []byte("MaxBlockInterval")
+	KeyRequestFee          = []byte("RequestFee")
+	KeyFeeDestination      = []byte("FeeDestination")
+	KeyMaxRequestsPerBlock = []byte("MaxRequestsPerBlock")
+	KeyOracleProviders     = []byte("OracleProviders")
+	KeyOracleThreshold     = []byte("OracleThreshold")
+	KeySeedCombination     = []byte("SeedCombination")
+	KeyRandomRetention     = []byte("RandomRetentionBlocks")
+	KeyVRFProofTimeout     = []byte("VRFProofTimeout")
+	KeyBeaconMinDeposit    = []byte("BeaconMinDeposit")
+	KeyBeaconSlashFraction = []byte("BeaconSlashFraction")
+	KeyBeaconCommitWindow  = []byte("BeaconCommitWindow")
+	KeyBeaconRevealWindow  = []byte("BeaconRevealWindow")
+)
+
+// ParamKeyTable for random module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs the random module parameters
+func NewParams(
+	minBlockInterval uint64,
+	maxBlockInterval uint64,
+	requestFee sdk.Coins,
+	feeDestination string,
+	maxRequestsPerBlock uint64,
+	oracleProviders uint32,
+	oracleThreshold uint32,
+	seedCombination string,
+	randomRetentionBlocks uint64,
+	vrfProofTimeout uint64,
+	beaconMinDeposit sdk.Coins,
+	beaconSlashFraction sdk.Dec,
+	beaconCommitWindow uint64,
+	beaconRevealWindow uint64,
+) Params {
+	return Params{
+		MinBlockInterval:      minBlockInterval,
+		MaxBlockInterval:      maxBlockInterval,
+		RequestFee:            requestFee,
+		FeeDestination:        feeDestination,
+		MaxRequestsPerBlock:   maxRequestsPerBlock,
+		OracleProviders:       oracleProviders,
+		OracleThreshold:       oracleThreshold,
+		SeedCombination:       seedCombination,
+		RandomRetentionBlocks: randomRetentionBlocks,
+		VRFProofTimeout:       vrfProofTimeout,
+		BeaconMinDeposit:      beaconMinDeposit,
+		BeaconSlashFraction:   beaconSlashFraction,
+		BeaconCommitWindow:    beaconCommitWindow,
+		BeaconRevealWindow:    beaconRevealWindow,
+	}
+}
+
+// DefaultParams returns default random module parameters
+func DefaultParams() Params {
+	return NewParams(
+		1, 100000, sdk.Coins{}, FeeDestinationFeeCollector, 100, 1, 1, SeedCombinationHash, 100000, DefaultVRFProofTimeout,
+		DefaultBeaconMinDeposit, DefaultBeaconSlashFraction, DefaultBeaconCommitWindow, DefaultBeaconRevealWindow,
+	)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinBlockInterval, &p.MinBlockInterval, validateBlockInterval),
+		paramtypes.NewParamSetPair(KeyMaxBlockInterval, &p.MaxBlockInterval, validateBlockInterval),
+		paramtypes.NewParamSetPair(KeyRequestFee, &p.RequestFee, validateRequestFee),
+		paramtypes.NewParamSetPair(KeyFeeDestination, &p.FeeDestination, validateFeeDestination),
+		paramtypes.NewParamSetPair(KeyMaxRequestsPerBlock, &p.MaxRequestsPerBlock, validateMaxRequestsPerBlock),
+		paramtypes.NewParamSetPair(KeyOracleProviders, &p.OracleProviders, validateOracleProviders),
+		paramtypes.NewParamSetPair(KeyOracleThreshold, &p.OracleThreshold, validateOracleThreshold),
+		paramtypes.NewParamSetPair(KeySeedCombination, &p.SeedCombination, validateSeedCombination),
+		paramtypes.NewParamSetPair(KeyRandomRetention, &p.RandomRetentionBlocks, validateRandomRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyVRFProofTimeout, &p.VRFProofTimeout, validateVRFProofTimeout),
+		paramtypes.NewParamSetPair(KeyBeaconMinDeposit, &p.BeaconMinDeposit, validateBeaconMinDeposit),
+		paramtypes.NewParamSetPair(KeyBeaconSlashFraction, &p.BeaconSlashFraction, validateBeaconSlashFraction),
+		paramtypes.NewParamSetPair(KeyBeaconCommitWindow, &p.BeaconCommitWindow, validateBeaconWindow),
+		paramtypes.NewParamSetPair(KeyBeaconRevealWindow, &p.BeaconRevealWindow, validateBeaconWindow),
+	}
+}
+
+// GetParamSpace implements params.ParamStruct
+func (p *Params) GetParamSpace() string {
+	return DefaultParamSpace
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateBlockInterval(p.MinBlockInterval); err != nil {
+		return err
+	}
+	if err := validateBlockInterval(p.MaxBlockInterval); err != nil {
+		return err
+	}
+	if p.MinBlockInterval > p.MaxBlockInterval {
+		return sdkerrors.Wrapf(
+			ErrInvalidParams, "min block interval [%d] should not be greater than max block interval [%d]",
+			p.MinBlockInterval, p.MaxBlockInterval,
+		)
+	}
+	if err := validateRequestFee(p.RequestFee); err != nil {
+		return err
+	}
+	if err := validateFeeDestination(p.FeeDestination); err != nil {
+		return err
+	}
+	if err := validateMaxRequestsPerBlock(p.MaxRequestsPerBlock); err != nil {
+		return err
+	}
+	if err := validateOracleProviders(p.OracleProviders); err != nil {
+		return err
+	}
+	if err := validateOracleThreshold(p.OracleThreshold); err != nil {
+		return err
+	}
+	if p.OracleThreshold > p.OracleProviders {
+		return sdkerrors.Wrapf(
+			ErrInvalidParams, "oracle threshold [%d] should not be greater than oracle providers [%d]",
+			p.OracleThreshold, p.OracleProviders,
+		)
+	}
+	if err := validateSeedCombination(p.SeedCombination); err != nil {
+		return err
+	}
+	if err := validateRandomRetentionBlocks(p.RandomRetentionBlocks); err != nil {
+		return err
+	}
+	if err := validateVRFProofTimeout(p.VRFProofTimeout); err != nil {
+		return err
+	}
+	if err := validateBeaconMinDeposit(p.BeaconMinDeposit); err != nil {
+		return err
+	}
+	if err := validateBeaconSlashFraction(p.BeaconSlashFraction); err != nil {
+		return err
+	}
+	if err := validateBeaconWindow(p.BeaconCommitWindow); err != nil {
+		return err
+	}
+	return validateBeaconWindow(p.BeaconRevealWindow)
+}
+
+func validateBlockInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("block interval must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRequestFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid request fee: %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeDestination(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != FeeDestinationFeeCollector && v != FeeDestinationCommunityPool {
+		return fmt.Errorf("fee destination must be %s or %s: %s", FeeDestinationFeeCollector, FeeDestinationCommunityPool, v)
+	}
+
+	return nil
+}
+
+func validateMaxRequestsPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max requests per block must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateOracleProviders(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("oracle providers must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateOracleThreshold(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("oracle threshold must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateSeedCombination(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != SeedCombinationHash {
+		return fmt.Errorf("seed combination must be %s: %s", SeedCombinationHash, v)
+	}
+
+	return nil
+}
+
+func validateRandomRetentionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateVRFProofTimeout(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("VRF proof timeout must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateBeaconMinDeposit(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid beacon min deposit: %s", v)
+	}
+
+	return nil
+}
+
+func validateBeaconSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("beacon slash fraction must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateBeaconWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("beacon window must be positive: %d", v)
+	}
+
+	return nil
+}
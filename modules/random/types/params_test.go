@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	slash := sdk.NewDecWithPrec(1, 1)
+
+	tests := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"community pool", NewParams(1, 100, fee, FeeDestinationCommunityPool, 10, 3, 2, SeedCombinationHash, 1000, 100, fee, slash, 10, 10), true},
+		{"zero min block interval", NewParams(0, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, SeedCombinationHash, 1000, 100, fee, slash, 10, 10), false},
+		{"min greater than max", NewParams(101, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, SeedCombinationHash, 1000, 100, fee, slash, 10, 10), false},
+		{"invalid request fee", NewParams(1, 100, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, FeeDestinationFeeCollector, 10, 3, 2, SeedCombinationHash, 1000, 100, fee, slash, 10, 10), false},
+		{"unknown fee destination", NewParams(1, 100, fee, "burn", 10, 3, 2, SeedCombinationHash, 1000, 100, fee, slash, 10, 10), false},
+		{"zero max requests per block", NewParams(1, 100, fee, FeeDestinationFeeCollector, 0, 3, 2, SeedCombinationHash, 1000, 100, fee, slash, 10, 10), false},
+		{"hash combination", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 3, SeedCombinationHash, 1000, 100, fee, slash, 10, 10), true},
+		{"zero oracle threshold", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 0, SeedCombinationHash, 1000, 100, fee, slash, 10, 10), false},
+		{"threshold greater than providers", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 4, SeedCombinationHash, 1000, 100, fee, slash, 10, 10), false},
+		{"keep randoms forever", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, SeedCombinationHash, 0, 100, fee, slash, 10, 10), true},
+		{"unknown seed combination", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, "sum", 1000, 100, fee, slash, 10, 10), false},
+		{"xor seed combination", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, "xor", 1000, 100, fee, slash, 10, 10), false},
+		{"zero VRF proof timeout", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, SeedCombinationHash, 1000, 0, fee, slash, 10, 10), false},
+		{"no beacon min deposit", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, SeedCombinationHash, 1000, 100, sdk.Coins{}, slash, 10, 10), true},
+		{"invalid beacon min deposit", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, SeedCombinationHash, 1000, 100, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, slash, 10, 10), false},
+		{"negative beacon slash fraction", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, SeedCombinationHash, 1000, 100, fee, sdk.NewDec(-1), 10, 10), false},
+		{"beacon slash fraction greater than one", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, SeedCombinationHash, 1000, 100, fee, sdk.NewDecWithPrec(11, 1), 10, 10), false},
+		{"zero beacon commit window", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, SeedCombinationHash, 1000, 100, fee, slash, 0, 10), false},
+		{"zero beacon reveal window", NewParams(1, 100, fee, FeeDestinationFeeCollector, 10, 3, 2, SeedCombinationHash, 1000, 100, fee, slash, 10, 0), false},
+	}
+
+	require.Equal(t, SeedCombinationHash, DefaultParams().SeedCombination)
+
+	for _, tc := range tests {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeUpdateVRFPublicKey = "UpdateVRFPublicKey" // type for UpdateVRFPublicKeyProposal
+)
+
+var _ govtypes.Content = &UpdateVRFPublicKeyProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateVRFPublicKey)
+	govtypes.RegisterProposalTypeCodec(&UpdateVRFPublicKeyProposal{}, "irishub/random/UpdateVRFPublicKeyProposal")
+}
+
+// NewUpdateVRFPublicKeyProposal constructs an UpdateVRFPublicKeyProposal
+func NewUpdateVRFPublicKeyProposal(title, description string, publicKey []byte) *UpdateVRFPublicKeyProposal {
+	return &UpdateVRFPublicKeyProposal{
+		Title:       title,
+		Description: description,
+		PublicKey:   publicKey,
+	}
+}
+
+// ProposalRoute implements Content.
+func (p *UpdateVRFPublicKeyProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements Content.
+func (p *UpdateVRFPublicKeyProposal) ProposalType() string { return ProposalTypeUpdateVRFPublicKey }
+
+// ValidateBasic implements Content.
+func (p *UpdateVRFPublicKeyProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := ParseVRFPublicKey(p.PublicKey); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVRFPublicKey, err.Error())
+	}
+	return nil
+}
+
+// String implements Content.
+func (p UpdateVRFPublicKeyProposal) String() string {
+	return fmt.Sprintf(`Update VRF Public Key Proposal:
+  Title:       %s
+  Description: %s
+  Public Key:  %s
+`, p.Title, p.Description, p.PublicKey)
+}
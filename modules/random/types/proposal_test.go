@@ -0,0 +1,36 @@
+package types
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateVRFPublicKeyProposalValidateBasic(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, MinVRFPublicKeyModulusBits)
+	require.NoError(t, err)
+	pubKey, err := x509.MarshalPKIXPublicKey(&privKey.PublicKey)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		proposal *UpdateVRFPublicKeyProposal
+		expPass  bool
+	}{
+		{"valid key", NewUpdateVRFPublicKeyProposal("title", "description", pubKey), true},
+		{"empty title", NewUpdateVRFPublicKeyProposal("", "description", pubKey), false},
+		{"empty key", NewUpdateVRFPublicKeyProposal("title", "description", nil), false},
+		{"invalid key", NewUpdateVRFPublicKeyProposal("title", "description", []byte("invalid_key")), false},
+	}
+
+	for _, tc := range testCases {
+		if tc.expPass {
+			require.NoError(t, tc.proposal.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.proposal.ValidateBasic(), tc.name)
+		}
+	}
+}
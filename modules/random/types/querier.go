@@ -1,16 +1,46 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultConsumerRequestsLimit is the default number of requests in a page of the consumer requests
+const DefaultConsumerRequestsLimit = 100
+
 const (
-	QueryRandom             = "random" // random query endpoint supported by the random querier
-	QueryRandomRequestQueue = "queue"  // random request queue query endpoint supported by the random querier
+	QueryRandom             = "random"            // random query endpoint supported by the random querier
+	QueryRandomValue        = "value"             // random value query endpoint supported by the random querier
+	QueryRandomRequestQueue = "queue"             // random request queue query endpoint supported by the random querier
+	QueryConsumerRequests   = "consumer_requests" // consumer requests query endpoint supported by the random querier
+	QueryVerifyRandom       = "verify"            // random verification query endpoint supported by the random querier
+	QueryPrunedCommitment   = "pruned_commitment" // pruned randoms commitment query endpoint supported by the random querier
+	QueryParams             = "params"            // params query endpoint supported by the random querier
 )
 
-// QueryRandomParams is the query parameters for 'custom/random/random'
+// QueryRandomParams is the query parameters for 'custom/random/random' and 'custom/random/verify'
 type QueryRandomParams struct {
 	ReqID string `json:"req_id" yaml:"req_id"` // request id
 }
 
+// QueryRandomValueParams is the query parameters for 'custom/random/value'
+type QueryRandomValueParams struct {
+	ReqID string `json:"req_id" yaml:"req_id"` // request id
+	Index uint32 `json:"index" yaml:"index"`   // index of the random value
+}
+
 // QueryRandomRequestQueueParams is the query parameters for 'custom/random/queue'
 type QueryRandomRequestQueueParams struct {
 	Height int64 `json:"height" yaml:"height"` // the height of the block where the random number is generated
 }
+
+// QueryConsumerRequestsParams is the query parameters for 'custom/random/consumer_requests'
+type QueryConsumerRequestsParams struct {
+	Consumer sdk.AccAddress `json:"consumer" yaml:"consumer"` // consumer of the random requests
+	Page     uint64         `json:"page" yaml:"page"`         // page number, starting from 1
+	Limit    uint64         `json:"limit" yaml:"limit"`       // number of requests in a page
+}
+
+// QueryPrunedCommitmentParams is the query parameters for 'custom/random/pruned_commitment'
+type QueryPrunedCommitmentParams struct {
+	Height int64 `json:"height" yaml:"height"` // the height of the block where the random numbers are pruned
+}
@@ -6,10 +6,12 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
+	github_com_tendermint_tendermint_libs_bytes "github.com/tendermint/tendermint/libs/bytes"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -119,6 +121,104 @@ func (m *QueryRandomResponse) GetRandom() *Random {
 	return nil
 }
 
+// QueryRandomValueRequest is request type for the Query/RandomValue RPC method
+type QueryRandomValueRequest struct {
+	ReqId string `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryRandomValueRequest) Reset()         { *m = QueryRandomValueRequest{} }
+func (m *QueryRandomValueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRandomValueRequest) ProtoMessage()    {}
+func (*QueryRandomValueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e1fe88061ff84, []int{2}
+}
+func (m *QueryRandomValueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRandomValueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRandomValueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRandomValueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRandomValueRequest.Merge(m, src)
+}
+func (m *QueryRandomValueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRandomValueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRandomValueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRandomValueRequest proto.InternalMessageInfo
+
+func (m *QueryRandomValueRequest) GetReqId() string {
+	if m != nil {
+		return m.ReqId
+	}
+	return ""
+}
+
+func (m *QueryRandomValueRequest) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// QueryRandomValueResponse is response type for the Query/RandomValue RPC method
+type QueryRandomValueResponse struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *QueryRandomValueResponse) Reset()         { *m = QueryRandomValueResponse{} }
+func (m *QueryRandomValueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRandomValueResponse) ProtoMessage()    {}
+func (*QueryRandomValueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e1fe88061ff84, []int{3}
+}
+func (m *QueryRandomValueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRandomValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRandomValueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRandomValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRandomValueResponse.Merge(m, src)
+}
+func (m *QueryRandomValueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRandomValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRandomValueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRandomValueResponse proto.InternalMessageInfo
+
+func (m *QueryRandomValueResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // QueryRandomRequestQueueRequest is request type for the Query/RandomRequestQueue RPC method
 type QueryRandomRequestQueueRequest struct {
 	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -128,7 +228,7 @@ func (m *QueryRandomRequestQueueRequest) Reset()         { *m = QueryRandomReque
 func (m *QueryRandomRequestQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRandomRequestQueueRequest) ProtoMessage()    {}
 func (*QueryRandomRequestQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e7e1fe88061ff84, []int{2}
+	return fileDescriptor_0e7e1fe88061ff84, []int{4}
 }
 func (m *QueryRandomRequestQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +273,7 @@ func (m *QueryRandomRequestQueueResponse) Reset()         { *m = QueryRandomRequ
 func (m *QueryRandomRequestQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRandomRequestQueueResponse) ProtoMessage()    {}
 func (*QueryRandomRequestQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e7e1fe88061ff84, []int{3}
+	return fileDescriptor_0e7e1fe88061ff84, []int{5}
 }
 func (m *QueryRandomRequestQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -209,362 +309,2234 @@ func (m *QueryRandomRequestQueueResponse) GetRequests() []Request {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryRandomRequest)(nil), "irishub.random.QueryRandomRequest")
-	proto.RegisterType((*QueryRandomResponse)(nil), "irishub.random.QueryRandomResponse")
-	proto.RegisterType((*QueryRandomRequestQueueRequest)(nil), "irishub.random.QueryRandomRequestQueueRequest")
-	proto.RegisterType((*QueryRandomRequestQueueResponse)(nil), "irishub.random.QueryRandomRequestQueueResponse")
+// QueryConsumerRequestsRequest is request type for the Query/ConsumerRequests RPC method
+type QueryConsumerRequestsRequest struct {
+	Consumer   string             `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("random/query.proto", fileDescriptor_0e7e1fe88061ff84) }
-
-var fileDescriptor_0e7e1fe88061ff84 = []byte{
-	// 338 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0x33, 0xf4, 0x6b, 0xf8, 0xbc, 0x05, 0x17, 0x53, 0xad, 0x35, 0x8b, 0x69, 0x89, 0x9b,
-	0x82, 0x90, 0x81, 0xba, 0xd1, 0x6d, 0xc1, 0x45, 0x97, 0x8d, 0x3b, 0x11, 0x8a, 0x6d, 0x2e, 0x69,
-	0xc0, 0x74, 0x9a, 0x99, 0x04, 0xe9, 0x5b, 0xf8, 0x58, 0x5d, 0x76, 0x23, 0xb8, 0x12, 0x69, 0x5f,
-	0x44, 0x32, 0x33, 0x15, 0x6b, 0xf0, 0xcf, 0x2a, 0x33, 0x73, 0x7e, 0xf7, 0x9c, 0x43, 0xb8, 0x40,
-	0xe5, 0xfd, 0x3c, 0x12, 0x29, 0xcf, 0x0a, 0x94, 0xcb, 0x60, 0x21, 0x45, 0x2e, 0xe8, 0x61, 0x22,
-	0x13, 0x35, 0x2b, 0x26, 0x81, 0xd1, 0xbc, 0xa6, 0x65, 0xcc, 0xc7, 0x40, 0xde, 0x51, 0x2c, 0x62,
-	0xa1, 0x8f, 0xbc, 0x3c, 0xd9, 0xd7, 0xd3, 0xa9, 0x50, 0xa9, 0x50, 0x63, 0x23, 0x98, 0x8b, 0x91,
-	0xfc, 0x73, 0xa0, 0xa3, 0x32, 0x24, 0xd4, 0x2e, 0x21, 0x66, 0x05, 0xaa, 0x9c, 0x1e, 0x83, 0x2b,
-	0x31, 0x1b, 0x27, 0x51, 0x9b, 0x74, 0x49, 0xef, 0x20, 0xac, 0x4b, 0xcc, 0x86, 0x91, 0x7f, 0x0d,
-	0xcd, 0x3d, 0x58, 0x2d, 0xc4, 0x5c, 0x21, 0x0d, 0xc0, 0x35, 0x25, 0x34, 0xdd, 0xe8, 0xb7, 0x82,
-	0xfd, 0xaa, 0x81, 0xe5, 0x2d, 0xe5, 0x5f, 0x02, 0xab, 0x66, 0x8e, 0x0a, 0x2c, 0x70, 0x97, 0xdf,
-	0x02, 0x77, 0x86, 0x49, 0x3c, 0xcb, 0xb5, 0x63, 0x2d, 0xb4, 0x37, 0xff, 0x0e, 0x3a, 0xdf, 0x4e,
-	0xda, 0x32, 0x57, 0xf0, 0x5f, 0x9a, 0x77, 0xd5, 0x26, 0xdd, 0x5a, 0xaf, 0xd1, 0x3f, 0xa9, 0xd4,
-	0x31, 0xfa, 0xe0, 0xdf, 0xea, 0xb5, 0xe3, 0x84, 0x1f, 0x78, 0xff, 0x99, 0x40, 0x5d, 0xdb, 0xd3,
-	0x1b, 0x70, 0x4d, 0x04, 0xf5, 0xbf, 0x0e, 0x57, 0xf3, 0xbd, 0xb3, 0x1f, 0x19, 0xd3, 0xcb, 0x77,
-	0xe8, 0x23, 0xd0, 0x6a, 0x6f, 0x1a, 0xfc, 0x1e, 0xf0, 0xf9, 0xd7, 0x78, 0xfc, 0xcf, 0xfc, 0x2e,
-	0x78, 0x30, 0x5c, 0x6d, 0x18, 0x59, 0x6f, 0x18, 0x79, 0xdb, 0x30, 0xf2, 0xb4, 0x65, 0xce, 0x7a,
-	0xcb, 0x9c, 0x97, 0x2d, 0x73, 0x6e, 0x79, 0x9c, 0xe4, 0xa5, 0xd5, 0x54, 0xa4, 0xbc, 0xb4, 0x9d,
-	0x63, 0xce, 0xad, 0x3d, 0x4f, 0x45, 0x54, 0x3c, 0xa0, 0xb2, 0xfb, 0xc5, 0xf3, 0xe5, 0x02, 0xd5,
-	0xc4, 0xd5, 0x5b, 0x73, 0xf1, 0x1e, 0x00, 0x00, 0xff, 0xff, 0x34, 0xe2, 0x6f, 0xda, 0xa1, 0x02,
-	0x00, 0x00,
+func (m *QueryConsumerRequestsRequest) Reset()         { *m = QueryConsumerRequestsRequest{} }
+func (m *QueryConsumerRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRequestsRequest) ProtoMessage()    {}
+func (*QueryConsumerRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e1fe88061ff84, []int{6}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Random queries the random result
-	Random(ctx context.Context, in *QueryRandomRequest, opts ...grpc.CallOption) (*QueryRandomResponse, error)
-	// RandomRequestQueue queries the random request queue
-	RandomRequestQueue(ctx context.Context, in *QueryRandomRequestQueueRequest, opts ...grpc.CallOption) (*QueryRandomRequestQueueResponse, error)
+func (m *QueryConsumerRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryConsumerRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryConsumerRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRequestsRequest.Merge(m, src)
+}
+func (m *QueryConsumerRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRequestsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Random(ctx context.Context, in *QueryRandomRequest, opts ...grpc.CallOption) (*QueryRandomResponse, error) {
-	out := new(QueryRandomResponse)
-	err := c.cc.Invoke(ctx, "/irishub.random.Query/Random", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerRequestsRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerRequestsRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) RandomRequestQueue(ctx context.Context, in *QueryRandomRequestQueueRequest, opts ...grpc.CallOption) (*QueryRandomRequestQueueResponse, error) {
-	out := new(QueryRandomRequestQueueResponse)
-	err := c.cc.Invoke(ctx, "/irishub.random.Query/RandomRequestQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Random queries the random result
-	Random(context.Context, *QueryRandomRequest) (*QueryRandomResponse, error)
-	// RandomRequestQueue queries the random request queue
-	RandomRequestQueue(context.Context, *QueryRandomRequestQueueRequest) (*QueryRandomRequestQueueResponse, error)
+// QueryConsumerRequestsResponse is response type for the Query/ConsumerRequests RPC method
+type QueryConsumerRequestsResponse struct {
+	Requests   []ConsumerRequest   `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryConsumerRequestsResponse) Reset()         { *m = QueryConsumerRequestsResponse{} }
+func (m *QueryConsumerRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRequestsResponse) ProtoMessage()    {}
+func (*QueryConsumerRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e1fe88061ff84, []int{7}
 }
-
-func (*UnimplementedQueryServer) Random(ctx context.Context, req *QueryRandomRequest) (*QueryRandomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Random not implemented")
+func (m *QueryConsumerRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RandomRequestQueue(ctx context.Context, req *QueryRandomRequestQueueRequest) (*QueryRandomRequestQueueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RandomRequestQueue not implemented")
+func (m *QueryConsumerRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryConsumerRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRequestsResponse.Merge(m, src)
+}
+func (m *QueryConsumerRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRequestsResponse.DiscardUnknown(m)
 }
 
-func _Query_Random_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRandomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Random(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/irishub.random.Query/Random",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Random(ctx, req.(*QueryRandomRequest))
+var xxx_messageInfo_QueryConsumerRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerRequestsResponse) GetRequests() []ConsumerRequest {
+	if m != nil {
+		return m.Requests
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RandomRequestQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRandomRequestQueueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RandomRequestQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/irishub.random.Query/RandomRequestQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RandomRequestQueue(ctx, req.(*QueryRandomRequestQueueRequest))
+func (m *QueryConsumerRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "irishub.random.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Random",
-			Handler:    _Query_Random_Handler,
-		},
-		{
-			MethodName: "RandomRequestQueue",
-			Handler:    _Query_RandomRequestQueue_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "random/query.proto",
+// QueryVerifyRandomRequest is request type for the Query/VerifyRandom RPC method
+type QueryVerifyRandomRequest struct {
+	ReqId string `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
 }
 
-func (m *QueryRandomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyRandomRequest) Reset()         { *m = QueryVerifyRandomRequest{} }
+func (m *QueryVerifyRandomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyRandomRequest) ProtoMessage()    {}
+func (*QueryVerifyRandomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e1fe88061ff84, []int{8}
+}
+func (m *QueryVerifyRandomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyRandomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyRandomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryRandomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVerifyRandomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyRandomRequest.Merge(m, src)
+}
+func (m *QueryVerifyRandomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyRandomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyRandomRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRandomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReqId) > 0 {
-		i -= len(m.ReqId)
-		copy(dAtA[i:], m.ReqId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReqId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryVerifyRandomRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyRandomRequest) GetReqId() string {
+	if m != nil {
+		return m.ReqId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRandomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryVerifyRandomResponse is response type for the Query/VerifyRandom RPC method
+type QueryVerifyRandomResponse struct {
+	Random       *Random                                              `protobuf:"bytes,1,opt,name=random,proto3" json:"random,omitempty"`
+	VRFPublicKey github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=vrf_public_key,json=vrfPublicKey,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"vrf_public_key,omitempty"`
+	Verified     bool                                                 `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
 }
 
-func (m *QueryRandomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVerifyRandomResponse) Reset()         { *m = QueryVerifyRandomResponse{} }
+func (m *QueryVerifyRandomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyRandomResponse) ProtoMessage()    {}
+func (*QueryVerifyRandomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e1fe88061ff84, []int{9}
 }
-
-func (m *QueryRandomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Random != nil {
-		{
-			size, err := m.Random.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVerifyRandomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyRandomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyRandomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRandomRequestQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVerifyRandomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyRandomResponse.Merge(m, src)
+}
+func (m *QueryVerifyRandomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyRandomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyRandomResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRandomRequestQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryVerifyRandomResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyRandomResponse) GetRandom() *Random {
+	if m != nil {
+		return m.Random
+	}
+	return nil
 }
 
-func (m *QueryRandomRequestQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryVerifyRandomResponse) GetVRFPublicKey() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.VRFPublicKey
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryRandomRequestQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyRandomResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryRandomRequestQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPrunedRandomsCommitmentRequest is request type for the Query/PrunedRandomsCommitment RPC method
+type QueryPrunedRandomsCommitmentRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryRandomRequestQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPrunedRandomsCommitmentRequest) Reset()         { *m = QueryPrunedRandomsCommitmentRequest{} }
+func (m *QueryPrunedRandomsCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrunedRandomsCommitmentRequest) ProtoMessage()    {}
+func (*QueryPrunedRandomsCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e1fe88061ff84, []int{10}
+}
+func (m *QueryPrunedRandomsCommitmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrunedRandomsCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrunedRandomsCommitmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPrunedRandomsCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrunedRandomsCommitmentRequest.Merge(m, src)
+}
+func (m *QueryPrunedRandomsCommitmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrunedRandomsCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrunedRandomsCommitmentRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryPrunedRandomsCommitmentRequest proto.InternalMessageInfo
+
+func (m *QueryPrunedRandomsCommitmentRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryRandomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ReqId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+// QueryPrunedRandomsCommitmentResponse is response type for the Query/PrunedRandomsCommitment RPC method
+type QueryPrunedRandomsCommitmentResponse struct {
+	Commitment PrunedRandomsCommitment `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment"`
+}
+
+func (m *QueryPrunedRandomsCommitmentResponse) Reset()         { *m = QueryPrunedRandomsCommitmentResponse{} }
+func (m *QueryPrunedRandomsCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrunedRandomsCommitmentResponse) ProtoMessage()    {}
+func (*QueryPrunedRandomsCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e1fe88061ff84, []int{11}
+}
+func (m *QueryPrunedRandomsCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrunedRandomsCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrunedRandomsCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPrunedRandomsCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrunedRandomsCommitmentResponse.Merge(m, src)
+}
+func (m *QueryPrunedRandomsCommitmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrunedRandomsCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrunedRandomsCommitmentResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRandomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPrunedRandomsCommitmentResponse proto.InternalMessageInfo
+
+func (m *QueryPrunedRandomsCommitmentResponse) GetCommitment() PrunedRandomsCommitment {
+	if m != nil {
+		return m.Commitment
 	}
-	var l int
-	_ = l
-	if m.Random != nil {
-		l = m.Random.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return PrunedRandomsCommitment{}
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e1fe88061ff84, []int{12}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRandomRequestQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e1fe88061ff84, []int{13}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return n
+	return Params{}
 }
 
-func (m *QueryRandomRequestQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*QueryRandomRequest)(nil), "irishub.random.QueryRandomRequest")
+	proto.RegisterType((*QueryRandomResponse)(nil), "irishub.random.QueryRandomResponse")
+	proto.RegisterType((*QueryRandomValueRequest)(nil), "irishub.random.QueryRandomValueRequest")
+	proto.RegisterType((*QueryRandomValueResponse)(nil), "irishub.random.QueryRandomValueResponse")
+	proto.RegisterType((*QueryRandomRequestQueueRequest)(nil), "irishub.random.QueryRandomRequestQueueRequest")
+	proto.RegisterType((*QueryRandomRequestQueueResponse)(nil), "irishub.random.QueryRandomRequestQueueResponse")
+	proto.RegisterType((*QueryConsumerRequestsRequest)(nil), "irishub.random.QueryConsumerRequestsRequest")
+	proto.RegisterType((*QueryConsumerRequestsResponse)(nil), "irishub.random.QueryConsumerRequestsResponse")
+	proto.RegisterType((*QueryVerifyRandomRequest)(nil), "irishub.random.QueryVerifyRandomRequest")
+	proto.RegisterType((*QueryVerifyRandomResponse)(nil), "irishub.random.QueryVerifyRandomResponse")
+	proto.RegisterType((*QueryPrunedRandomsCommitmentRequest)(nil), "irishub.random.QueryPrunedRandomsCommitmentRequest")
+	proto.RegisterType((*QueryPrunedRandomsCommitmentResponse)(nil), "irishub.random.QueryPrunedRandomsCommitmentResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "irishub.random.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "irishub.random.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("random/query.proto", fileDescriptor_0e7e1fe88061ff84) }
+
+var fileDescriptor_0e7e1fe88061ff84 = []byte{
+	// 754 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xda, 0x4a,
+	0x10, 0xc6, 0x2f, 0x0f, 0x94, 0x37, 0xf0, 0xa2, 0xa7, 0x0d, 0x2f, 0x21, 0x56, 0x63, 0x90, 0x53,
+	0x29, 0x54, 0x6d, 0xed, 0x96, 0xe4, 0xd0, 0x54, 0xea, 0xa1, 0x44, 0x8d, 0x12, 0x45, 0x95, 0x88,
+	0x23, 0xe5, 0x50, 0x55, 0x42, 0x06, 0x6f, 0xc8, 0xaa, 0xd8, 0x86, 0x5d, 0x9b, 0x86, 0x3f, 0xd0,
+	0x73, 0x7f, 0x41, 0x7f, 0x4f, 0x8e, 0xb9, 0xb5, 0xa7, 0xa8, 0x22, 0xbf, 0xa0, 0xd7, 0x9e, 0x2a,
+	0x7b, 0x17, 0xc7, 0x80, 0x4d, 0x68, 0x4f, 0xec, 0xec, 0x7c, 0xf3, 0x7d, 0x33, 0xbb, 0x33, 0x8b,
+	0x01, 0x51, 0xd3, 0xb1, 0x5c, 0x5b, 0xef, 0xfb, 0x98, 0x0e, 0xb5, 0x1e, 0x75, 0x3d, 0x17, 0xad,
+	0x10, 0x4a, 0xd8, 0x85, 0xdf, 0xd2, 0xb8, 0x4f, 0x5e, 0x15, 0x18, 0xfe, 0xc3, 0x41, 0x72, 0xb1,
+	0xe3, 0x76, 0xdc, 0x70, 0xa9, 0x07, 0x2b, 0xb1, 0xbb, 0xd1, 0x76, 0x99, 0xed, 0xb2, 0x26, 0x77,
+	0x70, 0x43, 0xb8, 0x36, 0xb9, 0xc5, 0x95, 0xf4, 0x9e, 0xd9, 0x21, 0x8e, 0xe9, 0x11, 0xd7, 0xe1,
+	0x6e, 0xf5, 0x31, 0xa0, 0x93, 0xc0, 0x63, 0x84, 0x22, 0x06, 0xee, 0xfb, 0x98, 0x79, 0xe8, 0x7f,
+	0xc8, 0x51, 0xdc, 0x6f, 0x12, 0xab, 0x24, 0x55, 0xa4, 0xea, 0x3f, 0x46, 0x96, 0xe2, 0xfe, 0x91,
+	0xa5, 0xbe, 0x81, 0xd5, 0x09, 0x30, 0xeb, 0xb9, 0x0e, 0xc3, 0x48, 0x83, 0x1c, 0xcf, 0x31, 0x44,
+	0xe7, 0x6b, 0x6b, 0xda, 0x64, 0x25, 0x9a, 0xc0, 0x0b, 0x94, 0x7a, 0x00, 0xeb, 0x31, 0x9a, 0x33,
+	0xb3, 0xeb, 0xe3, 0xf9, 0xc2, 0xa8, 0x08, 0x59, 0xe2, 0x58, 0xf8, 0xb2, 0xf4, 0x57, 0x45, 0xaa,
+	0xfe, 0x6b, 0x70, 0x43, 0x7d, 0x06, 0xa5, 0x59, 0x1e, 0x91, 0x53, 0x11, 0xb2, 0x83, 0x60, 0x63,
+	0xcc, 0x13, 0x1a, 0xea, 0x0b, 0x50, 0x66, 0xab, 0x3d, 0xf1, 0xf1, 0x5d, 0x02, 0x6b, 0x90, 0xbb,
+	0xc0, 0xa4, 0x73, 0xe1, 0x85, 0x81, 0x4b, 0x86, 0xb0, 0xd4, 0xf7, 0x50, 0x4e, 0x8d, 0x14, 0x92,
+	0x7b, 0xb0, 0x4c, 0xf9, 0x3e, 0x2b, 0x49, 0x95, 0xa5, 0x6a, 0xbe, 0xb6, 0x3e, 0x73, 0x10, 0xdc,
+	0x5f, 0xff, 0xfb, 0xea, 0xa6, 0x9c, 0x31, 0x22, 0xb8, 0xea, 0xc3, 0x83, 0x90, 0x7d, 0xdf, 0x75,
+	0x98, 0x6f, 0x63, 0x2a, 0x70, 0x6c, 0x9c, 0x95, 0x0c, 0xcb, 0x6d, 0xe1, 0x12, 0x05, 0x45, 0x36,
+	0xda, 0x03, 0xb8, 0xbb, 0xd5, 0xf0, 0x80, 0xf2, 0xb5, 0x0d, 0x4d, 0xf4, 0x00, 0xef, 0xaf, 0x86,
+	0xd9, 0x19, 0x17, 0x68, 0xc4, 0xc0, 0xea, 0x17, 0x09, 0x36, 0x53, 0x74, 0x45, 0x4d, 0xaf, 0x67,
+	0x6a, 0x2a, 0x4f, 0xd7, 0x34, 0x15, 0x3b, 0x5d, 0x1b, 0x7a, 0x99, 0x90, 0x9f, 0x9c, 0x94, 0x1f,
+	0x97, 0x9c, 0x48, 0xf0, 0xb9, 0xb8, 0xe1, 0x33, 0x4c, 0xc9, 0xf9, 0x62, 0x3d, 0xfa, 0x55, 0x82,
+	0x8d, 0x84, 0x98, 0x3f, 0x6b, 0x55, 0xe4, 0xc0, 0xca, 0x80, 0x9e, 0x37, 0x7b, 0x7e, 0xab, 0x4b,
+	0xda, 0xcd, 0x0f, 0x78, 0x18, 0x16, 0x50, 0xa8, 0x1f, 0x8e, 0x6e, 0xca, 0x85, 0x33, 0xe3, 0xa0,
+	0x11, 0x3a, 0x8e, 0xf1, 0xf0, 0xe7, 0x4d, 0x79, 0xb7, 0x43, 0xbc, 0x80, 0xa9, 0xed, 0xda, 0xba,
+	0x87, 0x1d, 0x0b, 0x53, 0x9b, 0x38, 0x5e, 0x7c, 0xd9, 0x25, 0x2d, 0xa6, 0xb7, 0x86, 0x1e, 0x66,
+	0xda, 0x21, 0xbe, 0xac, 0x07, 0x0b, 0xa3, 0x30, 0xa0, 0xe7, 0x11, 0x4b, 0x70, 0xd1, 0x83, 0x20,
+	0x6f, 0x82, 0xad, 0xd2, 0x52, 0x45, 0xaa, 0x2e, 0x1b, 0x91, 0xad, 0xbe, 0x82, 0xad, 0xb0, 0xb0,
+	0x06, 0xf5, 0x1d, 0x6c, 0xf1, 0x44, 0xd9, 0xbe, 0x6b, 0xdb, 0xc4, 0xb3, 0xb1, 0xe3, 0xdd, 0xd7,
+	0xc1, 0x3e, 0x3c, 0x9c, 0x1f, 0x2e, 0x8e, 0xe8, 0x2d, 0x40, 0x3b, 0xda, 0x15, 0xc7, 0xb4, 0x3d,
+	0x7d, 0x4c, 0x29, 0x24, 0xe2, 0xf2, 0x63, 0x04, 0x6a, 0x51, 0x3c, 0x30, 0x0d, 0x93, 0x9a, 0xf6,
+	0xb8, 0xa1, 0xd5, 0x63, 0x58, 0x9d, 0xd8, 0x15, 0xda, 0xbb, 0x90, 0xeb, 0x85, 0x3b, 0x69, 0xd7,
+	0xc3, 0xf1, 0x42, 0x46, 0x60, 0x6b, 0x3f, 0xb2, 0x90, 0x0d, 0xd9, 0xd0, 0x29, 0xe4, 0x78, 0x4e,
+	0x48, 0x9d, 0x8e, 0x9c, 0x9d, 0x5e, 0x79, 0x6b, 0x2e, 0x86, 0xa7, 0xa4, 0x66, 0xd0, 0x47, 0x40,
+	0xb3, 0x53, 0x8f, 0xb4, 0xfb, 0x05, 0xe2, 0x0f, 0x8b, 0xac, 0x2f, 0x8c, 0x8f, 0x84, 0xfb, 0xf0,
+	0xdf, 0xf4, 0x60, 0xa2, 0x27, 0x89, 0x34, 0x29, 0xef, 0x86, 0xfc, 0x74, 0x41, 0x74, 0x24, 0x89,
+	0xa1, 0x10, 0x9f, 0x1b, 0x54, 0x4d, 0x24, 0x48, 0x18, 0x47, 0xf9, 0xd1, 0x02, 0xc8, 0x48, 0xa6,
+	0x05, 0xf9, 0xd8, 0xa3, 0x8d, 0xb6, 0xe7, 0x9c, 0x4d, 0xfc, 0xef, 0x41, 0xae, 0xde, 0x0f, 0x8c,
+	0x34, 0x3e, 0x49, 0xb0, 0x9e, 0xd2, 0xa6, 0x68, 0x27, 0x91, 0x67, 0xfe, 0x60, 0xc9, 0xbb, 0xbf,
+	0x17, 0x14, 0x25, 0x72, 0x0a, 0x39, 0xde, 0xb6, 0x29, 0x4d, 0x39, 0x31, 0x19, 0xf2, 0xd6, 0x5c,
+	0xcc, 0x98, 0xb4, 0x7e, 0x74, 0x35, 0x52, 0xa4, 0xeb, 0x91, 0x22, 0x7d, 0x1f, 0x29, 0xd2, 0xe7,
+	0x5b, 0x25, 0x73, 0x7d, 0xab, 0x64, 0xbe, 0xdd, 0x2a, 0x99, 0x77, 0x7a, 0xec, 0x19, 0x0a, 0xa8,
+	0x1c, 0xec, 0xe9, 0x82, 0x52, 0xb7, 0x5d, 0xcb, 0xef, 0x62, 0x26, 0x3e, 0x29, 0x74, 0x6f, 0xd8,
+	0xc3, 0xac, 0x95, 0x0b, 0xbf, 0x04, 0x76, 0x7e, 0x0d, 0x00, 0x9e, 0x5e, 0x37, 0x87, 0x94, 0x08,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Random queries the random result
+	Random(ctx context.Context, in *QueryRandomRequest, opts ...grpc.CallOption) (*QueryRandomResponse, error)
+	// RandomRequestQueue queries the random request queue
+	RandomRequestQueue(ctx context.Context, in *QueryRandomRequestQueueRequest, opts ...grpc.CallOption) (*QueryRandomRequestQueueResponse, error)
+	// ConsumerRequests queries the random requests of a consumer with their status
+	ConsumerRequests(ctx context.Context, in *QueryConsumerRequestsRequest, opts ...grpc.CallOption) (*QueryConsumerRequestsResponse, error)
+	// VerifyRandom verifies the VRF proof of a random number
+	VerifyRandom(ctx context.Context, in *QueryVerifyRandomRequest, opts ...grpc.CallOption) (*QueryVerifyRandomResponse, error)
+	// RandomValue queries the random value by the request id and the index
+	RandomValue(ctx context.Context, in *QueryRandomValueRequest, opts ...grpc.CallOption) (*QueryRandomValueResponse, error)
+	// PrunedRandomsCommitment queries the Merkle commitment of the random numbers pruned at the height
+	PrunedRandomsCommitment(ctx context.Context, in *QueryPrunedRandomsCommitmentRequest, opts ...grpc.CallOption) (*QueryPrunedRandomsCommitmentResponse, error)
+	// Params queries the random parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Random(ctx context.Context, in *QueryRandomRequest, opts ...grpc.CallOption) (*QueryRandomResponse, error) {
+	out := new(QueryRandomResponse)
+	err := c.cc.Invoke(ctx, "/irishub.random.Query/Random", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
+	return out, nil
+}
+
+func (c *queryClient) RandomRequestQueue(ctx context.Context, in *QueryRandomRequestQueueRequest, opts ...grpc.CallOption) (*QueryRandomRequestQueueResponse, error) {
+	out := new(QueryRandomRequestQueueResponse)
+	err := c.cc.Invoke(ctx, "/irishub.random.Query/RandomRequestQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConsumerRequests(ctx context.Context, in *QueryConsumerRequestsRequest, opts ...grpc.CallOption) (*QueryConsumerRequestsResponse, error) {
+	out := new(QueryConsumerRequestsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.random.Query/ConsumerRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyRandom(ctx context.Context, in *QueryVerifyRandomRequest, opts ...grpc.CallOption) (*QueryVerifyRandomResponse, error) {
+	out := new(QueryVerifyRandomResponse)
+	err := c.cc.Invoke(ctx, "/irishub.random.Query/VerifyRandom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RandomValue(ctx context.Context, in *QueryRandomValueRequest, opts ...grpc.CallOption) (*QueryRandomValueResponse, error) {
+	out := new(QueryRandomValueResponse)
+	err := c.cc.Invoke(ctx, "/irishub.random.Query/RandomValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PrunedRandomsCommitment(ctx context.Context, in *QueryPrunedRandomsCommitmentRequest, opts ...grpc.CallOption) (*QueryPrunedRandomsCommitmentResponse, error) {
+	out := new(QueryPrunedRandomsCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/irishub.random.Query/PrunedRandomsCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.random.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Random queries the random result
+	Random(context.Context, *QueryRandomRequest) (*QueryRandomResponse, error)
+	// RandomRequestQueue queries the random request queue
+	RandomRequestQueue(context.Context, *QueryRandomRequestQueueRequest) (*QueryRandomRequestQueueResponse, error)
+	// ConsumerRequests queries the random requests of a consumer with their status
+	ConsumerRequests(context.Context, *QueryConsumerRequestsRequest) (*QueryConsumerRequestsResponse, error)
+	// VerifyRandom verifies the VRF proof of a random number
+	VerifyRandom(context.Context, *QueryVerifyRandomRequest) (*QueryVerifyRandomResponse, error)
+	// RandomValue queries the random value by the request id and the index
+	RandomValue(context.Context, *QueryRandomValueRequest) (*QueryRandomValueResponse, error)
+	// PrunedRandomsCommitment queries the Merkle commitment of the random numbers pruned at the height
+	PrunedRandomsCommitment(context.Context, *QueryPrunedRandomsCommitmentRequest) (*QueryPrunedRandomsCommitmentResponse, error)
+	// Params queries the random parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Random(ctx context.Context, req *QueryRandomRequest) (*QueryRandomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Random not implemented")
+}
+func (*UnimplementedQueryServer) RandomRequestQueue(ctx context.Context, req *QueryRandomRequestQueueRequest) (*QueryRandomRequestQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RandomRequestQueue not implemented")
+}
+func (*UnimplementedQueryServer) ConsumerRequests(ctx context.Context, req *QueryConsumerRequestsRequest) (*QueryConsumerRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumerRequests not implemented")
+}
+func (*UnimplementedQueryServer) VerifyRandom(ctx context.Context, req *QueryVerifyRandomRequest) (*QueryVerifyRandomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRandom not implemented")
+}
+func (*UnimplementedQueryServer) RandomValue(ctx context.Context, req *QueryRandomValueRequest) (*QueryRandomValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RandomValue not implemented")
+}
+func (*UnimplementedQueryServer) PrunedRandomsCommitment(ctx context.Context, req *QueryPrunedRandomsCommitmentRequest) (*QueryPrunedRandomsCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrunedRandomsCommitment not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Random_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRandomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Random(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.random.Query/Random",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Random(ctx, req.(*QueryRandomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RandomRequestQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRandomRequestQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RandomRequestQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.random.Query/RandomRequestQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RandomRequestQueue(ctx, req.(*QueryRandomRequestQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsumerRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsumerRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.random.Query/ConsumerRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsumerRequests(ctx, req.(*QueryConsumerRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyRandom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyRandomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyRandom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.random.Query/VerifyRandom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyRandom(ctx, req.(*QueryVerifyRandomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RandomValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRandomValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RandomValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.random.Query/RandomValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RandomValue(ctx, req.(*QueryRandomValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrunedRandomsCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrunedRandomsCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrunedRandomsCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.random.Query/PrunedRandomsCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrunedRandomsCommitment(ctx, req.(*QueryPrunedRandomsCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.random.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irishub.random.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Random",
+			Handler:    _Query_Random_Handler,
+		},
+		{
+			MethodName: "RandomRequestQueue",
+			Handler:    _Query_RandomRequestQueue_Handler,
+		},
+		{
+			MethodName: "ConsumerRequests",
+			Handler:    _Query_ConsumerRequests_Handler,
+		},
+		{
+			MethodName: "VerifyRandom",
+			Handler:    _Query_VerifyRandom_Handler,
+		},
+		{
+			MethodName: "RandomValue",
+			Handler:    _Query_RandomValue_Handler,
+		},
+		{
+			MethodName: "PrunedRandomsCommitment",
+			Handler:    _Query_PrunedRandomsCommitment_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "random/query.proto",
+}
+
+func (m *QueryRandomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRandomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Random != nil {
+		{
+			size, err := m.Random.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRandomValueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomValueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomValueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRandomValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRandomRequestQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomRequestQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomRequestQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRandomRequestQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRandomRequestQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRandomRequestQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyRandomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyRandomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyRandomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyRandomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyRandomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyRandomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VRFPublicKey) > 0 {
+		i -= len(m.VRFPublicKey)
+		copy(dAtA[i:], m.VRFPublicKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VRFPublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Random != nil {
+		{
+			size, err := m.Random.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrunedRandomsCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrunedRandomsCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrunedRandomsCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrunedRandomsCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrunedRandomsCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrunedRandomsCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commitment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRandomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRandomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Random != nil {
+		l = m.Random.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRandomValueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryRandomValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRandomRequestQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryRandomRequestQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyRandomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyRandomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Random != nil {
+		l = m.Random.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VRFPublicKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPrunedRandomsCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryPrunedRandomsCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commitment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRandomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRandomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRandomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRandomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRandomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRandomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Random", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Random == nil {
+				m.Random = &Random{}
+			}
+			if err := m.Random.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRandomValueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRandomValueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRandomValueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRandomValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRandomValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRandomValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRandomRequestQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRandomRequestQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRandomRequestQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRandomRequestQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRandomRequestQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRandomRequestQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, Request{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, ConsumerRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryRandomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyRandomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -587,10 +2559,10 @@ func (m *QueryRandomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRandomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyRandomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRandomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyRandomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -649,7 +2621,7 @@ func (m *QueryRandomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRandomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyRandomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -672,10 +2644,10 @@ func (m *QueryRandomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRandomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyRandomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRandomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyRandomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -714,6 +2686,60 @@ func (m *QueryRandomResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFPublicKey = append(m.VRFPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VRFPublicKey == nil {
+				m.VRFPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -738,7 +2764,7 @@ func (m *QueryRandomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRandomRequestQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPrunedRandomsCommitmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -761,10 +2787,10 @@ func (m *QueryRandomRequestQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRandomRequestQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPrunedRandomsCommitmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRandomRequestQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPrunedRandomsCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -810,7 +2836,7 @@ func (m *QueryRandomRequestQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRandomRequestQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPrunedRandomsCommitmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -833,15 +2859,15 @@ func (m *QueryRandomRequestQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRandomRequestQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPrunedRandomsCommitmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRandomRequestQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPrunedRandomsCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -868,8 +2894,146 @@ func (m *QueryRandomRequestQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, Request{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
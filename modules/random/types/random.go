@@ -8,3 +8,70 @@ func NewRandom(requestTxHash []byte, height int64, value string) Random {
 		Value:         value,
 	}
 }
+
+// NewVRFRandom constructs a Random generated in the VRF mode along with the VRF key it is proved by
+func NewVRFRandom(requestTxHash []byte, height int64, value string, proof []byte, vrfInput []byte, vrfPublicKey []byte) Random {
+	return Random{
+		RequestTxHash: requestTxHash,
+		Height:        height,
+		Value:         value,
+		Proof:         proof,
+		VRFInput:      vrfInput,
+		VRFPublicKey:  vrfPublicKey,
+	}
+}
+
+// NewFailedRandom constructs a Random recording the failure of the request
+func NewFailedRandom(requestTxHash []byte, height int64, reason string) Random {
+	return Random{
+		RequestTxHash: requestTxHash,
+		Height:        height,
+		Status:        StatusFailed,
+		FailureReason: reason,
+	}
+}
+
+// NewConsumerRequest constructs a ConsumerRequest
+func NewConsumerRequest(reqID []byte, request Request, status RequestStatus) ConsumerRequest {
+	return ConsumerRequest{
+		ReqId:   append([]byte{}, reqID...),
+		Request: request,
+		Status:  status,
+	}
+}
+
+// NewPrunedRandom constructs a PrunedRandom
+func NewPrunedRandom(reqID []byte, random Random, values []string) PrunedRandom {
+	return PrunedRandom{
+		ReqId:  reqID,
+		Random: random,
+		Values: values,
+	}
+}
+
+// NewPrunedRandomsCommitment constructs a PrunedRandomsCommitment
+func NewPrunedRandomsCommitment(height int64, root []byte, count uint32) PrunedRandomsCommitment {
+	return PrunedRandomsCommitment{
+		Height: height,
+		Root:   root,
+		Count:  count,
+	}
+}
+
+// NewGenesisRandom constructs a GenesisRandom
+func NewGenesisRandom(reqID []byte, random Random, values []string) GenesisRandom {
+	return GenesisRandom{
+		ReqId:  append([]byte{}, reqID...),
+		Random: random,
+		Values: values,
+	}
+}
+
+// NewRandomExpiry constructs a RandomExpiry
+func NewRandomExpiry(height int64, reqID []byte, consumer []byte) RandomExpiry {
+	return RandomExpiry{
+		Height:   height,
+		ReqId:    append([]byte{}, reqID...),
+		Consumer: append([]byte{}, consumer...),
+	}
+}
@@ -28,12 +28,80 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OutputType defines the type of the requested random values
+type OutputType int32
+
+const (
+	// decimal numbers in [0,1) with the precision of 20 digits
+	Decimal OutputType = 0
+	// integers in the range [range_min,range_max)
+	Integer OutputType = 1
+	// raw 32-byte outputs
+	Raw OutputType = 2
+)
+
+var OutputType_name = map[int32]string{
+	0: "DECIMAL",
+	1: "INTEGER",
+	2: "RAW",
+}
+
+var OutputType_value = map[string]int32{
+	"DECIMAL": 0,
+	"INTEGER": 1,
+	"RAW":     2,
+}
+
+func (x OutputType) String() string {
+	return proto.EnumName(OutputType_name, int32(x))
+}
+
+func (OutputType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{0}
+}
+
+// RequestStatus defines the status of a random request, the fulfilled status is the default
+// so that the random numbers stored before the status was introduced keep their meaning
+type RequestStatus int32
+
+const (
+	// the random number is generated
+	StatusFulfilled RequestStatus = 0
+	// the request failed without generating a random number
+	StatusFailed RequestStatus = 1
+	// the random number is not generated yet
+	StatusPending RequestStatus = 2
+)
+
+var RequestStatus_name = map[int32]string{
+	0: "FULFILLED",
+	1: "FAILED",
+	2: "PENDING",
+}
+
+var RequestStatus_value = map[string]int32{
+	"FULFILLED": 0,
+	"FAILED":    1,
+	"PENDING":   2,
+}
+
+func (x RequestStatus) String() string {
+	return proto.EnumName(RequestStatus_name, int32(x))
+}
+
+func (RequestStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{1}
+}
+
 // MsgRequestRandom defines an sdk.Msg type that supports requesting a random number
 type MsgRequestRandom struct {
 	BlockInterval uint64                                        `protobuf:"varint,1,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty" yaml:"block_interval"`
 	Consumer      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=consumer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"consumer,omitempty"`
 	Oracle        bool                                          `protobuf:"varint,3,opt,name=oracle,proto3" json:"oracle,omitempty"`
 	ServiceFeeCap github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,4,rep,name=service_fee_cap,json=serviceFeeCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"service_fee_cap" yaml:"service_fee_cap"`
+	VRF           bool                                          `protobuf:"varint,5,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	Beacon        bool                                          `protobuf:"varint,6,opt,name=beacon,proto3" json:"beacon,omitempty"`
+	Output        OutputSpec                                    `protobuf:"bytes,7,opt,name=output,proto3" json:"output"`
 }
 
 func (m *MsgRequestRandom) Reset()         { *m = MsgRequestRandom{} }
@@ -97,18 +165,326 @@ func (m *MsgRequestRandom) GetServiceFeeCap() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
+func (m *MsgRequestRandom) GetVRF() bool {
+	if m != nil {
+		return m.VRF
+	}
+	return false
+}
+
+func (m *MsgRequestRandom) GetBeacon() bool {
+	if m != nil {
+		return m.Beacon
+	}
+	return false
+}
+
+func (m *MsgRequestRandom) GetOutput() OutputSpec {
+	if m != nil {
+		return m.Output
+	}
+	return OutputSpec{}
+}
+
+// MsgSubmitVRFProof defines an sdk.Msg type that supports submitting the VRF proof of a random number
+type MsgSubmitVRFProof struct {
+	ReqId  string                                               `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty" yaml:"req_id"`
+	Proof  github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=proof,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"proof,omitempty"`
+	Sender github_com_cosmos_cosmos_sdk_types.AccAddress        `protobuf:"bytes,3,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+}
+
+func (m *MsgSubmitVRFProof) Reset()         { *m = MsgSubmitVRFProof{} }
+func (m *MsgSubmitVRFProof) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitVRFProof) ProtoMessage()    {}
+func (*MsgSubmitVRFProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{1}
+}
+func (m *MsgSubmitVRFProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitVRFProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitVRFProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitVRFProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitVRFProof.Merge(m, src)
+}
+func (m *MsgSubmitVRFProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitVRFProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitVRFProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitVRFProof proto.InternalMessageInfo
+
+func (m *MsgSubmitVRFProof) GetReqId() string {
+	if m != nil {
+		return m.ReqId
+	}
+	return ""
+}
+
+func (m *MsgSubmitVRFProof) GetProof() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *MsgSubmitVRFProof) GetSender() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+// MsgRegisterBeaconParticipant defines an sdk.Msg type that supports registering a beacon participant
+type MsgRegisterBeaconParticipant struct {
+	Participant github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=participant,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"participant,omitempty"`
+	Deposit     github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+}
+
+func (m *MsgRegisterBeaconParticipant) Reset()         { *m = MsgRegisterBeaconParticipant{} }
+func (m *MsgRegisterBeaconParticipant) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBeaconParticipant) ProtoMessage()    {}
+func (*MsgRegisterBeaconParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{2}
+}
+func (m *MsgRegisterBeaconParticipant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBeaconParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBeaconParticipant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterBeaconParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBeaconParticipant.Merge(m, src)
+}
+func (m *MsgRegisterBeaconParticipant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBeaconParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBeaconParticipant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterBeaconParticipant proto.InternalMessageInfo
+
+func (m *MsgRegisterBeaconParticipant) GetParticipant() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Participant
+	}
+	return nil
+}
+
+func (m *MsgRegisterBeaconParticipant) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+// MsgUnregisterBeaconParticipant defines an sdk.Msg type that supports unregistering a beacon participant
+type MsgUnregisterBeaconParticipant struct {
+	Participant github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=participant,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"participant,omitempty"`
+}
+
+func (m *MsgUnregisterBeaconParticipant) Reset()         { *m = MsgUnregisterBeaconParticipant{} }
+func (m *MsgUnregisterBeaconParticipant) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterBeaconParticipant) ProtoMessage()    {}
+func (*MsgUnregisterBeaconParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{3}
+}
+func (m *MsgUnregisterBeaconParticipant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterBeaconParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterBeaconParticipant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnregisterBeaconParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterBeaconParticipant.Merge(m, src)
+}
+func (m *MsgUnregisterBeaconParticipant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterBeaconParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterBeaconParticipant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnregisterBeaconParticipant proto.InternalMessageInfo
+
+func (m *MsgUnregisterBeaconParticipant) GetParticipant() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Participant
+	}
+	return nil
+}
+
+// MsgCommitBeacon defines an sdk.Msg type that supports committing the hash of a secret to a beacon round
+type MsgCommitBeacon struct {
+	ReqId       string                                               `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty" yaml:"req_id"`
+	Commitment  github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=commitment,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"commitment,omitempty"`
+	Participant github_com_cosmos_cosmos_sdk_types.AccAddress        `protobuf:"bytes,3,opt,name=participant,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"participant,omitempty"`
+}
+
+func (m *MsgCommitBeacon) Reset()         { *m = MsgCommitBeacon{} }
+func (m *MsgCommitBeacon) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitBeacon) ProtoMessage()    {}
+func (*MsgCommitBeacon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{4}
+}
+func (m *MsgCommitBeacon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitBeacon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitBeacon.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitBeacon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitBeacon.Merge(m, src)
+}
+func (m *MsgCommitBeacon) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitBeacon) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitBeacon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitBeacon proto.InternalMessageInfo
+
+func (m *MsgCommitBeacon) GetReqId() string {
+	if m != nil {
+		return m.ReqId
+	}
+	return ""
+}
+
+func (m *MsgCommitBeacon) GetCommitment() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *MsgCommitBeacon) GetParticipant() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Participant
+	}
+	return nil
+}
+
+// MsgRevealBeacon defines an sdk.Msg type that supports revealing the committed secret of a beacon round
+type MsgRevealBeacon struct {
+	ReqId       string                                               `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty" yaml:"req_id"`
+	Secret      github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=secret,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"secret,omitempty"`
+	Participant github_com_cosmos_cosmos_sdk_types.AccAddress        `protobuf:"bytes,3,opt,name=participant,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"participant,omitempty"`
+}
+
+func (m *MsgRevealBeacon) Reset()         { *m = MsgRevealBeacon{} }
+func (m *MsgRevealBeacon) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealBeacon) ProtoMessage()    {}
+func (*MsgRevealBeacon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{5}
+}
+func (m *MsgRevealBeacon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealBeacon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealBeacon.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealBeacon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealBeacon.Merge(m, src)
+}
+func (m *MsgRevealBeacon) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealBeacon) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealBeacon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealBeacon proto.InternalMessageInfo
+
+func (m *MsgRevealBeacon) GetReqId() string {
+	if m != nil {
+		return m.ReqId
+	}
+	return ""
+}
+
+func (m *MsgRevealBeacon) GetSecret() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *MsgRevealBeacon) GetParticipant() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Participant
+	}
+	return nil
+}
+
 // Random defines the feed standard
 type Random struct {
 	RequestTxHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=request_tx_hash,json=requestTxHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"request_tx_hash,omitempty" yaml:"request_tx_hash"`
 	Height        int64                                                `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	Value         string                                               `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Proof         github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,4,opt,name=proof,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"proof,omitempty"`
+	VRFInput      github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=vrf_input,json=vrfInput,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"vrf_input,omitempty" yaml:"vrf_input"`
+	Output        OutputSpec                                           `protobuf:"bytes,6,opt,name=output,proto3" json:"output"`
+	Status        RequestStatus                                        `protobuf:"varint,7,opt,name=status,proto3,enum=irishub.random.RequestStatus" json:"status,omitempty"`
+	FailureReason string                                               `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty" yaml:"failure_reason"`
+	VRFPublicKey  github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,9,opt,name=vrf_public_key,json=vrfPublicKey,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"vrf_public_key,omitempty" yaml:"vrf_public_key"`
 }
 
 func (m *Random) Reset()         { *m = Random{} }
 func (m *Random) String() string { return proto.CompactTextString(m) }
 func (*Random) ProtoMessage()    {}
 func (*Random) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5da2919a686585f, []int{1}
+	return fileDescriptor_e5da2919a686585f, []int{6}
 }
 func (m *Random) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,21 +534,73 @@ func (m *Random) GetValue() string {
 	return ""
 }
 
+func (m *Random) GetProof() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *Random) GetVRFInput() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.VRFInput
+	}
+	return nil
+}
+
+func (m *Random) GetOutput() OutputSpec {
+	if m != nil {
+		return m.Output
+	}
+	return OutputSpec{}
+}
+
+func (m *Random) GetStatus() RequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StatusFulfilled
+}
+
+func (m *Random) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *Random) GetVRFPublicKey() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.VRFPublicKey
+	}
+	return nil
+}
+
 // Request defines the random request standard
 type Request struct {
-	Height           int64                                                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Consumer         github_com_cosmos_cosmos_sdk_types.AccAddress        `protobuf:"bytes,2,opt,name=consumer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"consumer,omitempty"`
-	TxHash           github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"tx_hash,omitempty" yaml:"tx_hash"`
-	Oracle           bool                                                 `protobuf:"varint,4,opt,name=oracle,proto3" json:"oracle,omitempty"`
-	ServiceFeeCap    github_com_cosmos_cosmos_sdk_types.Coins             `protobuf:"bytes,5,rep,name=service_fee_cap,json=serviceFeeCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"service_fee_cap" yaml:"service_fee_cap"`
-	ServiceContextID github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=service_context_id,json=serviceContextId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"service_context_id,omitempty" yaml:"service_context_id"`
+	Height              int64                                                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Consumer            github_com_cosmos_cosmos_sdk_types.AccAddress        `protobuf:"bytes,2,opt,name=consumer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"consumer,omitempty"`
+	TxHash              github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	Oracle              bool                                                 `protobuf:"varint,4,opt,name=oracle,proto3" json:"oracle,omitempty"`
+	ServiceFeeCap       github_com_cosmos_cosmos_sdk_types.Coins             `protobuf:"bytes,5,rep,name=service_fee_cap,json=serviceFeeCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"service_fee_cap" yaml:"service_fee_cap"`
+	ServiceContextID    github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,6,opt,name=service_context_id,json=serviceContextId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"service_context_id,omitempty" yaml:"service_context_id"`
+	VRF                 bool                                                 `protobuf:"varint,7,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	VRFInput            github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,8,opt,name=vrf_input,json=vrfInput,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"vrf_input,omitempty" yaml:"vrf_input"`
+	Beacon              bool                                                 `protobuf:"varint,9,opt,name=beacon,proto3" json:"beacon,omitempty"`
+	CommitEndHeight     int64                                                `protobuf:"varint,10,opt,name=commit_end_height,json=commitEndHeight,proto3" json:"commit_end_height,omitempty" yaml:"commit_end_height"`
+	RevealEndHeight     int64                                                `protobuf:"varint,11,opt,name=reveal_end_height,json=revealEndHeight,proto3" json:"reveal_end_height,omitempty" yaml:"reveal_end_height"`
+	ModuleName          string                                               `protobuf:"bytes,12,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty" yaml:"module_name"`
+	Output              OutputSpec                                           `protobuf:"bytes,13,opt,name=output,proto3" json:"output"`
+	RequestFee          github_com_cosmos_cosmos_sdk_types.Coins             `protobuf:"bytes,14,rep,name=request_fee,json=requestFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"request_fee" yaml:"request_fee"`
+	Nonce               uint64                                               `protobuf:"varint,15,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	VRFExpirationHeight int64                                                `protobuf:"varint,16,opt,name=vrf_expiration_height,json=vrfExpirationHeight,proto3" json:"vrf_expiration_height,omitempty" yaml:"vrf_expiration_height"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5da2919a686585f, []int{2}
+	return fileDescriptor_e5da2919a686585f, []int{7}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,324 +671,4838 @@ func (m *Request) GetServiceContextID() github_com_tendermint_tendermint_libs_by
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgRequestRandom)(nil), "irishub.random.MsgRequestRandom")
-	proto.RegisterType((*Random)(nil), "irishub.random.Random")
-	proto.RegisterType((*Request)(nil), "irishub.random.Request")
-}
-
-func init() { proto.RegisterFile("random/random.proto", fileDescriptor_e5da2919a686585f) }
-
-var fileDescriptor_e5da2919a686585f = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0xe3, 0x26, 0x4d, 0xcb, 0xd1, 0x37, 0x99, 0xb6, 0x24, 0x1d, 0xec, 0xc8, 0x53, 0x96,
-	0xda, 0x0a, 0x30, 0x31, 0x51, 0x07, 0xa1, 0xa6, 0x52, 0x17, 0xc3, 0xc4, 0x62, 0x9d, 0xcf, 0x0f,
-	0xf1, 0xa9, 0xb6, 0x2f, 0xf8, 0x2e, 0x51, 0xc2, 0x77, 0x40, 0x62, 0xeb, 0x77, 0x60, 0xe4, 0x53,
-	0x54, 0x62, 0xe9, 0xc8, 0x64, 0x50, 0xf2, 0x0d, 0x32, 0x76, 0x42, 0xf6, 0x5d, 0xdb, 0x24, 0x0b,
-	0x08, 0x90, 0x98, 0xee, 0x9e, 0xfb, 0x3f, 0x2f, 0xf7, 0xfc, 0xee, 0xf4, 0xa0, 0x47, 0x19, 0x4e,
-	0x43, 0x96, 0x38, 0x72, 0xb1, 0x07, 0x19, 0x13, 0x4c, 0xdf, 0xa1, 0x19, 0xe5, 0xd1, 0x30, 0xb0,
-	0xe5, 0xe9, 0xd1, 0x7e, 0x9f, 0xf5, 0x59, 0x29, 0x39, 0xc5, 0x4e, 0x7a, 0x1d, 0x1d, 0x14, 0x5e,
-	0x09, 0x0b, 0x1d, 0x0e, 0xd9, 0x88, 0x12, 0x50, 0xc7, 0x4d, 0xc2, 0x78, 0xc2, 0xb8, 0x2f, 0xfd,
-	0xa5, 0xa1, 0xa4, 0xc7, 0x2b, 0x12, 0x4d, 0xa5, 0x60, 0x7d, 0x5d, 0x43, 0x7b, 0xe7, 0xbc, 0xef,
-	0xc1, 0xfb, 0x21, 0x70, 0xe1, 0x95, 0x55, 0xf5, 0x17, 0x68, 0x27, 0x88, 0x19, 0xb9, 0xf0, 0x69,
-	0x2a, 0x20, 0x1b, 0xe1, 0xb8, 0xa1, 0xb5, 0xb4, 0x76, 0xcd, 0x6d, 0xce, 0x73, 0xf3, 0x60, 0x82,
-	0x93, 0xf8, 0xb9, 0xb5, 0xac, 0x5b, 0xde, 0x76, 0x79, 0xd0, 0x53, 0xb6, 0x7e, 0x8e, 0x36, 0x09,
-	0x4b, 0xf9, 0x30, 0x81, 0xac, 0xb1, 0xd6, 0xd2, 0xda, 0x5b, 0x6e, 0xe7, 0x26, 0x37, 0x8f, 0xfb,
-	0x54, 0x14, 0xcd, 0x11, 0x96, 0xa8, 0xeb, 0xa9, 0xe5, 0x98, 0x87, 0x17, 0x8e, 0x98, 0x0c, 0x80,
-	0xdb, 0x27, 0x84, 0x9c, 0x84, 0x61, 0x06, 0x9c, 0x7b, 0x77, 0x29, 0xf4, 0x43, 0x54, 0x67, 0x19,
-	0x26, 0x31, 0x34, 0xaa, 0x2d, 0xad, 0xbd, 0xe9, 0x29, 0x4b, 0xff, 0xa8, 0xa1, 0x5d, 0xc5, 0xc0,
-	0x7f, 0x07, 0xe0, 0x13, 0x3c, 0x68, 0xd4, 0x5a, 0xd5, 0xf6, 0xc3, 0x27, 0x4d, 0x5b, 0xf5, 0x1f,
-	0x60, 0x0e, 0xf6, 0xa8, 0x13, 0x80, 0xc0, 0x1d, 0xbb, 0xcb, 0x68, 0xea, 0x9e, 0x5d, 0xe5, 0x66,
-	0x65, 0x9e, 0x9b, 0x87, 0xb2, 0x93, 0x95, 0x78, 0xeb, 0xf3, 0x77, 0xb3, 0xfd, 0x1b, 0xf7, 0x2c,
-	0x52, 0x71, 0x6f, 0x5b, 0x45, 0xbf, 0x02, 0xe8, 0xe2, 0x81, 0xf5, 0x45, 0x43, 0x75, 0xc5, 0xf0,
-	0x03, 0xda, 0xcd, 0x24, 0x54, 0x5f, 0x8c, 0xfd, 0x08, 0xf3, 0xa8, 0x84, 0xb8, 0xe5, 0x7a, 0xf7,
-	0xa5, 0x57, 0x1c, 0xac, 0x9b, 0xdc, 0x7c, 0xb6, 0x50, 0x5a, 0x40, 0x1a, 0x42, 0x96, 0xd0, 0x54,
-	0x2c, 0x6e, 0x63, 0x1a, 0x70, 0x27, 0x98, 0x08, 0xe0, 0xf6, 0x29, 0x8c, 0xdd, 0x62, 0xe3, 0x6d,
-	0xab, 0x4c, 0x6f, 0xc6, 0xa7, 0x98, 0x47, 0x05, 0xae, 0x08, 0x68, 0x3f, 0x12, 0x25, 0xfb, 0xaa,
-	0xa7, 0x2c, 0x7d, 0x1f, 0xad, 0x8f, 0x70, 0x3c, 0x94, 0x14, 0x1f, 0x78, 0xd2, 0xb0, 0x2e, 0x6b,
-	0x68, 0x43, 0xbd, 0xff, 0x42, 0xa4, 0xb6, 0x14, 0xf9, 0x8f, 0xdf, 0x93, 0xa0, 0x8d, 0x5b, 0x28,
-	0xd5, 0x32, 0xdb, 0xd9, 0x3c, 0x37, 0x77, 0x24, 0x94, 0xbf, 0x86, 0x51, 0x17, 0x77, 0x14, 0xd4,
-	0xa7, 0xa9, 0xfd, 0xf2, 0xd3, 0xac, 0xff, 0xbf, 0x4f, 0xa3, 0x5f, 0x6a, 0x48, 0xbf, 0xcd, 0x47,
-	0x58, 0x2a, 0x60, 0x2c, 0x7c, 0x1a, 0x36, 0xea, 0x25, 0x18, 0x3a, 0xcd, 0xcd, 0xbd, 0xd7, 0x52,
-	0xed, 0x4a, 0xb1, 0xf7, 0x72, 0x9e, 0x9b, 0xcd, 0xe5, 0x7b, 0xdc, 0xc7, 0xfd, 0x39, 0xb7, 0x3d,
-	0xbe, 0x5c, 0x26, 0x74, 0x7b, 0x57, 0x53, 0x43, 0xbb, 0x9e, 0x1a, 0xda, 0x8f, 0xa9, 0xa1, 0x7d,
-	0x9a, 0x19, 0x95, 0xeb, 0x99, 0x51, 0xf9, 0x36, 0x33, 0x2a, 0x6f, 0x9d, 0x85, 0x0a, 0xc5, 0x30,
-	0x4a, 0x41, 0x38, 0x6a, 0x74, 0x39, 0x09, 0x0b, 0x87, 0x31, 0x70, 0x35, 0xd8, 0x64, 0xe7, 0x41,
-	0xbd, 0x1c, 0x37, 0x4f, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xba, 0x01, 0xe2, 0xf6, 0x04,
-	0x00, 0x00,
+func (m *Request) GetVRF() bool {
+	if m != nil {
+		return m.VRF
+	}
+	return false
 }
 
-func (m *MsgRequestRandom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Request) GetVRFInput() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.VRFInput
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgRequestRandom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Request) GetBeacon() bool {
+	if m != nil {
+		return m.Beacon
+	}
+	return false
 }
 
-func (m *MsgRequestRandom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceFeeCap) > 0 {
-		for iNdEx := len(m.ServiceFeeCap) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ServiceFeeCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRandom(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.Oracle {
-		i--
-		if m.Oracle {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Consumer) > 0 {
-		i -= len(m.Consumer)
-		copy(dAtA[i:], m.Consumer)
-		i = encodeVarintRandom(dAtA, i, uint64(len(m.Consumer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BlockInterval != 0 {
-		i = encodeVarintRandom(dAtA, i, uint64(m.BlockInterval))
-		i--
-		dAtA[i] = 0x8
+func (m *Request) GetCommitEndHeight() int64 {
+	if m != nil {
+		return m.CommitEndHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Random) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Request) GetRevealEndHeight() int64 {
+	if m != nil {
+		return m.RevealEndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Random) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Request) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
 }
 
-func (m *Random) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintRandom(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x1a
+func (m *Request) GetOutput() OutputSpec {
+	if m != nil {
+		return m.Output
 	}
-	if m.Height != 0 {
-		i = encodeVarintRandom(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	return OutputSpec{}
+}
+
+func (m *Request) GetRequestFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RequestFee
 	}
-	if len(m.RequestTxHash) > 0 {
-		i -= len(m.RequestTxHash)
-		copy(dAtA[i:], m.RequestTxHash)
-		i = encodeVarintRandom(dAtA, i, uint64(len(m.RequestTxHash)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Request) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Request) GetVRFExpirationHeight() int64 {
+	if m != nil {
+		return m.VRFExpirationHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerRequest defines a random request of a consumer along with its status
+type ConsumerRequest struct {
+	ReqId   github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"req_id,omitempty" yaml:"req_id"`
+	Request Request                                              `protobuf:"bytes,2,opt,name=request,proto3" json:"request"`
+	Status  RequestStatus                                        `protobuf:"varint,3,opt,name=status,proto3,enum=irishub.random.RequestStatus" json:"status,omitempty"`
 }
 
-func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServiceContextID) > 0 {
-		i -= len(m.ServiceContextID)
-		copy(dAtA[i:], m.ServiceContextID)
-		i = encodeVarintRandom(dAtA, i, uint64(len(m.ServiceContextID)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ServiceFeeCap) > 0 {
-		for iNdEx := len(m.ServiceFeeCap) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ServiceFeeCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRandom(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.Oracle {
-		i--
-		if m.Oracle {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ConsumerRequest) Reset()         { *m = ConsumerRequest{} }
+func (m *ConsumerRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRequest) ProtoMessage()    {}
+func (*ConsumerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{8}
+}
+func (m *ConsumerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintRandom(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Consumer) > 0 {
-		i -= len(m.Consumer)
-		copy(dAtA[i:], m.Consumer)
-		i = encodeVarintRandom(dAtA, i, uint64(len(m.Consumer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Height != 0 {
-		i = encodeVarintRandom(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRequest.Merge(m, src)
+}
+func (m *ConsumerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRequest.DiscardUnknown(m)
 }
 
-func encodeVarintRandom(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRandom(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ConsumerRequest proto.InternalMessageInfo
+
+func (m *ConsumerRequest) GetReqId() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.ReqId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgRequestRandom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockInterval != 0 {
-		n += 1 + sovRandom(uint64(m.BlockInterval))
-	}
-	l = len(m.Consumer)
-	if l > 0 {
-		n += 1 + l + sovRandom(uint64(l))
+
+func (m *ConsumerRequest) GetRequest() Request {
+	if m != nil {
+		return m.Request
 	}
-	if m.Oracle {
-		n += 2
+	return Request{}
+}
+
+func (m *ConsumerRequest) GetStatus() RequestStatus {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.ServiceFeeCap) > 0 {
-		for _, e := range m.ServiceFeeCap {
-			l = e.Size()
-			n += 1 + l + sovRandom(uint64(l))
+	return StatusFulfilled
+}
+
+// PrunedRandom defines the leaf of the Merkle commitment of a pruned random number
+type PrunedRandom struct {
+	ReqId  github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"req_id,omitempty" yaml:"req_id"`
+	Random Random                                               `protobuf:"bytes,2,opt,name=random,proto3" json:"random"`
+	Values []string                                             `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *PrunedRandom) Reset()         { *m = PrunedRandom{} }
+func (m *PrunedRandom) String() string { return proto.CompactTextString(m) }
+func (*PrunedRandom) ProtoMessage()    {}
+func (*PrunedRandom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{9}
+}
+func (m *PrunedRandom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrunedRandom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrunedRandom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *PrunedRandom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrunedRandom.Merge(m, src)
+}
+func (m *PrunedRandom) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrunedRandom) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrunedRandom.DiscardUnknown(m)
 }
 
-func (m *Random) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RequestTxHash)
-	if l > 0 {
-		n += 1 + l + sovRandom(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovRandom(uint64(m.Height))
+var xxx_messageInfo_PrunedRandom proto.InternalMessageInfo
+
+func (m *PrunedRandom) GetReqId() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.ReqId
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovRandom(uint64(l))
+	return nil
+}
+
+func (m *PrunedRandom) GetRandom() Random {
+	if m != nil {
+		return m.Random
 	}
-	return n
+	return Random{}
 }
 
-func (m *Request) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PrunedRandom) GetValues() []string {
+	if m != nil {
+		return m.Values
 	}
-	var l int
+	return nil
+}
+
+// PrunedRandomsCommitment defines the Merkle commitment of the random numbers pruned in a block
+type PrunedRandomsCommitment struct {
+	Height int64                                                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Root   github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=root,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"root,omitempty"`
+	Count  uint32                                               `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *PrunedRandomsCommitment) Reset()         { *m = PrunedRandomsCommitment{} }
+func (m *PrunedRandomsCommitment) String() string { return proto.CompactTextString(m) }
+func (*PrunedRandomsCommitment) ProtoMessage()    {}
+func (*PrunedRandomsCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{10}
+}
+func (m *PrunedRandomsCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrunedRandomsCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrunedRandomsCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrunedRandomsCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrunedRandomsCommitment.Merge(m, src)
+}
+func (m *PrunedRandomsCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrunedRandomsCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrunedRandomsCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrunedRandomsCommitment proto.InternalMessageInfo
+
+func (m *PrunedRandomsCommitment) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PrunedRandomsCommitment) GetRoot() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *PrunedRandomsCommitment) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// OutputSpec defines the number and the type of the requested random values
+type OutputSpec struct {
+	Type     OutputType `protobuf:"varint,1,opt,name=type,proto3,enum=irishub.random.OutputType" json:"type,omitempty"`
+	Count    uint32     `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	RangeMin uint64     `protobuf:"varint,3,opt,name=range_min,json=rangeMin,proto3" json:"range_min,omitempty" yaml:"range_min"`
+	RangeMax uint64     `protobuf:"varint,4,opt,name=range_max,json=rangeMax,proto3" json:"range_max,omitempty" yaml:"range_max"`
+}
+
+func (m *OutputSpec) Reset()         { *m = OutputSpec{} }
+func (m *OutputSpec) String() string { return proto.CompactTextString(m) }
+func (*OutputSpec) ProtoMessage()    {}
+func (*OutputSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{11}
+}
+func (m *OutputSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutputSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutputSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutputSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputSpec.Merge(m, src)
+}
+func (m *OutputSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutputSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutputSpec proto.InternalMessageInfo
+
+func (m *OutputSpec) GetType() OutputType {
+	if m != nil {
+		return m.Type
+	}
+	return Decimal
+}
+
+func (m *OutputSpec) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *OutputSpec) GetRangeMin() uint64 {
+	if m != nil {
+		return m.RangeMin
+	}
+	return 0
+}
+
+func (m *OutputSpec) GetRangeMax() uint64 {
+	if m != nil {
+		return m.RangeMax
+	}
+	return 0
+}
+
+// BeaconParticipant defines a registered participant of the beacon rounds
+type BeaconParticipant struct {
+	Address        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	Deposit        github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	PendingCommits uint64                                        `protobuf:"varint,3,opt,name=pending_commits,json=pendingCommits,proto3" json:"pending_commits,omitempty" yaml:"pending_commits"`
+}
+
+func (m *BeaconParticipant) Reset()         { *m = BeaconParticipant{} }
+func (m *BeaconParticipant) String() string { return proto.CompactTextString(m) }
+func (*BeaconParticipant) ProtoMessage()    {}
+func (*BeaconParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{12}
+}
+func (m *BeaconParticipant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconParticipant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconParticipant.Merge(m, src)
+}
+func (m *BeaconParticipant) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconParticipant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconParticipant proto.InternalMessageInfo
+
+func (m *BeaconParticipant) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *BeaconParticipant) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *BeaconParticipant) GetPendingCommits() uint64 {
+	if m != nil {
+		return m.PendingCommits
+	}
+	return 0
+}
+
+// BeaconCommit defines the commitment of a participant to a beacon round
+type BeaconCommit struct {
+	ReqId       github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"req_id,omitempty" yaml:"req_id"`
+	Participant github_com_cosmos_cosmos_sdk_types.AccAddress        `protobuf:"bytes,2,opt,name=participant,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"participant,omitempty"`
+	Commitment  github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=commitment,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"commitment,omitempty"`
+	Secret      github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,4,opt,name=secret,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"secret,omitempty"`
+}
+
+func (m *BeaconCommit) Reset()         { *m = BeaconCommit{} }
+func (m *BeaconCommit) String() string { return proto.CompactTextString(m) }
+func (*BeaconCommit) ProtoMessage()    {}
+func (*BeaconCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{13}
+}
+func (m *BeaconCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconCommit.Merge(m, src)
+}
+func (m *BeaconCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconCommit proto.InternalMessageInfo
+
+func (m *BeaconCommit) GetReqId() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.ReqId
+	}
+	return nil
+}
+
+func (m *BeaconCommit) GetParticipant() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Participant
+	}
+	return nil
+}
+
+func (m *BeaconCommit) GetCommitment() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *BeaconCommit) GetSecret() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+// Params defines the parameters of the random module
+type Params struct {
+	// minimal block interval of a random request
+	MinBlockInterval uint64 `protobuf:"varint,1,opt,name=min_block_interval,json=minBlockInterval,proto3" json:"min_block_interval,omitempty" yaml:"min_block_interval"`
+	// maximal block interval of a random request
+	MaxBlockInterval uint64 `protobuf:"varint,2,opt,name=max_block_interval,json=maxBlockInterval,proto3" json:"max_block_interval,omitempty" yaml:"max_block_interval"`
+	// fee paid by the consumer for each random request
+	RequestFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=request_fee,json=requestFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"request_fee" yaml:"request_fee"`
+	// destination of the request fee, either the fee collector or the community pool
+	FeeDestination string `protobuf:"bytes,4,opt,name=fee_destination,json=feeDestination,proto3" json:"fee_destination,omitempty" yaml:"fee_destination"`
+	// maximal number of random requests in a block
+	MaxRequestsPerBlock uint64 `protobuf:"varint,5,opt,name=max_requests_per_block,json=maxRequestsPerBlock,proto3" json:"max_requests_per_block,omitempty" yaml:"max_requests_per_block"`
+	// number of providers requested for the oracle seeds
+	OracleProviders uint32 `protobuf:"varint,6,opt,name=oracle_providers,json=oracleProviders,proto3" json:"oracle_providers,omitempty" yaml:"oracle_providers"`
+	// minimal number of oracle seeds required to generate the random number
+	OracleThreshold uint32 `protobuf:"varint,7,opt,name=oracle_threshold,json=oracleThreshold,proto3" json:"oracle_threshold,omitempty" yaml:"oracle_threshold"`
+	// method to combine the oracle seeds, only hash is supported
+	SeedCombination string `protobuf:"bytes,8,opt,name=seed_combination,json=seedCombination,proto3" json:"seed_combination,omitempty" yaml:"seed_combination"`
+	// number of blocks for which a generated random number is kept, 0 to keep forever
+	RandomRetentionBlocks uint64 `protobuf:"varint,9,opt,name=random_retention_blocks,json=randomRetentionBlocks,proto3" json:"random_retention_blocks,omitempty" yaml:"random_retention_blocks"`
+	// number of blocks in which the proof of a VRF request must be submitted
+	VRFProofTimeout uint64 `protobuf:"varint,10,opt,name=vrf_proof_timeout,json=vrfProofTimeout,proto3" json:"vrf_proof_timeout,omitempty" yaml:"vrf_proof_timeout"`
+	// minimal deposit of a beacon participant to commit to the beacon rounds
+	BeaconMinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=beacon_min_deposit,json=beaconMinDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"beacon_min_deposit" yaml:"beacon_min_deposit"`
+	// fraction of the deposit slashed for a missing reveal
+	BeaconSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=beacon_slash_fraction,json=beaconSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"beacon_slash_fraction" yaml:"beacon_slash_fraction"`
+	// number of blocks in which the commitments of a beacon round are accepted
+	BeaconCommitWindow uint64 `protobuf:"varint,13,opt,name=beacon_commit_window,json=beaconCommitWindow,proto3" json:"beacon_commit_window,omitempty" yaml:"beacon_commit_window"`
+	// number of blocks in which the secrets of a beacon round are revealed
+	BeaconRevealWindow uint64 `protobuf:"varint,14,opt,name=beacon_reveal_window,json=beaconRevealWindow,proto3" json:"beacon_reveal_window,omitempty" yaml:"beacon_reveal_window"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{14}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinBlockInterval() uint64 {
+	if m != nil {
+		return m.MinBlockInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlockInterval() uint64 {
+	if m != nil {
+		return m.MaxBlockInterval
+	}
+	return 0
+}
+
+func (m *Params) GetRequestFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RequestFee
+	}
+	return nil
+}
+
+func (m *Params) GetFeeDestination() string {
+	if m != nil {
+		return m.FeeDestination
+	}
+	return ""
+}
+
+func (m *Params) GetMaxRequestsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRequestsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetOracleProviders() uint32 {
+	if m != nil {
+		return m.OracleProviders
+	}
+	return 0
+}
+
+func (m *Params) GetOracleThreshold() uint32 {
+	if m != nil {
+		return m.OracleThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetSeedCombination() string {
+	if m != nil {
+		return m.SeedCombination
+	}
+	return ""
+}
+
+func (m *Params) GetRandomRetentionBlocks() uint64 {
+	if m != nil {
+		return m.RandomRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetVRFProofTimeout() uint64 {
+	if m != nil {
+		return m.VRFProofTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetBeaconMinDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BeaconMinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetBeaconCommitWindow() uint64 {
+	if m != nil {
+		return m.BeaconCommitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetBeaconRevealWindow() uint64 {
+	if m != nil {
+		return m.BeaconRevealWindow
+	}
+	return 0
+}
+
+// UpdateVRFPublicKeyProposal defines a governance proposal to designate a new VRF public key
+type UpdateVRFPublicKeyProposal struct {
+	Title       string                                               `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                               `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PublicKey   github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"public_key,omitempty" yaml:"public_key"`
+}
+
+func (m *UpdateVRFPublicKeyProposal) Reset()      { *m = UpdateVRFPublicKeyProposal{} }
+func (*UpdateVRFPublicKeyProposal) ProtoMessage() {}
+func (*UpdateVRFPublicKeyProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5da2919a686585f, []int{15}
+}
+func (m *UpdateVRFPublicKeyProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateVRFPublicKeyProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateVRFPublicKeyProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateVRFPublicKeyProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateVRFPublicKeyProposal.Merge(m, src)
+}
+func (m *UpdateVRFPublicKeyProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateVRFPublicKeyProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateVRFPublicKeyProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateVRFPublicKeyProposal proto.InternalMessageInfo
+
+func (m *UpdateVRFPublicKeyProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateVRFPublicKeyProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateVRFPublicKeyProposal) GetPublicKey() github_com_tendermint_tendermint_libs_bytes.HexBytes {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("irishub.random.OutputType", OutputType_name, OutputType_value)
+	proto.RegisterEnum("irishub.random.RequestStatus", RequestStatus_name, RequestStatus_value)
+	proto.RegisterType((*MsgRequestRandom)(nil), "irishub.random.MsgRequestRandom")
+	proto.RegisterType((*MsgSubmitVRFProof)(nil), "irishub.random.MsgSubmitVRFProof")
+	proto.RegisterType((*MsgRegisterBeaconParticipant)(nil), "irishub.random.MsgRegisterBeaconParticipant")
+	proto.RegisterType((*MsgUnregisterBeaconParticipant)(nil), "irishub.random.MsgUnregisterBeaconParticipant")
+	proto.RegisterType((*MsgCommitBeacon)(nil), "irishub.random.MsgCommitBeacon")
+	proto.RegisterType((*MsgRevealBeacon)(nil), "irishub.random.MsgRevealBeacon")
+	proto.RegisterType((*Random)(nil), "irishub.random.Random")
+	proto.RegisterType((*Request)(nil), "irishub.random.Request")
+	proto.RegisterType((*ConsumerRequest)(nil), "irishub.random.ConsumerRequest")
+	proto.RegisterType((*PrunedRandom)(nil), "irishub.random.PrunedRandom")
+	proto.RegisterType((*PrunedRandomsCommitment)(nil), "irishub.random.PrunedRandomsCommitment")
+	proto.RegisterType((*OutputSpec)(nil), "irishub.random.OutputSpec")
+	proto.RegisterType((*BeaconParticipant)(nil), "irishub.random.BeaconParticipant")
+	proto.RegisterType((*BeaconCommit)(nil), "irishub.random.BeaconCommit")
+	proto.RegisterType((*Params)(nil), "irishub.random.Params")
+	proto.RegisterType((*UpdateVRFPublicKeyProposal)(nil), "irishub.random.UpdateVRFPublicKeyProposal")
+}
+
+func init() { proto.RegisterFile("random/random.proto", fileDescriptor_e5da2919a686585f) }
+
+var fileDescriptor_e5da2919a686585f = []byte{
+	// 2065 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0xe3, 0xc6,
+	0x15, 0x37, 0x25, 0x59, 0xb2, 0x46, 0xd6, 0x87, 0xe9, 0xfd, 0x90, 0x95, 0x5d, 0x51, 0xe5, 0xa1,
+	0x30, 0x02, 0x44, 0xee, 0x6e, 0x53, 0x6c, 0x90, 0x53, 0x2d, 0xd9, 0x8a, 0x95, 0xb5, 0x5c, 0x75,
+	0xec, 0x75, 0x16, 0xe9, 0x81, 0x1d, 0x91, 0x23, 0x99, 0x58, 0x91, 0xd4, 0x72, 0x28, 0xad, 0xdc,
+	0x63, 0x2e, 0x2d, 0x8c, 0x16, 0xe8, 0xa5, 0x1f, 0x17, 0xa3, 0x05, 0x7a, 0xeb, 0x1f, 0x50, 0xe4,
+	0xd4, 0xf3, 0x1e, 0xf7, 0x18, 0xe4, 0xc0, 0x16, 0xda, 0x5b, 0x6f, 0xd5, 0x31, 0xa7, 0x60, 0x3e,
+	0x24, 0x52, 0x92, 0x8d, 0x8d, 0xbd, 0xce, 0xe6, 0x64, 0xcd, 0x7b, 0x6f, 0x7e, 0x9c, 0xf7, 0xf8,
+	0xe6, 0xbd, 0xdf, 0xa3, 0xc1, 0xba, 0x8b, 0x6c, 0xc3, 0xb1, 0xb6, 0xf8, 0x9f, 0x72, 0xcf, 0x75,
+	0x3c, 0x47, 0xce, 0x98, 0xae, 0x49, 0x4e, 0xfa, 0xad, 0x32, 0x97, 0x16, 0x6e, 0x75, 0x9c, 0x8e,
+	0xc3, 0x54, 0x5b, 0xf4, 0x17, 0xb7, 0x2a, 0xdc, 0xa6, 0x56, 0x96, 0x63, 0x6c, 0x11, 0xec, 0x0e,
+	0x4c, 0x1d, 0x0b, 0xf1, 0x86, 0xee, 0x10, 0xcb, 0x21, 0x1a, 0xb7, 0xe7, 0x0b, 0xa1, 0xba, 0x3b,
+	0xa7, 0x32, 0x6d, 0xae, 0x50, 0xbf, 0x8c, 0x82, 0x5c, 0x83, 0x74, 0x20, 0x7e, 0xde, 0xc7, 0xc4,
+	0x83, 0xec, 0xa9, 0xf2, 0xcf, 0x41, 0xa6, 0xd5, 0x75, 0xf4, 0x67, 0x9a, 0x69, 0x7b, 0xd8, 0x1d,
+	0xa0, 0x6e, 0x5e, 0x2a, 0x49, 0x9b, 0xb1, 0xca, 0xc6, 0xd8, 0x57, 0x6e, 0x9f, 0x22, 0xab, 0xfb,
+	0xb1, 0x3a, 0xab, 0x57, 0x61, 0x9a, 0x09, 0xea, 0x62, 0x2d, 0x37, 0xc0, 0x8a, 0xee, 0xd8, 0xa4,
+	0x6f, 0x61, 0x37, 0x1f, 0x29, 0x49, 0x9b, 0xab, 0x95, 0x07, 0xdf, 0xf8, 0xca, 0x07, 0x1d, 0xd3,
+	0xa3, 0xce, 0xe9, 0x8e, 0x25, 0x8e, 0x27, 0xfe, 0x7c, 0x40, 0x8c, 0x67, 0x5b, 0xde, 0x69, 0x0f,
+	0x93, 0xf2, 0xb6, 0xae, 0x6f, 0x1b, 0x86, 0x8b, 0x09, 0x81, 0x53, 0x08, 0xf9, 0x0e, 0x88, 0x3b,
+	0x2e, 0xd2, 0xbb, 0x38, 0x1f, 0x2d, 0x49, 0x9b, 0x2b, 0x50, 0xac, 0xe4, 0x3f, 0x48, 0x20, 0x2b,
+	0x62, 0xa0, 0xb5, 0x31, 0xd6, 0x74, 0xd4, 0xcb, 0xc7, 0x4a, 0xd1, 0xcd, 0xd4, 0xc3, 0x8d, 0xb2,
+	0xf0, 0xbf, 0x85, 0x08, 0x2e, 0x0f, 0x1e, 0xb4, 0xb0, 0x87, 0x1e, 0x94, 0xab, 0x8e, 0x69, 0x57,
+	0x3e, 0x7d, 0xe9, 0x2b, 0x4b, 0x63, 0x5f, 0xb9, 0xc3, 0x3d, 0x99, 0xdb, 0xaf, 0xfe, 0xf3, 0x3f,
+	0xca, 0xe6, 0x77, 0x38, 0x27, 0x85, 0x22, 0x30, 0x2d, 0x76, 0xd7, 0x30, 0xae, 0xa2, 0x9e, 0xbc,
+	0x01, 0xa2, 0x03, 0xb7, 0x9d, 0x5f, 0xa6, 0x87, 0xac, 0x24, 0x46, 0xbe, 0x12, 0x3d, 0x86, 0x35,
+	0x48, 0x65, 0xd4, 0x85, 0x16, 0x46, 0xba, 0x63, 0xe7, 0xe3, 0xdc, 0x05, 0xbe, 0x92, 0x3f, 0x02,
+	0x71, 0xa7, 0xef, 0xf5, 0xfa, 0x5e, 0x3e, 0x51, 0x92, 0x36, 0x53, 0x0f, 0x0b, 0xe5, 0xd9, 0x14,
+	0x28, 0xff, 0x82, 0x69, 0x0f, 0x7b, 0x58, 0xaf, 0xc4, 0xe8, 0xc9, 0xa1, 0xb0, 0x57, 0xbf, 0x92,
+	0xc0, 0x5a, 0x83, 0x74, 0x0e, 0xfb, 0x2d, 0xcb, 0xf4, 0x8e, 0x61, 0xad, 0xe9, 0x3a, 0x4e, 0x5b,
+	0xde, 0x04, 0x71, 0x17, 0x3f, 0xd7, 0x4c, 0x83, 0xbd, 0xb3, 0x64, 0x65, 0x6d, 0xec, 0x2b, 0x69,
+	0xee, 0x29, 0x97, 0xab, 0x70, 0xd9, 0xc5, 0xcf, 0xeb, 0x86, 0x7c, 0x00, 0x96, 0x7b, 0x74, 0x8b,
+	0x78, 0x41, 0x1f, 0x7d, 0xe3, 0x2b, 0x1f, 0x86, 0x1c, 0xf7, 0xb0, 0x6d, 0x60, 0xd7, 0x32, 0x6d,
+	0x2f, 0xfc, 0xb3, 0x6b, 0xb6, 0xc8, 0x56, 0xeb, 0xd4, 0xc3, 0xa4, 0xbc, 0x87, 0x87, 0x15, 0xfa,
+	0x03, 0x72, 0x18, 0xb9, 0x0e, 0xe2, 0x84, 0x19, 0xe6, 0xa3, 0xd7, 0x7d, 0xe3, 0x02, 0x40, 0xfd,
+	0x5a, 0x02, 0xf7, 0x58, 0x56, 0x76, 0x4c, 0xe2, 0x61, 0xb7, 0xc2, 0x42, 0xd5, 0x44, 0xae, 0x67,
+	0xea, 0x66, 0x0f, 0xd9, 0x9e, 0x7c, 0x08, 0x52, 0xbd, 0x60, 0x99, 0x97, 0xae, 0xfb, 0xc0, 0x30,
+	0x8a, 0x8c, 0x41, 0xc2, 0xc0, 0x3d, 0x87, 0x98, 0x5e, 0x3e, 0xf2, 0xa6, 0x24, 0xfa, 0x09, 0x7d,
+	0x15, 0x57, 0x4a, 0x95, 0x09, 0xb6, 0xda, 0x07, 0xc5, 0x06, 0xe9, 0x3c, 0xb1, 0xdd, 0x77, 0xea,
+	0x9d, 0xfa, 0x3f, 0x09, 0x64, 0x1b, 0xa4, 0x53, 0x75, 0x2c, 0xcb, 0xf4, 0xf8, 0x33, 0xaf, 0x90,
+	0x2c, 0x4f, 0x01, 0xd0, 0xd9, 0x4e, 0x0b, 0xdb, 0xde, 0x5b, 0x67, 0x4c, 0x08, 0x6b, 0xde, 0xd9,
+	0xe8, 0x8d, 0x38, 0x3b, 0xe2, 0xce, 0x42, 0x3c, 0xc0, 0xa8, 0x7b, 0x65, 0x67, 0x9b, 0x34, 0x93,
+	0x75, 0x17, 0xbf, 0xbd, 0xa3, 0x02, 0xe7, 0xfb, 0x71, 0xf2, 0x5f, 0xcb, 0x20, 0x2e, 0x2a, 0xf6,
+	0x6f, 0x40, 0xd6, 0xe5, 0x25, 0x5c, 0xf3, 0x86, 0xda, 0x09, 0x22, 0x27, 0x22, 0x6b, 0x60, 0x50,
+	0xe8, 0xe6, 0x0c, 0xd4, 0x6b, 0x3b, 0x95, 0x16, 0x48, 0x47, 0xc3, 0x3d, 0x44, 0x4e, 0x68, 0x65,
+	0x3b, 0xc1, 0x66, 0xe7, 0x84, 0x47, 0x2b, 0x0a, 0xc5, 0x4a, 0xbe, 0x05, 0x96, 0x07, 0xa8, 0xdb,
+	0xe7, 0x35, 0x3b, 0x09, 0xf9, 0x22, 0xa8, 0x3a, 0xb1, 0x9b, 0xa9, 0x3a, 0x7d, 0x90, 0x1c, 0xb8,
+	0x6d, 0xcd, 0xb4, 0x69, 0x09, 0x5d, 0x66, 0x98, 0x4f, 0x47, 0xbe, 0xb2, 0x72, 0x0c, 0x6b, 0x75,
+	0x2a, 0x1b, 0xfb, 0x4a, 0x8e, 0xfb, 0x3f, 0x35, 0xbb, 0xbe, 0xe7, 0x2b, 0x03, 0xb7, 0xcd, 0x50,
+	0x43, 0x65, 0x3b, 0x7e, 0xb5, 0xb2, 0x2d, 0xff, 0x0c, 0xc4, 0x89, 0x87, 0xbc, 0x3e, 0x61, 0x05,
+	0x3f, 0xf3, 0xf0, 0xfe, 0xfc, 0x4e, 0xd1, 0x8b, 0x0f, 0x99, 0x11, 0x14, 0xc6, 0xb4, 0x27, 0xb7,
+	0x91, 0xd9, 0xed, 0xbb, 0x58, 0x73, 0x31, 0x22, 0x8e, 0x9d, 0x5f, 0x61, 0x59, 0x1c, 0xea, 0xc9,
+	0xb3, 0x7a, 0x15, 0xa6, 0x85, 0x00, 0xb2, 0xb5, 0xfc, 0x5b, 0x09, 0x64, 0x68, 0x0c, 0x7a, 0xfd,
+	0x56, 0xd7, 0xd4, 0xb5, 0x67, 0xf8, 0x34, 0x9f, 0x64, 0xf1, 0x42, 0x23, 0x5f, 0x59, 0xa5, 0x0d,
+	0x84, 0x29, 0x1e, 0xe3, 0xd3, 0x00, 0x72, 0xd6, 0xfe, 0xfa, 0x81, 0x5b, 0x1d, 0xb8, 0xed, 0x29,
+	0xbc, 0xfa, 0x2a, 0x09, 0x12, 0xc2, 0xcb, 0x50, 0xf6, 0x48, 0x33, 0xd9, 0x73, 0xc3, 0x0c, 0x42,
+	0x07, 0x89, 0xc9, 0xc5, 0xe0, 0x97, 0xef, 0xd3, 0xb1, 0xaf, 0x64, 0xb8, 0x93, 0x6f, 0x7d, 0x21,
+	0xe2, 0xde, 0xf4, 0x26, 0x08, 0x9a, 0x12, 0x7b, 0x23, 0x4d, 0x59, 0xfe, 0x01, 0x69, 0xca, 0x5f,
+	0x24, 0x20, 0x4f, 0xf0, 0x74, 0xc7, 0xf6, 0xf0, 0xd0, 0xa3, 0x65, 0x31, 0xce, 0x02, 0x63, 0x8e,
+	0x7c, 0x25, 0x77, 0xc8, 0xb5, 0x55, 0xae, 0xac, 0xef, 0x8c, 0x7d, 0x65, 0x63, 0xf6, 0x1c, 0xc1,
+	0xbe, 0xeb, 0xc7, 0x2d, 0x47, 0x66, 0x1f, 0x63, 0x4c, 0x08, 0x54, 0xe2, 0x02, 0x02, 0x35, 0x73,
+	0xd1, 0x57, 0xde, 0xd9, 0x45, 0x0f, 0x78, 0x5b, 0x72, 0x86, 0xb7, 0xed, 0x81, 0x35, 0xde, 0xc4,
+	0x34, 0x6c, 0x1b, 0x9a, 0x48, 0x61, 0x40, 0x53, 0xb8, 0x72, 0x6f, 0xec, 0x2b, 0x79, 0x7e, 0x94,
+	0x05, 0x13, 0x15, 0x66, 0xb9, 0x6c, 0xd7, 0x36, 0xf6, 0x78, 0xa6, 0xef, 0x81, 0x35, 0x97, 0xf5,
+	0xa9, 0x30, 0x52, 0x6a, 0x1e, 0x69, 0xc1, 0x44, 0x85, 0x59, 0x2e, 0x0b, 0x90, 0x1e, 0x81, 0x94,
+	0xe5, 0x18, 0xfd, 0x2e, 0xd6, 0x6c, 0x64, 0xe1, 0xfc, 0x2a, 0x2b, 0x10, 0x77, 0xc6, 0xbe, 0x22,
+	0x73, 0x8c, 0x90, 0x52, 0x85, 0x80, 0xaf, 0x0e, 0x90, 0x85, 0x43, 0xd5, 0x2c, 0x7d, 0xc5, 0x6a,
+	0xf6, 0x85, 0x04, 0x52, 0x93, 0xc6, 0xd2, 0xc6, 0x38, 0x9f, 0x79, 0x53, 0x5a, 0xd7, 0x44, 0x5a,
+	0xcb, 0xb3, 0x4d, 0xa9, 0x8d, 0xf1, 0xd5, 0x52, 0x1a, 0x88, 0x9d, 0x35, 0x8c, 0x69, 0xa7, 0xb1,
+	0x1d, 0x5b, 0xc7, 0xf9, 0x2c, 0x1d, 0x53, 0x20, 0x5f, 0xc8, 0xcf, 0xc0, 0x6d, 0x9a, 0x09, 0x78,
+	0xd8, 0x33, 0x5d, 0xe4, 0x99, 0x8e, 0x3d, 0x89, 0x6d, 0x8e, 0xc5, 0xf6, 0xd1, 0xc8, 0x57, 0xd6,
+	0x8f, 0x61, 0x6d, 0x77, 0xaa, 0xe7, 0x51, 0x1c, 0xfb, 0xca, 0xbd, 0x20, 0x8f, 0x16, 0x76, 0xab,
+	0x70, 0x7d, 0xe0, 0xb6, 0xe7, 0x37, 0xa9, 0xaf, 0x25, 0x90, 0xad, 0x8a, 0x62, 0x33, 0x29, 0x6d,
+	0xbf, 0x9e, 0x21, 0x1c, 0xab, 0x95, 0xfa, 0x02, 0xe1, 0xb8, 0x7e, 0xf3, 0xe3, 0x44, 0xe5, 0x11,
+	0x48, 0x88, 0x30, 0xb0, 0x1a, 0x99, 0x7a, 0x78, 0xf7, 0x92, 0x66, 0x22, 0xde, 0xda, 0xc4, 0x3a,
+	0xd4, 0x84, 0xa2, 0x57, 0x68, 0x42, 0xea, 0xbf, 0x25, 0xb0, 0xda, 0x74, 0xfb, 0x36, 0x36, 0x04,
+	0xef, 0xf8, 0xfe, 0x5d, 0xfc, 0x10, 0xc4, 0xf9, 0x91, 0x84, 0x87, 0x77, 0x16, 0x4e, 0xca, 0xfe,
+	0x4c, 0xd2, 0x92, 0x0b, 0xe9, 0xad, 0x65, 0x74, 0x83, 0xfa, 0x17, 0xdd, 0x4c, 0x42, 0xb1, 0x52,
+	0xff, 0x24, 0x81, 0xbb, 0x61, 0x07, 0x48, 0x35, 0x20, 0xa2, 0x97, 0x75, 0xa2, 0x7d, 0x10, 0x73,
+	0x1d, 0xe7, 0xed, 0xb9, 0x20, 0x43, 0xa1, 0xb9, 0xaa, 0x3b, 0x7d, 0xc1, 0x01, 0xd3, 0x90, 0x2f,
+	0xd4, 0x2f, 0x25, 0x00, 0x82, 0x3b, 0x26, 0x97, 0x41, 0x8c, 0xe6, 0x3a, 0x3b, 0x48, 0xe6, 0xb2,
+	0xdb, 0x78, 0x74, 0xda, 0xc3, 0x90, 0xd9, 0x05, 0xa0, 0x91, 0x10, 0xa8, 0xfc, 0x00, 0x24, 0x5d,
+	0x64, 0x77, 0xb0, 0x66, 0x99, 0x36, 0x7b, 0x5c, 0xac, 0x72, 0x2b, 0xa8, 0x92, 0x53, 0x95, 0x0a,
+	0x57, 0xd8, 0xef, 0x86, 0x69, 0x87, 0xb6, 0xa0, 0x61, 0x3e, 0x76, 0xc9, 0x16, 0x34, 0x9c, 0x6e,
+	0x41, 0x43, 0xf5, 0xf7, 0x11, 0xb0, 0xb6, 0x38, 0xc2, 0x3c, 0x06, 0x09, 0xc4, 0x9b, 0xf0, 0xf5,
+	0xc7, 0x97, 0x09, 0xc2, 0x3b, 0x1a, 0xcc, 0xe4, 0x2a, 0xc8, 0xf6, 0xb0, 0x6d, 0x98, 0x76, 0x47,
+	0xe3, 0x35, 0x9a, 0x88, 0xa8, 0x15, 0x82, 0x36, 0x3c, 0x67, 0xa0, 0xc2, 0x8c, 0x90, 0x54, 0x85,
+	0xe0, 0xff, 0x11, 0xb0, 0xca, 0xc3, 0xc1, 0x25, 0xef, 0xe0, 0x8a, 0xcc, 0x0d, 0x17, 0x91, 0x1b,
+	0x19, 0x86, 0x67, 0x07, 0xbe, 0xe8, 0x0d, 0x0e, 0x7c, 0xc1, 0x74, 0x15, 0xbb, 0x99, 0xe9, 0x4a,
+	0xfd, 0x1b, 0x00, 0xf1, 0x26, 0x72, 0x91, 0x45, 0xe4, 0xc7, 0x40, 0xb6, 0x4c, 0x5b, 0xbb, 0xf0,
+	0xf3, 0xd5, 0xfd, 0x80, 0xc5, 0x2c, 0xda, 0xa8, 0x30, 0x67, 0x99, 0x76, 0x65, 0xe6, 0x2b, 0x16,
+	0x05, 0x43, 0xc3, 0x79, 0xb0, 0xc8, 0x02, 0x18, 0x1a, 0x5e, 0x00, 0x86, 0x86, 0xb3, 0x60, 0xf3,
+	0x9d, 0x32, 0xfa, 0x43, 0x74, 0xca, 0x2a, 0xc8, 0x52, 0x02, 0x69, 0x60, 0xe2, 0x99, 0x36, 0xeb,
+	0x5f, 0xec, 0x25, 0x24, 0xc3, 0x29, 0x3e, 0x67, 0xa0, 0xc2, 0x4c, 0x1b, 0xe3, 0x9d, 0x40, 0x20,
+	0x1f, 0x83, 0x3b, 0xd4, 0x65, 0x01, 0x4b, 0xb4, 0x1e, 0x76, 0xb9, 0xff, 0x6c, 0xfe, 0x8a, 0x55,
+	0x7e, 0x34, 0xf6, 0x95, 0xfb, 0x41, 0x68, 0x16, 0xed, 0x54, 0xb8, 0x6e, 0xa1, 0xa1, 0x68, 0x31,
+	0xa4, 0x89, 0x5d, 0x16, 0x29, 0xb9, 0x06, 0x72, 0x9c, 0x30, 0xd3, 0xcf, 0x94, 0x03, 0xd3, 0xc0,
+	0x2e, 0x61, 0x9c, 0x34, 0x5d, 0x79, 0x6f, 0xec, 0x2b, 0x77, 0x39, 0xe2, 0xbc, 0x85, 0x0a, 0xb3,
+	0x5c, 0xd4, 0x9c, 0x48, 0x42, 0x38, 0xde, 0x89, 0x8b, 0xc9, 0x89, 0xd3, 0x35, 0xf2, 0x89, 0x4b,
+	0x70, 0xa6, 0x16, 0x53, 0x9c, 0xa3, 0x89, 0x84, 0xe2, 0x10, 0x8c, 0x0d, 0x7a, 0xd7, 0x5b, 0x93,
+	0x68, 0xf1, 0xa1, 0x2b, 0x84, 0x33, 0x6f, 0xa1, 0xc2, 0x2c, 0x15, 0x55, 0x03, 0x89, 0xfc, 0x39,
+	0xb8, 0xcb, 0x0b, 0xb7, 0xe6, 0x62, 0x0f, 0xdb, 0x54, 0xc6, 0xe3, 0x40, 0x18, 0xa7, 0x8c, 0x55,
+	0xd4, 0xb1, 0xaf, 0x14, 0xa7, 0x25, 0xf6, 0x22, 0x43, 0x15, 0xde, 0xe6, 0x1a, 0x38, 0x51, 0xb0,
+	0x90, 0x11, 0xf9, 0x57, 0x60, 0x8d, 0xcd, 0x68, 0x74, 0x16, 0xd6, 0x3c, 0xd3, 0xc2, 0x4e, 0x9f,
+	0xd3, 0xd0, 0x58, 0x65, 0x6b, 0xe4, 0x2b, 0xd9, 0xc9, 0x77, 0xc1, 0x23, 0xae, 0x0a, 0xf8, 0xe4,
+	0xc2, 0x2e, 0x15, 0x66, 0xe9, 0x90, 0x16, 0x32, 0x96, 0xff, 0x2c, 0x01, 0x99, 0xd3, 0x5d, 0xda,
+	0x27, 0xb4, 0x49, 0x0d, 0x4e, 0xbd, 0x29, 0x73, 0x1b, 0x22, 0x73, 0xc5, 0xfd, 0x58, 0x84, 0xb8,
+	0x5a, 0x02, 0xe7, 0x38, 0x40, 0xc3, 0xb4, 0x77, 0x44, 0xa5, 0xfe, 0x42, 0x02, 0xb7, 0x05, 0x2a,
+	0xe9, 0x22, 0x72, 0xa2, 0xb5, 0x5d, 0xa4, 0xb3, 0xf7, 0xc3, 0x39, 0xef, 0x01, 0x3d, 0xc0, 0xd7,
+	0xbe, 0xf2, 0xe3, 0xef, 0xf0, 0x8c, 0x1d, 0xac, 0x07, 0x94, 0xef, 0x42, 0x50, 0x15, 0xae, 0x73,
+	0xf9, 0x21, 0x15, 0xd7, 0x84, 0x54, 0xfe, 0x25, 0xb8, 0x25, 0xcc, 0x05, 0xcb, 0x7f, 0x61, 0xda,
+	0x86, 0xf3, 0x82, 0x51, 0xe8, 0x58, 0x45, 0x19, 0xfb, 0xca, 0x7b, 0x33, 0xa0, 0x33, 0x56, 0x2a,
+	0x94, 0x5b, 0xa1, 0x2e, 0xf1, 0x19, 0x13, 0x86, 0x20, 0x05, 0xdd, 0x17, 0x90, 0x99, 0x4b, 0x20,
+	0x67, 0xac, 0xa6, 0x90, 0xfc, 0xab, 0x17, 0x87, 0xfc, 0x38, 0xf6, 0xd7, 0xbf, 0x2b, 0x4b, 0xea,
+	0x4b, 0x09, 0x14, 0x9e, 0xf4, 0x0c, 0xe4, 0xe1, 0xf0, 0x9c, 0xdf, 0x74, 0x9d, 0x9e, 0x43, 0x50,
+	0x97, 0xf2, 0x07, 0xcf, 0xf4, 0xba, 0x9c, 0x70, 0x24, 0x21, 0x5f, 0xc8, 0x25, 0x90, 0x32, 0x30,
+	0xd1, 0x5d, 0xb3, 0xc7, 0x42, 0x1b, 0x61, 0xba, 0xb0, 0x48, 0xee, 0x02, 0x10, 0xfa, 0x9a, 0xc0,
+	0x9b, 0x44, 0x63, 0xec, 0x2b, 0x6b, 0xa2, 0x59, 0xde, 0xc0, 0x97, 0x83, 0x64, 0x6f, 0x72, 0x5a,
+	0xee, 0xca, 0xfb, 0x4f, 0x01, 0x08, 0xf8, 0x8f, 0x9c, 0x07, 0x89, 0x9d, 0xdd, 0x6a, 0xbd, 0xb1,
+	0xbd, 0x9f, 0x5b, 0x2a, 0xa4, 0xce, 0xce, 0x4b, 0x89, 0x1d, 0xac, 0x9b, 0x16, 0xea, 0x52, 0x4d,
+	0xfd, 0xe0, 0x68, 0xf7, 0x93, 0x5d, 0x98, 0x93, 0xb8, 0x86, 0x96, 0xe2, 0x0e, 0x76, 0xe5, 0x1c,
+	0x88, 0xc2, 0xed, 0xcf, 0x72, 0x91, 0x42, 0xe2, 0xec, 0xbc, 0x14, 0x85, 0xe8, 0x45, 0x21, 0xf6,
+	0xbb, 0x7f, 0x14, 0x97, 0xde, 0x7f, 0x01, 0xd2, 0x33, 0xb4, 0x57, 0x56, 0x41, 0xb2, 0xf6, 0x64,
+	0xbf, 0x56, 0xdf, 0xdf, 0xdf, 0xdd, 0xc9, 0x2d, 0x15, 0xd6, 0xcf, 0xce, 0x4b, 0x59, 0xae, 0xaa,
+	0xf5, 0xbb, 0x6d, 0xb3, 0xdb, 0xc5, 0x86, 0x7c, 0x0f, 0xc4, 0x6b, 0xdb, 0x75, 0x6a, 0x20, 0x15,
+	0x72, 0x67, 0xe7, 0xa5, 0x55, 0x61, 0x80, 0x4c, 0xaa, 0x2d, 0x82, 0x44, 0x73, 0xf7, 0x60, 0xa7,
+	0x7e, 0xf0, 0x49, 0x2e, 0x52, 0x58, 0x3b, 0x3b, 0x2f, 0xa5, 0xb9, 0xba, 0xc9, 0x49, 0x03, 0x7f,
+	0x70, 0xa5, 0xfe, 0x72, 0x54, 0x94, 0x5e, 0x8d, 0x8a, 0xd2, 0x7f, 0x47, 0x45, 0xe9, 0x8f, 0xaf,
+	0x8b, 0x4b, 0xaf, 0x5e, 0x17, 0x97, 0xbe, 0x7a, 0x5d, 0x5c, 0xfa, 0x7c, 0x2b, 0x14, 0x33, 0x4a,
+	0x02, 0x6d, 0xec, 0x6d, 0x09, 0x32, 0xb8, 0xc5, 0xe7, 0x37, 0x22, 0xfe, 0x81, 0xc4, 0xb3, 0xb9,
+	0x15, 0x67, 0xff, 0xd6, 0xf9, 0xe9, 0xb7, 0x03, 0x00, 0xf6, 0xf1, 0x71, 0xb7, 0x5e, 0x1a, 0x00,
+	0x00,
+}
+
+func (m *MsgRequestRandom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestRandom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestRandom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRandom(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Beacon {
+		i--
+		if m.Beacon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VRF {
+		i--
+		if m.VRF {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ServiceFeeCap) > 0 {
+		for iNdEx := len(m.ServiceFeeCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServiceFeeCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRandom(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Oracle {
+		i--
+		if m.Oracle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockInterval != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.BlockInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitVRFProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitVRFProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitVRFProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBeaconParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBeaconParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBeaconParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRandom(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterBeaconParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterBeaconParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterBeaconParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitBeacon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitBeacon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitBeacon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealBeacon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealBeacon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealBeacon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Random) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Random) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Random) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VRFPublicKey) > 0 {
+		i -= len(m.VRFPublicKey)
+		copy(dAtA[i:], m.VRFPublicKey)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.VRFPublicKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRandom(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.VRFInput) > 0 {
+		i -= len(m.VRFInput)
+		copy(dAtA[i:], m.VRFInput)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.VRFInput)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RequestTxHash) > 0 {
+		i -= len(m.RequestTxHash)
+		copy(dAtA[i:], m.RequestTxHash)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.RequestTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VRFExpirationHeight != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.VRFExpirationHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.RequestFee) > 0 {
+		for iNdEx := len(m.RequestFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRandom(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRandom(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.RevealEndHeight != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.RevealEndHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CommitEndHeight != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.CommitEndHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Beacon {
+		i--
+		if m.Beacon {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.VRFInput) > 0 {
+		i -= len(m.VRFInput)
+		copy(dAtA[i:], m.VRFInput)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.VRFInput)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.VRF {
+		i--
+		if m.VRF {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ServiceContextID) > 0 {
+		i -= len(m.ServiceContextID)
+		copy(dAtA[i:], m.ServiceContextID)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.ServiceContextID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ServiceFeeCap) > 0 {
+		for iNdEx := len(m.ServiceFeeCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServiceFeeCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRandom(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Oracle {
+		i--
+		if m.Oracle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRandom(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrunedRandom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrunedRandom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrunedRandom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintRandom(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Random.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRandom(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrunedRandomsCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrunedRandomsCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrunedRandomsCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutputSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RangeMax != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.RangeMax))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RangeMin != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.RangeMin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingCommits != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.PendingCommits))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRandom(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BeaconRevealWindow != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.BeaconRevealWindow))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BeaconCommitWindow != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.BeaconCommitWindow))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.BeaconSlashFraction.Size()
+		i -= size
+		if _, err := m.BeaconSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRandom(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.BeaconMinDeposit) > 0 {
+		for iNdEx := len(m.BeaconMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeaconMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRandom(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.VRFProofTimeout != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.VRFProofTimeout))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RandomRetentionBlocks != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.RandomRetentionBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SeedCombination) > 0 {
+		i -= len(m.SeedCombination)
+		copy(dAtA[i:], m.SeedCombination)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.SeedCombination)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.OracleThreshold != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.OracleThreshold))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OracleProviders != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.OracleProviders))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRequestsPerBlock != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.MaxRequestsPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FeeDestination) > 0 {
+		i -= len(m.FeeDestination)
+		copy(dAtA[i:], m.FeeDestination)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.FeeDestination)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RequestFee) > 0 {
+		for iNdEx := len(m.RequestFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequestFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRandom(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxBlockInterval != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.MaxBlockInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinBlockInterval != 0 {
+		i = encodeVarintRandom(dAtA, i, uint64(m.MinBlockInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateVRFPublicKeyProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateVRFPublicKeyProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateVRFPublicKeyProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintRandom(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRandom(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRandom(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRequestRandom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockInterval != 0 {
+		n += 1 + sovRandom(uint64(m.BlockInterval))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	if m.Oracle {
+		n += 2
+	}
+	if len(m.ServiceFeeCap) > 0 {
+		for _, e := range m.ServiceFeeCap {
+			l = e.Size()
+			n += 1 + l + sovRandom(uint64(l))
+		}
+	}
+	if m.VRF {
+		n += 2
+	}
+	if m.Beacon {
+		n += 2
+	}
+	l = m.Output.Size()
+	n += 1 + l + sovRandom(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitVRFProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterBeaconParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovRandom(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnregisterBeaconParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitBeacon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealBeacon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	return n
+}
+
+func (m *Random) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestTxHash)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRandom(uint64(m.Height))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.VRFInput)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = m.Output.Size()
+	n += 1 + l + sovRandom(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovRandom(uint64(m.Status))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.VRFPublicKey)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	return n
+}
+
+func (m *Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRandom(uint64(m.Height))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	if m.Oracle {
+		n += 2
+	}
+	if len(m.ServiceFeeCap) > 0 {
+		for _, e := range m.ServiceFeeCap {
+			l = e.Size()
+			n += 1 + l + sovRandom(uint64(l))
+		}
+	}
+	l = len(m.ServiceContextID)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	if m.VRF {
+		n += 2
+	}
+	l = len(m.VRFInput)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	if m.Beacon {
+		n += 2
+	}
+	if m.CommitEndHeight != 0 {
+		n += 1 + sovRandom(uint64(m.CommitEndHeight))
+	}
+	if m.RevealEndHeight != 0 {
+		n += 1 + sovRandom(uint64(m.RevealEndHeight))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = m.Output.Size()
+	n += 1 + l + sovRandom(uint64(l))
+	if len(m.RequestFee) > 0 {
+		for _, e := range m.RequestFee {
+			l = e.Size()
+			n += 1 + l + sovRandom(uint64(l))
+		}
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovRandom(uint64(m.Nonce))
+	}
+	if m.VRFExpirationHeight != 0 {
+		n += 2 + sovRandom(uint64(m.VRFExpirationHeight))
+	}
+	return n
+}
+
+func (m *ConsumerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = m.Request.Size()
+	n += 1 + l + sovRandom(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovRandom(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *PrunedRandom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = m.Random.Size()
+	n += 1 + l + sovRandom(uint64(l))
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovRandom(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrunedRandomsCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRandom(uint64(m.Height))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRandom(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *OutputSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRandom(uint64(m.Type))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRandom(uint64(m.Count))
+	}
+	if m.RangeMin != 0 {
+		n += 1 + sovRandom(uint64(m.RangeMin))
+	}
+	if m.RangeMax != 0 {
+		n += 1 + sovRandom(uint64(m.RangeMax))
+	}
+	return n
+}
+
+func (m *BeaconParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovRandom(uint64(l))
+		}
+	}
+	if m.PendingCommits != 0 {
+		n += 1 + sovRandom(uint64(m.PendingCommits))
+	}
+	return n
+}
+
+func (m *BeaconCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinBlockInterval != 0 {
+		n += 1 + sovRandom(uint64(m.MinBlockInterval))
+	}
+	if m.MaxBlockInterval != 0 {
+		n += 1 + sovRandom(uint64(m.MaxBlockInterval))
+	}
+	if len(m.RequestFee) > 0 {
+		for _, e := range m.RequestFee {
+			l = e.Size()
+			n += 1 + l + sovRandom(uint64(l))
+		}
+	}
+	l = len(m.FeeDestination)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	if m.MaxRequestsPerBlock != 0 {
+		n += 1 + sovRandom(uint64(m.MaxRequestsPerBlock))
+	}
+	if m.OracleProviders != 0 {
+		n += 1 + sovRandom(uint64(m.OracleProviders))
+	}
+	if m.OracleThreshold != 0 {
+		n += 1 + sovRandom(uint64(m.OracleThreshold))
+	}
+	l = len(m.SeedCombination)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	if m.RandomRetentionBlocks != 0 {
+		n += 1 + sovRandom(uint64(m.RandomRetentionBlocks))
+	}
+	if m.VRFProofTimeout != 0 {
+		n += 1 + sovRandom(uint64(m.VRFProofTimeout))
+	}
+	if len(m.BeaconMinDeposit) > 0 {
+		for _, e := range m.BeaconMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovRandom(uint64(l))
+		}
+	}
+	l = m.BeaconSlashFraction.Size()
+	n += 1 + l + sovRandom(uint64(l))
+	if m.BeaconCommitWindow != 0 {
+		n += 1 + sovRandom(uint64(m.BeaconCommitWindow))
+	}
+	if m.BeaconRevealWindow != 0 {
+		n += 1 + sovRandom(uint64(m.BeaconRevealWindow))
+	}
+	return n
+}
+
+func (m *UpdateVRFPublicKeyProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovRandom(uint64(m.Height))
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovRandom(uint64(l))
+	}
+	return n
+}
+
+func sovRandom(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRandom(x uint64) (n int) {
+	return sovRandom(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRequestRandom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRandom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRandom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+			}
+			m.BlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = append(m.Consumer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Consumer == nil {
+				m.Consumer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Oracle = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFeeCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceFeeCap = append(m.ServiceFeeCap, types.Coin{})
+			if err := m.ServiceFeeCap[len(m.ServiceFeeCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRF", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VRF = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Beacon = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitVRFProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitVRFProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitVRFProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterBeaconParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterBeaconParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterBeaconParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = append(m.Participant[:0], dAtA[iNdEx:postIndex]...)
+			if m.Participant == nil {
+				m.Participant = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterBeaconParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterBeaconParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterBeaconParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = append(m.Participant[:0], dAtA[iNdEx:postIndex]...)
+			if m.Participant == nil {
+				m.Participant = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitBeacon) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitBeacon: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitBeacon: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = append(m.Participant[:0], dAtA[iNdEx:postIndex]...)
+			if m.Participant == nil {
+				m.Participant = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevealBeacon) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealBeacon: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealBeacon: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = append(m.Secret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Secret == nil {
+				m.Secret = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = append(m.Participant[:0], dAtA[iNdEx:postIndex]...)
+			if m.Participant == nil {
+				m.Participant = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Random) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Random: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Random: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestTxHash = append(m.RequestTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestTxHash == nil {
+				m.RequestTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFInput", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFInput = append(m.VRFInput[:0], dAtA[iNdEx:postIndex]...)
+			if m.VRFInput == nil {
+				m.VRFInput = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFPublicKey = append(m.VRFPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VRFPublicKey == nil {
+				m.VRFPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = append(m.Consumer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Consumer == nil {
+				m.Consumer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Oracle = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFeeCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceFeeCap = append(m.ServiceFeeCap, types.Coin{})
+			if err := m.ServiceFeeCap[len(m.ServiceFeeCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceContextID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceContextID = append(m.ServiceContextID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ServiceContextID == nil {
+				m.ServiceContextID = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRF", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VRF = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFInput", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VRFInput = append(m.VRFInput[:0], dAtA[iNdEx:postIndex]...)
+			if m.VRFInput == nil {
+				m.VRFInput = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Beacon = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitEndHeight", wireType)
+			}
+			m.CommitEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEndHeight", wireType)
+			}
+			m.RevealEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestFee = append(m.RequestFee, types.Coin{})
+			if err := m.RequestFee[len(m.RequestFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFExpirationHeight", wireType)
+			}
+			m.VRFExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VRFExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = append(m.ReqId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReqId == nil {
+				m.ReqId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrunedRandom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrunedRandom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrunedRandom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = append(m.ReqId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReqId == nil {
+				m.ReqId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Random", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Random.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrunedRandomsCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrunedRandomsCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrunedRandomsCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutputSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OutputType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeMin", wireType)
+			}
+			m.RangeMin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeMin |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeMax", wireType)
+			}
+			m.RangeMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeMax |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.Consumer)
-	if l > 0 {
-		n += 1 + l + sovRandom(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovRandom(uint64(l))
+	return nil
+}
+func (m *BeaconParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommits", wireType)
+			}
+			m.PendingCommits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingCommits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.Oracle {
-		n += 2
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.ServiceFeeCap) > 0 {
-		for _, e := range m.ServiceFeeCap {
-			l = e.Size()
-			n += 1 + l + sovRandom(uint64(l))
+	return nil
+}
+func (m *BeaconCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRandom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = append(m.ReqId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReqId == nil {
+				m.ReqId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = append(m.Participant[:0], dAtA[iNdEx:postIndex]...)
+			if m.Participant == nil {
+				m.Participant = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = append(m.Secret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Secret == nil {
+				m.Secret = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRandom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.ServiceContextID)
-	if l > 0 {
-		n += 1 + l + sovRandom(uint64(l))
-	}
-	return n
-}
 
-func sovRandom(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRandom(x uint64) (n int) {
-	return sovRandom(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgRequestRandom) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -579,21 +5521,163 @@ func (m *MsgRequestRandom) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestRandom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestRandom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlockInterval", wireType)
+			}
+			m.MinBlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockInterval", wireType)
+			}
+			m.MaxBlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestFee = append(m.RequestFee, types.Coin{})
+			if err := m.RequestFee[len(m.RequestFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRandom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRandom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRequestsPerBlock", wireType)
+			}
+			m.MaxRequestsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRequestsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleProviders", wireType)
+			}
+			m.OracleProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleProviders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleThreshold", wireType)
 			}
-			m.BlockInterval = 0
+			m.OracleThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRandom
@@ -603,16 +5687,16 @@ func (m *MsgRequestRandom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockInterval |= uint64(b&0x7F) << shift
+				m.OracleThreshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedCombination", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRandom
@@ -622,31 +5706,29 @@ func (m *MsgRequestRandom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRandom
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRandom
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Consumer = append(m.Consumer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Consumer == nil {
-				m.Consumer = []byte{}
-			}
+			m.SeedCombination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomRetentionBlocks", wireType)
 			}
-			var v int
+			m.RandomRetentionBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRandom
@@ -656,15 +5738,33 @@ func (m *MsgRequestRandom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RandomRetentionBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Oracle = bool(v != 0)
-		case 4:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRFProofTimeout", wireType)
+			}
+			m.VRFProofTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRandom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VRFProofTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFeeCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconMinDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -691,69 +5791,16 @@ func (m *MsgRequestRandom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceFeeCap = append(m.ServiceFeeCap, types.Coin{})
-			if err := m.ServiceFeeCap[len(m.ServiceFeeCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BeaconMinDeposit = append(m.BeaconMinDeposit, types.Coin{})
+			if err := m.BeaconMinDeposit[len(m.BeaconMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRandom(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRandom
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRandom
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Random) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRandom
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Random: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Random: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconSlashFraction", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRandom
@@ -763,31 +5810,31 @@ func (m *Random) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRandom
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRandom
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestTxHash = append(m.RequestTxHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.RequestTxHash == nil {
-				m.RequestTxHash = []byte{}
+			if err := m.BeaconSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconCommitWindow", wireType)
 			}
-			m.Height = 0
+			m.BeaconCommitWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRandom
@@ -797,16 +5844,16 @@ func (m *Random) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.BeaconCommitWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconRevealWindow", wireType)
 			}
-			var stringLen uint64
+			m.BeaconRevealWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRandom
@@ -816,24 +5863,11 @@ func (m *Random) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BeaconRevealWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRandom
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRandom
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRandom(dAtA[iNdEx:])
@@ -858,7 +5892,7 @@ func (m *Random) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *UpdateVRFPublicKeyProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -881,70 +5915,17 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateVRFPublicKeyProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateVRFPublicKeyProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRandom
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRandom
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRandom
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRandom
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Consumer = append(m.Consumer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Consumer == nil {
-				m.Consumer = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRandom
@@ -954,51 +5935,29 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRandom
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRandom
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxHash == nil {
-				m.TxHash = []byte{}
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRandom
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Oracle = bool(v != 0)
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFeeCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRandom
@@ -1008,29 +5967,27 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRandom
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRandom
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceFeeCap = append(m.ServiceFeeCap, types.Coin{})
-			if err := m.ServiceFeeCap[len(m.ServiceFeeCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceContextID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1057,9 +6014,9 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceContextID = append(m.ServiceContextID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ServiceContextID == nil {
-				m.ServiceContextID = []byte{}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -29,13 +29,19 @@ func NewRequest(
 	}
 }
 
-// GenerateRequestID generate a request id
+// GenerateRequestID generate a request id, the nonce tells apart the requests
+// of a consumer in the same block
 func GenerateRequestID(r Request) []byte {
 	reqID := make([]byte, 0)
 
 	reqID = append(reqID, sdk.Uint64ToBigEndian(uint64(r.Height))...)
 	reqID = append(reqID, []byte(r.Consumer)...)
 
+	// the first request of a block keeps the id of the requests made before the nonce was introduced
+	if r.Nonce > 0 {
+		reqID = append(reqID, sdk.Uint64ToBigEndian(r.Nonce)...)
+	}
+
 	return SHA256(reqID)
 }
 
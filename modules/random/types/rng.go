@@ -20,16 +20,18 @@ type PRNG struct {
 	BlockHash      []byte         // hash of some block
 	BlockTimestamp int64          // timestamp of the next block
 	TxInitiator    sdk.AccAddress // address initiating the request tx
+	RequestID      []byte         // id of the request, which tells apart the requests of the initiator in the same block
 	OracleSeed     []byte         // oracle seed
 	Oracle         bool           // oracle method
 }
 
 // MakePRNG constructs a PRNG
-func MakePRNG(blockHash []byte, blockTimestampt int64, txInitiator sdk.AccAddress, oracleSeed []byte, oracle bool) PRNG {
+func MakePRNG(blockHash []byte, blockTimestampt int64, txInitiator sdk.AccAddress, reqID []byte, oracleSeed []byte, oracle bool) PRNG {
 	return PRNG{
 		BlockHash:      blockHash,
 		BlockTimestamp: blockTimestampt,
 		TxInitiator:    txInitiator,
+		RequestID:      reqID,
 		OracleSeed:     oracleSeed,
 		Oracle:         oracle,
 	}
@@ -37,26 +39,32 @@ func MakePRNG(blockHash []byte, blockTimestampt int64, txInitiator sdk.AccAddres
 
 // GetRand implements RNG
 func (p PRNG) GetRand() *big.Rat {
+	return SeedToRand(p.GetSeed())
+}
+
+// GetSeed returns the seed of the random number
+func (p PRNG) GetSeed() []byte {
 	seedBT := big.NewInt(p.BlockTimestamp)
 	seedBH := new(big.Int).Div(new(big.Int).SetBytes(SHA256(p.BlockHash)), seedBT)
 	seedTI := new(big.Int).Div(new(big.Int).SetBytes(SHA256(p.TxInitiator)), seedBT)
+	seedRI := new(big.Int).Div(new(big.Int).SetBytes(SHA256(p.RequestID)), seedBT)
 
 	seedSum := new(big.Int).Add(seedBT, seedBH)
 	seedSum = new(big.Int).Add(seedSum, seedTI)
+	seedSum = new(big.Int).Add(seedSum, seedRI)
 
 	if p.Oracle {
 		seedOS := new(big.Int).Div(new(big.Int).SetBytes(SHA256(p.OracleSeed)), seedBT)
 		seedSum = new(big.Int).Add(seedSum, seedOS)
 	}
 
-	seed := new(big.Int).SetBytes(SHA256(seedSum.Bytes()))
+	return SHA256(seedSum.Bytes())
+}
 
+// SeedToRand returns the random number between [0,1) with `RandPrec` precision from the seed
+func SeedToRand(seed []byte) *big.Rat {
 	precision := new(big.Int).Exp(big.NewInt(10), big.NewInt(RandPrec), nil)
-
-	// Generate a random number between [0,1) with `RandPrec` precision from seed
-	random := new(big.Rat).SetFrac(new(big.Int).Mod(seed, precision), precision)
-
-	return random
+	return new(big.Rat).SetFrac(new(big.Int).Mod(new(big.Int).SetBytes(seed), precision), precision)
 }
 
 // SHA256 wraps sha256.Sum256 with result converted to slice
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -61,3 +63,39 @@ func GetSvcDefinitions() []servicetypes.ServiceDefinition {
 		),
 	}
 }
+
+// GenerateProviderSeed returns the seed of the provider selection, which is derived from
+// the chain data of the request so that all the validators select the same providers
+func GenerateProviderSeed(lastBlockHash []byte, txHash []byte, consumer sdk.AccAddress) []byte {
+	seed := append(append([]byte{}, lastBlockHash...), txHash...)
+	return SHA256(append(seed, consumer.Bytes()...))
+}
+
+// SelectProviders selects n distinct providers from the candidates by a Fisher-Yates shuffle
+// driven by a hash chain of the seed, all the candidates are selected if there are no more than n
+func SelectProviders(seed []byte, candidates []sdk.AccAddress, n int) []sdk.AccAddress {
+	providers := append([]sdk.AccAddress{}, candidates...)
+	if n >= len(providers) {
+		return providers
+	}
+
+	hash := seed
+	for i := 0; i < n; i++ {
+		hash = SHA256(hash)
+		j := i + int(binary.BigEndian.Uint64(hash[:8])%uint64(len(providers)-i))
+		providers[i], providers[j] = providers[j], providers[i]
+	}
+
+	return providers[:n]
+}
+
+// CombineSeeds combines the oracle seeds by hashing their concatenation, which is bound to the order
+// of the seeds. The responses are public once submitted, so the last provider can still try many seeds
+// or withhold its response, but can not choose the combined seed
+func CombineSeeds(seeds [][]byte) []byte {
+	var bz []byte
+	for _, seed := range seeds {
+		bz = append(bz, seed...)
+	}
+	return SHA256(bz)
+}
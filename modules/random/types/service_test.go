@@ -0,0 +1,43 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSelectProviders(t *testing.T) {
+	var candidates []sdk.AccAddress
+	for i := byte(0); i < 10; i++ {
+		candidates = append(candidates, sdk.AccAddress(bytes.Repeat([]byte{i}, 20)))
+	}
+
+	seed := GenerateProviderSeed([]byte("block_hash"), []byte("tx_hash"), candidates[0])
+
+	providers := SelectProviders(seed, candidates, 3)
+	require.Len(t, providers, 3)
+
+	// the selection is deterministic and the providers are distinct
+	require.Equal(t, providers, SelectProviders(seed, candidates, 3))
+	require.NotEqual(t, providers[0], providers[1])
+	require.NotEqual(t, providers[0], providers[2])
+	require.NotEqual(t, providers[1], providers[2])
+
+	// the candidates are not modified
+	require.Equal(t, sdk.AccAddress(bytes.Repeat([]byte{0}, 20)), candidates[0])
+
+	require.Len(t, SelectProviders(seed, candidates, 20), 10)
+}
+
+func TestCombineSeeds(t *testing.T) {
+	seed1 := bytes.Repeat([]byte{0x0f}, SeedBytesLength)
+	seed2 := bytes.Repeat([]byte{0xff}, SeedBytesLength)
+
+	hash := CombineSeeds([][]byte{seed1, seed2})
+	require.Equal(t, SHA256(append(append([]byte{}, seed1...), seed2...)), hash)
+	require.NotEqual(t, hash, CombineSeeds([][]byte{seed2, seed1}))
+	require.Equal(t, SHA256(seed1), CombineSeeds([][]byte{seed1}))
+}
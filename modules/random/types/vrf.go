@@ -0,0 +1,120 @@
+package types
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// The VRF mode is implemented by RSA-FDH-VRF-SHA256 (draft-irtf-cfrg-vrf): the proof is the
+// deterministic RSA signature of a full domain hash of the input, so there is exactly one valid
+// proof for each input and the random output can not be chosen by the holder of the key
+const (
+	vrfSuite                   = 0x01 // RSA-FDH-VRF-SHA256
+	vrfMGFDomainSeparator      = 0x01
+	vrfProofToHashSeparator    = 0x02
+	MinVRFPublicKeyModulusBits = 2048 // minimal modulus size of the designated VRF key
+)
+
+// GenerateVRFInput returns the VRF input of a request, which is fixed by the hash of
+// the block at the generation height of the request
+func GenerateVRFInput(blockHash []byte, reqID []byte) []byte {
+	return SHA256(append(append([]byte{}, blockHash...), reqID...))
+}
+
+// ParseVRFPublicKey parses the designated VRF key from the PKIX DER encoding
+func ParseVRFPublicKey(bz []byte) (*rsa.PublicKey, error) {
+	key, err := x509.ParsePKIXPublicKey(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("the VRF public key must be a RSA public key")
+	}
+
+	if pubKey.N.BitLen() < MinVRFPublicKeyModulusBits {
+		return nil, fmt.Errorf("the modulus of the VRF public key must be at least %d bits", MinVRFPublicKeyModulusBits)
+	}
+	return pubKey, nil
+}
+
+// ProveVRF generates the VRF proof of the input with the private key, it is run by the
+// holder of the designated VRF key off chain
+func ProveVRF(privKey *rsa.PrivateKey, input []byte) []byte {
+	k := (privKey.N.BitLen() + 7) / 8
+	m := new(big.Int).SetBytes(vrfEncode(&privKey.PublicKey, input))
+	s := new(big.Int).Exp(m, privKey.D, privKey.N)
+	return i2osp(s, k)
+}
+
+// VerifyVRF verifies the VRF proof of the input against the public key and returns the VRF output
+func VerifyVRF(pubKey *rsa.PublicKey, input []byte, proof []byte) ([]byte, error) {
+	k := (pubKey.N.BitLen() + 7) / 8
+	if len(proof) != k {
+		return nil, fmt.Errorf("invalid proof length %d, expected %d", len(proof), k)
+	}
+
+	s := new(big.Int).SetBytes(proof)
+	if s.Cmp(pubKey.N) >= 0 {
+		return nil, errors.New("proof out of range")
+	}
+
+	m := new(big.Int).Exp(s, big.NewInt(int64(pubKey.E)), pubKey.N)
+	if m.BitLen() > (k-1)*8 {
+		return nil, errors.New("invalid proof")
+	}
+
+	if !bytes.Equal(i2osp(m, k-1), vrfEncode(pubKey, input)) {
+		return nil, errors.New("invalid proof")
+	}
+
+	return SHA256(append([]byte{vrfSuite, vrfProofToHashSeparator}, proof...)), nil
+}
+
+// GetVRFRand returns the random number between [0,1) with `RandPrec` precision from the VRF output
+func GetVRFRand(output []byte) *big.Rat {
+	return SeedToRand(output)
+}
+
+// vrfEncode returns the full domain hash of the input for the public key
+func vrfEncode(pubKey *rsa.PublicKey, input []byte) []byte {
+	k := (pubKey.N.BitLen() + 7) / 8
+
+	seed := []byte{vrfSuite, vrfMGFDomainSeparator}
+	seed = append(seed, uint32ToBigEndian(uint32(k))...)
+	seed = append(seed, i2osp(pubKey.N, k)...)
+	seed = append(seed, input...)
+
+	return mgf1SHA256(seed, k-1)
+}
+
+// mgf1SHA256 is the mask generation function MGF1 of RFC 8017 with SHA-256
+func mgf1SHA256(seed []byte, length int) []byte {
+	var out []byte
+	for counter := uint32(0); len(out) < length; counter++ {
+		h := sha256.New()
+		h.Write(seed)
+		h.Write(uint32ToBigEndian(counter))
+		out = h.Sum(out)
+	}
+	return out[:length]
+}
+
+// i2osp converts the integer to the big endian byte string of the given length
+func i2osp(x *big.Int, length int) []byte {
+	bz := x.Bytes()
+	return append(make([]byte, length-len(bz)), bz...)
+}
+
+func uint32ToBigEndian(i uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, i)
+	return bz
+}
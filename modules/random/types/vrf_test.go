@@ -0,0 +1,68 @@
+package types
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVRF(t *testing.T) {
+	privKey, err := rsa.GenerateKey(rand.Reader, MinVRFPublicKeyModulusBits)
+	require.NoError(t, err)
+
+	bz, err := x509.MarshalPKIXPublicKey(&privKey.PublicKey)
+	require.NoError(t, err)
+
+	pubKey, err := ParseVRFPublicKey(bz)
+	require.NoError(t, err)
+
+	input := GenerateVRFInput([]byte("block_hash"), []byte("req_id"))
+	proof := ProveVRF(privKey, input)
+
+	// the proof is unique for the input
+	require.Equal(t, proof, ProveVRF(privKey, input))
+
+	output, err := VerifyVRF(pubKey, input, proof)
+	require.NoError(t, err)
+	require.Len(t, output, 32)
+
+	random := GetVRFRand(output)
+	require.True(t, random.Sign() >= 0 && random.Cmp(big.NewRat(1, 1)) < 0)
+
+	// the proof is bound to the input
+	_, err = VerifyVRF(pubKey, GenerateVRFInput([]byte("block_hash"), []byte("other_req_id")), proof)
+	require.Error(t, err)
+
+	// a tampered proof is rejected
+	tampered := append([]byte{}, proof...)
+	tampered[len(tampered)-1] ^= 0x01
+	_, err = VerifyVRF(pubKey, input, tampered)
+	require.Error(t, err)
+
+	_, err = VerifyVRF(pubKey, input, proof[1:])
+	require.Error(t, err)
+
+	// the proof is bound to the key
+	otherKey, err := rsa.GenerateKey(rand.Reader, MinVRFPublicKeyModulusBits)
+	require.NoError(t, err)
+	_, err = VerifyVRF(&otherKey.PublicKey, input, proof)
+	require.Error(t, err)
+}
+
+func TestParseVRFPublicKey(t *testing.T) {
+	_, err := ParseVRFPublicKey([]byte("invalid"))
+	require.Error(t, err)
+
+	weakKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	bz, err := x509.MarshalPKIXPublicKey(&weakKey.PublicKey)
+	require.NoError(t, err)
+
+	_, err = ParseVRFPublicKey(bz)
+	require.Error(t, err)
+}
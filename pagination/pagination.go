@@ -0,0 +1,61 @@
+package pagination
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Paginate iterates through the store entries by the page request and returns the page response.
+// newIterator returns the iterator from the key of the page request, or from the beginning if the
+// key is nil, so that the entries can be iterated in either order. onResult is called with each
+// entry and returns whether the entry is counted, the entries rejected by a filter are skipped;
+// accumulate tells if the entry belongs to the page.
+func Paginate(
+	pageReq *query.PageRequest,
+	defaultLimit uint64,
+	newIterator func(pageKey []byte) sdk.Iterator,
+	onResult func(key, value []byte, accumulate bool) bool,
+) (*query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	iterator := newIterator(pageReq.Key)
+	defer iterator.Close()
+
+	var nextKey []byte
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		accumulate := count >= pageReq.Offset && count < pageReq.Offset+limit
+		if !onResult(iterator.Key(), iterator.Value(), accumulate) {
+			continue
+		}
+
+		count++
+		if count <= pageReq.Offset+limit {
+			continue
+		}
+
+		if nextKey == nil {
+			nextKey = append([]byte{}, iterator.Key()...)
+		}
+		if !pageReq.CountTotal {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if pageReq.CountTotal && len(pageReq.Key) == 0 {
+		pageRes.Total = count
+	}
+	return pageRes, nil
+}
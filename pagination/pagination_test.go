@@ -0,0 +1,61 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestPaginate(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := uint64(1); i <= 10; i++ {
+		store.Set(sdk.Uint64ToBigEndian(i), sdk.Uint64ToBigEndian(i))
+	}
+
+	// only the even entries are counted
+	paginate := func(pageReq *query.PageRequest) ([]uint64, *query.PageResponse, error) {
+		var result []uint64
+		pageRes, err := Paginate(
+			pageReq, 2,
+			func(pageKey []byte) sdk.Iterator {
+				return store.Iterator(pageKey, nil)
+			},
+			func(key, value []byte, accumulate bool) bool {
+				n := sdk.BigEndianToUint64(value)
+				if n%2 != 0 {
+					return false
+				}
+				if accumulate {
+					result = append(result, n)
+				}
+				return true
+			},
+		)
+		return result, pageRes, err
+	}
+
+	result, pageRes, err := paginate(nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4}, result)
+	require.Equal(t, sdk.Uint64ToBigEndian(6), pageRes.NextKey)
+
+	result, pageRes, err = paginate(&query.PageRequest{Key: pageRes.NextKey, Limit: 3})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{6, 8, 10}, result)
+	require.Nil(t, pageRes.NextKey)
+
+	result, pageRes, err = paginate(&query.PageRequest{Offset: 1, Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 6}, result)
+	require.Equal(t, sdk.Uint64ToBigEndian(8), pageRes.NextKey)
+	require.Equal(t, uint64(5), pageRes.Total)
+
+	_, _, err = paginate(&query.PageRequest{Key: sdk.Uint64ToBigEndian(6), Offset: 1})
+	require.Error(t, err)
+}
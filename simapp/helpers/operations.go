@@ -0,0 +1,232 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdkhelpers "github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	servicetypes "github.com/irismod/service/types"
+)
+
+// ResponseResult is the result of the mock service responses
+const ResponseResult = `{"code":200,"message":""}`
+
+// ErrInsufficientFunds is returned if the spendable coins of the account are less than the amount reserved for the msg
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// AccountKeeper defines the account keeper used to sign the simulated txs
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// BankKeeper defines the bank keeper used to pay the fees of the simulated txs
+type BankKeeper interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// ServiceKeeper defines the service keeper used to mock the services which the simulated requests are sent to
+type ServiceKeeper interface {
+	GetServiceDefinition(ctx sdk.Context, serviceName string) (servicetypes.ServiceDefinition, bool)
+
+	GetServiceBinding(ctx sdk.Context, serviceName string, provider sdk.AccAddress) (servicetypes.ServiceBinding, bool)
+
+	GetMinDeposit(ctx sdk.Context, pricing servicetypes.Pricing) (sdk.Coins, error)
+
+	ActiveRequestsIterator(ctx sdk.Context, serviceName string, provider sdk.AccAddress) sdk.Iterator
+
+	GetRequest(ctx sdk.Context, requestID tmbytes.HexBytes) (servicetypes.Request, bool)
+}
+
+// MockService defines a service which the simulated requests are sent to, it is defined
+// and bound by the simulation accounts with the txs of the service module
+type MockService struct {
+	Name              string
+	Description       string
+	Tags              []string
+	AuthorDescription string
+	Schemas           string
+	Pricing           string
+	QoS               uint64
+	Options           string
+}
+
+// Bind defines the service by the author if it does not exist and binds the providers which
+// are not bound to it yet with the minimal deposit
+func (s MockService) Bind(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak AccountKeeper,
+	bk BankKeeper,
+	sk ServiceKeeper,
+	author simtypes.Account,
+	providers []simtypes.Account,
+	chainID string,
+) error {
+	if _, found := sk.GetServiceDefinition(ctx, s.Name); !found {
+		msg := servicetypes.NewMsgDefineService(s.Name, s.Description, s.Tags, author.Address, s.AuthorDescription, s.Schemas)
+		if err := DeliverMsg(r, app, ctx, ak, bk, author, msg, chainID, nil); err != nil {
+			return err
+		}
+	}
+
+	pricing, err := servicetypes.ParsePricing(s.Pricing)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sk.GetMinDeposit(ctx, pricing)
+	if err != nil {
+		return err
+	}
+
+	for _, provider := range providers {
+		if _, found := sk.GetServiceBinding(ctx, s.Name, provider.Address); found {
+			continue
+		}
+
+		msg := servicetypes.NewMsgBindService(s.Name, provider.Address, deposit, s.Pricing, s.QoS, s.Options, provider.Address)
+		if err := DeliverMsg(r, app, ctx, ak, bk, provider, msg, chainID, deposit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SimulateMsgRespondService mocks the response of a provider to a random active request of the
+// service, the output of the response is generated by genOutput
+func SimulateMsgRespondService(
+	ak AccountKeeper,
+	bk BankKeeper,
+	sk ServiceKeeper,
+	serviceName string,
+	genOutput func(r *rand.Rand) string,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var requestIDs []tmbytes.HexBytes
+		for _, acc := range accs {
+			iterator := sk.ActiveRequestsIterator(ctx, serviceName, acc.Address)
+			for ; iterator.Valid(); iterator.Next() {
+				requestIDs = append(requestIDs, iterator.Value())
+			}
+			iterator.Close()
+		}
+
+		if len(requestIDs) == 0 {
+			return simtypes.NoOpMsg(servicetypes.ModuleName, servicetypes.TypeMsgRespondService, "no active request found"), nil, nil
+		}
+
+		requestID := requestIDs[r.Intn(len(requestIDs))]
+		request, found := sk.GetRequest(ctx, requestID)
+		if !found {
+			return simtypes.NoOpMsg(servicetypes.ModuleName, servicetypes.TypeMsgRespondService, "request not found"), nil, nil
+		}
+
+		provider, found := simtypes.FindAccount(accs, request.Provider)
+		if !found {
+			return simtypes.NoOpMsg(servicetypes.ModuleName, servicetypes.TypeMsgRespondService, "provider not found"), nil, nil
+		}
+
+		msg := servicetypes.NewMsgRespondService(requestID.String(), provider.Address, ResponseResult, genOutput(r))
+		return DeliverTx(r, app, ctx, ak, bk, provider, msg, chainID, nil)
+	}
+}
+
+// RandomProviders returns at least min and up to 3 distinct random accounts as the providers
+func RandomProviders(r *rand.Rand, accs []simtypes.Account, min int) []simtypes.Account {
+	n := simtypes.RandIntBetween(r, 1, 4)
+	if n < min {
+		n = min
+	}
+	if n > len(accs) {
+		n = len(accs)
+	}
+
+	providers := make([]simtypes.Account, n)
+	for i, idx := range r.Perm(len(accs))[:n] {
+		providers[i] = accs[idx]
+	}
+	return providers
+}
+
+// DeliverTx delivers the msg by DeliverMsg and returns the operation msg, the operation is
+// skipped if the account cannot afford the amount reserved for the msg
+func DeliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak AccountKeeper,
+	bk BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	chainID string,
+	reserved sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	if err := DeliverMsg(r, app, ctx, ak, bk, simAccount, msg, chainID, reserved); err != nil {
+		opMsg := simtypes.NoOpMsg(msg.Route(), msg.Type(), err.Error())
+		if errors.Is(err, ErrInsufficientFunds) {
+			return opMsg, nil, nil
+		}
+		return opMsg, nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+// DeliverMsg signs the msg by the account with random fees and delivers the tx, the fees are
+// chosen from the spendable coins exceeding the amount reserved for the msg
+func DeliverMsg(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak AccountKeeper,
+	bk BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	chainID string,
+	reserved sdk.Coins,
+) error {
+	account := ak.GetAccount(ctx, simAccount.Address)
+
+	spendable, hasNeg := bk.SpendableCoins(ctx, account.GetAddress()).SafeSub(reserved)
+	if hasNeg {
+		return fmt.Errorf("%w of %s for %s", ErrInsufficientFunds, simAccount.Address, msg.Type())
+	}
+
+	fees, err := simtypes.RandomFees(r, ctx, spendable)
+	if err != nil {
+		return fmt.Errorf("unable to generate fees: %w", err)
+	}
+
+	txGen := simappparams.MakeEncodingConfig().TxConfig
+	tx, err := sdkhelpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		sdkhelpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to generate mock tx: %w", err)
+	}
+
+	if _, _, err := app.Deliver(tx); err != nil {
+		return fmt.Errorf("unable to deliver tx: %w", err)
+	}
+	return nil
+}